@@ -9,11 +9,21 @@ initIndexes = {
                 'name': "idx_username"
             }
         );
+        // Accounts created from an external identity may have no email
         db.getCollection("users").createIndex(
             { 'email': 1 }, 
             { 
                 'name': "idx_email_unique", 
-                'unique': true
+                'unique': true,
+                'partialFilterExpression': { 'email': { '$gt': "" } }
+            }
+        );
+        db.getCollection("users").createIndex(
+            { 'identities.key': 1 }, 
+            { 
+                'name': "idx_user_identityKey_unique", 
+                'unique': true,
+                'sparse': true
             }
         );
         db.getCollection("users").createIndex(
@@ -60,6 +70,22 @@ initIndexes = {
                 'name': "idx_tip_updatedAt"
             }
         );
+        // Sweep of tips whose event started but that are not settled
+        db.getCollection("tips").createIndex(
+            { 'selection.eventStart': 1 }, 
+            { 
+                'name': "idx_tip_selection_eventStart",
+                'partialFilterExpression': { 'selection': { '$exists': true } }
+            }
+        );
+        // Tipster records, read in event order
+        db.getCollection("tips").createIndex(
+            { 'tipsterId': 1, 'selection.eventStart': 1 }, 
+            { 
+                'name': "idx_tip_settled_tipsterId_eventStart",
+                'partialFilterExpression': { 'settlement': { '$exists': true } }
+            }
+        );
 
         // Comments Collection Indexes
         db.getCollection("comments").createIndex(
@@ -92,6 +118,167 @@ initIndexes = {
                 'name': "idx_comment_updatedAt"
             }
         );
+
+        // Feed Events Collection Indexes
+        // Also serves the read path of events posted above the fan-out
+        // threshold, which filters on pulled
+        db.getCollection("feed_events").createIndex(
+            { 'authorId': 1, '_id': -1 }, 
+            { 
+                'name': "idx_feed_authorId_id"
+            }
+        );
+
+        // Timelines Collection Indexes
+        db.getCollection("timelines").createIndex(
+            { 'ownerId': 1, 'eventId': -1 }, 
+            { 
+                'name': "idx_timeline_ownerId_eventId_unique",
+                'unique': true
+            }
+        );
+        db.getCollection("timelines").createIndex(
+            { 'ownerId': 1, 'authorId': 1 }, 
+            { 
+                'name': "idx_timeline_ownerId_authorId"
+            }
+        );
+
+        // Self-Exclusions Collection Indexes
+        db.getCollection("self_exclusions").createIndex(
+            { 'userId': 1, '_id': -1 }, 
+            { 
+                'name': "idx_selfExclusion_userId_id"
+            }
+        );
+        db.getCollection("users").createIndex(
+            { 'status': 1, 'selfExcludedUntil': 1 }, 
+            { 
+                'name': "idx_user_status_selfExcludedUntil"
+            }
+        );
+        db.getCollection("users").createIndex(
+            { 'deletion.purgeAfter': 1 }, 
+            { 
+                'name': "idx_user_deletion_purgeAfter",
+                'sparse': true
+            }
+        );
+
+        // Follows Collection Indexes
+        db.getCollection("follows").createIndex(
+            { 'followerId': 1, 'followeeId': 1 }, 
+            { 
+                'name': "idx_follow_followerId_followeeId_unique",
+                'unique': true
+            }
+        );
+        db.getCollection("follows").createIndex(
+            { 'followeeId': 1, '_id': -1 }, 
+            { 
+                'name': "idx_follow_followeeId_id"
+            }
+        );
+        db.getCollection("follows").createIndex(
+            { 'followerId': 1, '_id': -1 }, 
+            { 
+                'name': "idx_follow_followerId_id"
+            }
+        );
+
+        // Follow Requests Collection Indexes
+        db.getCollection("follow_requests").createIndex(
+            { 'requesterId': 1, 'targetId': 1 }, 
+            { 
+                'name': "idx_followRequest_requesterId_targetId_unique",
+                'unique': true
+            }
+        );
+        db.getCollection("follow_requests").createIndex(
+            { 'targetId': 1, '_id': -1 }, 
+            { 
+                'name': "idx_followRequest_targetId_id"
+            }
+        );
+
+        // Restrictions Collection Indexes
+        db.getCollection("restrictions").createIndex(
+            { 'ownerId': 1, 'targetId': 1, 'kind': 1 }, 
+            { 
+                'name': "idx_restriction_ownerId_targetId_kind_unique",
+                'unique': true
+            }
+        );
+
+        // Shares Collection Indexes
+        db.getCollection("shares").createIndex(
+            { 'tipId': 1, 'sharerId': 1 }, 
+            { 
+                'name': "idx_share_tipId_sharerId_unique",
+                'unique': true
+            }
+        );
+        db.getCollection("shares").createIndex(
+            { 'tipId': 1, '_id': -1 }, 
+            { 
+                'name': "idx_share_tipId_id"
+            }
+        );
+        db.getCollection("shares").createIndex(
+            { 'sharerId': 1 }, 
+            { 
+                'name': "idx_share_sharerId"
+            }
+        );
+
+        // Tip Revisions Collection Indexes
+        db.getCollection("tip_revisions").createIndex(
+            { 'tipId': 1, 'number': 1 }, 
+            { 
+                'name': "idx_tip_revision_tipId_number_unique",
+                'unique': true
+            }
+        );
+        db.getCollection("tip_revisions").createIndex(
+            { 'tipId': 1, '_id': 1 }, 
+            { 
+                'name': "idx_tip_revision_tipId_id"
+            }
+        );
+        db.getCollection("tip_revisions").createIndex(
+            { 'editorId': 1 }, 
+            { 
+                'name': "idx_tip_revision_editorId"
+            }
+        );
+
+        // Tip Ledger Collection Indexes
+        db.getCollection("tip_ledger").createIndex(
+            { 'tipsterId': 1, 'sequence': 1 }, 
+            { 
+                'name': "idx_tip_ledger_tipsterId_sequence_unique",
+                'unique': true
+            }
+        );
+
+        // Leaderboard Collections Indexes
+        db.getCollection("leaderboard_snapshots").createIndex(
+            { 'window': 1, 'computedAt': -1 }, 
+            { 
+                'name': "idx_leaderboard_snapshot_window_computedAt"
+            }
+        );
+        ['profit', 'roi', 'strikeRate'].forEach(function (metric) {
+            const keys = { 'snapshotId': 1, 'sport': 1, 'tag': 1 };
+            keys['stats.' + metric] = -1;
+            keys['_id'] = 1;
+            db.getCollection("leaderboard_entries").createIndex(
+                keys, 
+                { 
+                    'name': "idx_leaderboard_entry_snapshotId_scope_" + metric
+                }
+            );
+        });
     }
 };
 
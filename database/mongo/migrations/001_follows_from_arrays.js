@@ -0,0 +1,76 @@
+// Moves the follow graph from the following/followers arrays on users into
+// the follows collection and replaces the arrays with followersCount and
+// followingCount. Safe to run more than once.
+//
+//   mongosh -u root -p pass.123 < database/mongo/migrations/001_follows_from_arrays.js
+use tipster;
+
+migrateFollows = {
+    batchSize: 1000,
+
+    start: function () {
+        const users = db.getCollection("users");
+        const follows = db.getCollection("follows");
+
+        // Dedupes the edges when both arrays list the same follow
+        follows.createIndex(
+            { 'followerId': 1, 'followeeId': 1 },
+            {
+                'name': "idx_follow_followerId_followeeId_unique",
+                'unique': true
+            }
+        );
+
+        // Legacy follows have no timestamp, the user's update time is the
+        // closest we have
+        users.find(
+            { 'following.0': { '$exists': true } },
+            { 'following': 1, 'updatedAt': 1 }
+        ).forEach(function (user) {
+            migrateFollows.upsertEdges(follows, user.following.map(function (followeeId) {
+                return { followerId: user._id, followeeId: followeeId, createdAt: user.updatedAt };
+            }));
+        });
+        // The followers arrays mirror the following arrays, except where the
+        // two non-atomic updates of a follow drifted apart
+        users.find(
+            { 'followers.0': { '$exists': true } },
+            { 'followers': 1, 'updatedAt': 1 }
+        ).forEach(function (user) {
+            migrateFollows.upsertEdges(follows, user.followers.map(function (followerId) {
+                return { followerId: followerId, followeeId: user._id, createdAt: user.updatedAt };
+            }));
+        });
+
+        users.find({}, { '_id': 1 }).forEach(function (user) {
+            users.updateOne(
+                { '_id': user._id },
+                {
+                    '$set': {
+                        'followersCount': follows.countDocuments({ 'followeeId': user._id }),
+                        'followingCount': follows.countDocuments({ 'followerId': user._id })
+                    },
+                    '$unset': { 'followers': "", 'following': "" }
+                }
+            );
+        });
+
+        print("follows: " + follows.countDocuments({}) + " edges");
+    },
+
+    upsertEdges: function (follows, edges) {
+        for (let i = 0; i < edges.length; i += migrateFollows.batchSize) {
+            follows.bulkWrite(edges.slice(i, i + migrateFollows.batchSize).map(function (edge) {
+                return {
+                    updateOne: {
+                        filter: { followerId: edge.followerId, followeeId: edge.followeeId },
+                        update: { '$setOnInsert': edge },
+                        upsert: true
+                    }
+                };
+            }), { ordered: false });
+        }
+    }
+};
+
+migrateFollows.start();
@@ -0,0 +1,45 @@
+// Converts the decimal odds of tip selections from doubles into the exact
+// { num, den } fractions the odds package stores. Each double is read as its
+// shortest decimal representation, so 2.1 becomes 21/10. Safe to run more
+// than once.
+//
+//   mongosh -u root -p pass.123 < database/mongo/migrations/002_selection_odds_fractions.js
+use tipster;
+
+migrateOdds = {
+    start: function () {
+        const tips = db.getCollection("tips");
+        let converted = 0;
+
+        tips.find(
+            { 'selection.odds': { '$type': "double" } },
+            { 'selection.odds': 1 }
+        ).forEach(function (tip) {
+            const fraction = migrateOdds.toFraction(tip.selection.odds);
+            tips.updateOne(
+                { '_id': tip._id, 'selection.odds': tip.selection.odds },
+                { '$set': { 'selection.odds': { 'num': NumberLong(fraction.num.toString()), 'den': NumberLong(fraction.den.toString()) } } }
+            );
+            converted++;
+        });
+
+        print("tips: converted the odds of " + converted + " selections");
+    },
+
+    toFraction: function (odds) {
+        const [whole, decimals = ""] = String(odds).split(".");
+        let num = BigInt(whole + decimals);
+        let den = 10n ** BigInt(decimals.length);
+        const divisor = migrateOdds.gcd(num, den);
+        return { num: num / divisor, den: den / divisor };
+    },
+
+    gcd: function (a, b) {
+        while (b !== 0n) {
+            [a, b] = [b, a % b];
+        }
+        return a;
+    }
+};
+
+migrateOdds.start();
@@ -0,0 +1,40 @@
+// Marks the feed events of tipsters currently above the fan-out threshold as
+// pulled. Before the flag existed, those events were only merged in while the
+// tipster stayed above the threshold, so they would drop out of followers'
+// feeds once the tipster fell back below it. Events that were fanned out
+// earlier get marked too, which is harmless: the feed reads both sources in
+// one query. Set fanoutThreshold below to feed.fanout_follower_threshold if
+// that is configured differently. Also drops idx_feed_authorId_id_pulled,
+// which duplicated idx_feed_authorId_id. Safe to run more than once.
+//
+//   mongosh -u root -p pass.123 < database/mongo/migrations/003_feed_events_pulled.js
+use tipster;
+
+migratePulled = {
+    fanoutThreshold: 1000,
+
+    start: function () {
+        const events = db.getCollection("feed_events");
+        let marked = 0;
+
+        db.getCollection("users").find(
+            { 'followersCount': { '$gt': migratePulled.fanoutThreshold } },
+            { '_id': 1 }
+        ).forEach(function (user) {
+            const result = events.updateMany(
+                { 'authorId': user._id, 'pulled': { '$ne': true } },
+                { '$set': { 'pulled': true } }
+            );
+            marked += result.modifiedCount;
+        });
+
+        print("feed_events: marked " + marked + " events as pulled");
+
+        if (events.getIndexes().some(function (index) { return index.name === "idx_feed_authorId_id_pulled"; })) {
+            events.dropIndex("idx_feed_authorId_id_pulled");
+            print("feed_events: dropped idx_feed_authorId_id_pulled");
+        }
+    }
+};
+
+migratePulled.start();
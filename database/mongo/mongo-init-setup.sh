@@ -7,4 +7,16 @@ echo "Setup Completed!"
 echo "Executing MongoDB Init..."
 for file in $(ls /etc/mongo/init-data/*.js); do mongosh -u root -p pass.123 < $file; done
 echo INIT_DATA_DONE >> /tmp/dbstatus
-echo "Init Completed!" 
\ No newline at end of file
+echo "Init Completed!" 
+
+# Init scripts run against a temporary standalone mongod, the entrypoint
+# drops --replSet until they are done. Initiate the replica set once the
+# real server is up.
+echo "Waiting to initiate the replica set..."
+(
+    until mongosh --quiet -u root -p pass.123 --eval 'try { rs.status() } catch (ex) { rs.initiate({ _id: "rs0", members: [{ _id: 0, host: "localhost:27017" }] }) } print("REPLICA_SET_OK")' | grep -q REPLICA_SET_OK; do
+        sleep 1
+    done
+    echo REPLICA_SET_DONE >> /tmp/dbstatus
+    echo "Replica set initiated!"
+) &
@@ -0,0 +1,50 @@
+package authtest
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/transport"
+)
+
+// ServerContext returns the context of a server request for the operation,
+// with the Authorization header set unless authorization is empty.
+func ServerContext(operation, authorization string) context.Context {
+	header := headerCarrier{}
+	if authorization != "" {
+		header.Set("Authorization", authorization)
+	}
+	return transport.NewServerContext(context.Background(), &serverTransport{operation: operation, header: header})
+}
+
+// headerCarrier is a transport.Header over a plain map.
+type headerCarrier map[string][]string
+
+func (h headerCarrier) Get(key string) string {
+	if values := h[key]; len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (h headerCarrier) Set(key, value string)      { h[key] = []string{value} }
+func (h headerCarrier) Add(key, value string)      { h[key] = append(h[key], value) }
+func (h headerCarrier) Values(key string) []string { return h[key] }
+
+func (h headerCarrier) Keys() []string {
+	keys := make([]string, 0, len(h))
+	for key := range h {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+type serverTransport struct {
+	operation string
+	header    headerCarrier
+}
+
+func (t *serverTransport) Kind() transport.Kind            { return transport.KindGRPC }
+func (t *serverTransport) Endpoint() string                { return "" }
+func (t *serverTransport) Operation() string               { return t.operation }
+func (t *serverTransport) RequestHeader() transport.Header { return t.header }
+func (t *serverTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
@@ -0,0 +1,46 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"src/internal/conf"
+	commonpb "src/protos/YM.Common"
+)
+
+// ExternalIdentity is what an identity provider vouches for once it has
+// verified a sign-in. Subject is the provider's stable ID for the user.
+type ExternalIdentity struct {
+	Provider      commonpb.IdendityProvider
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+}
+
+// IdentityVerifier checks a credential issued by an identity provider, such
+// as a Google ID token or Telegram login data, and returns the identity it
+// proves. Implementations must reject credentials they cannot fully verify.
+type IdentityVerifier interface {
+	Verify(ctx context.Context, credential string) (*ExternalIdentity, error)
+}
+
+// IdentityVerifiers holds the verifier of each enabled provider.
+type IdentityVerifiers map[commonpb.IdendityProvider]IdentityVerifier
+
+// NewIdentityVerifiersFromConf enables every provider that is configured.
+func NewIdentityVerifiersFromConf(c *conf.Auth_Identity) IdentityVerifiers {
+	verifiers := IdentityVerifiers{}
+	if len(c.GetGoogle().GetClientIds()) > 0 {
+		verifiers[commonpb.IdendityProvider_Google] = NewGoogleVerifier(c.GetGoogle())
+	}
+	if c.GetTelegram().GetBotToken() != "" {
+		verifiers[commonpb.IdendityProvider_Telegram] = NewTelegramVerifier(c.GetTelegram())
+	}
+	return verifiers
+}
+
+// IdentityKey identifies an external identity across providers.
+func IdentityKey(provider commonpb.IdendityProvider, subject string) string {
+	return fmt.Sprintf("%s:%s", provider.String(), subject)
+}
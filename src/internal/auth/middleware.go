@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"src/internal/errors"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// Server authenticates the bearer access token of every request it wraps and
+// stores the caller as the Principal of the request context.
+func Server(issuer *TokenIssuer) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, errors.ErrUnauthenticated
+			}
+
+			header := tr.RequestHeader().Get(authorizationHeader)
+			token, found := strings.CutPrefix(header, bearerPrefix)
+			if !found || token == "" {
+				return nil, errors.ErrUnauthenticated
+			}
+
+			claims, err := issuer.Parse(token, AccessToken)
+			if err != nil {
+				return nil, errors.ErrInvalidToken
+			}
+			userID, err := primitive.ObjectIDFromHex(claims.Subject)
+			if err != nil {
+				return nil, errors.ErrInvalidToken
+			}
+
+			return handler(NewContext(ctx, &Principal{UserID: userID}), req)
+		}
+	}
+}
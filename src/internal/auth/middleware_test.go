@@ -0,0 +1,68 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"src/internal/auth"
+	"src/internal/auth/authtest"
+	"src/internal/conf"
+	"src/internal/errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestServer(t *testing.T) {
+	const secret = "test-secret-of-at-least-32-bytes"
+	tokens, err := auth.NewTokenIssuer(&conf.Auth_Token{Secret: secret})
+	if err != nil {
+		t.Fatal(err)
+	}
+	userID := primitive.NewObjectID()
+	pair, err := tokens.Issue(userID.Hex())
+	if err != nil {
+		t.Fatal(err)
+	}
+	notAnID, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &auth.Claims{
+		TokenType:        auth.AccessToken,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "not-an-object-id", ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute))},
+	}).SignedString([]byte(secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{"valid access token", authtest.ServerContext("op", "Bearer "+pair.AccessToken), nil},
+		{"no transport", context.Background(), errors.ErrUnauthenticated},
+		{"no header", authtest.ServerContext("op", ""), errors.ErrUnauthenticated},
+		{"no bearer prefix", authtest.ServerContext("op", pair.AccessToken), errors.ErrUnauthenticated},
+		{"empty bearer", authtest.ServerContext("op", "Bearer "), errors.ErrUnauthenticated},
+		{"refresh token", authtest.ServerContext("op", "Bearer "+pair.RefreshToken), errors.ErrInvalidToken},
+		{"subject not a user ID", authtest.ServerContext("op", "Bearer "+notAnID), errors.ErrInvalidToken},
+		{"garbage", authtest.ServerContext("op", "Bearer garbage"), errors.ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		var principal *auth.Principal
+		handler := auth.Server(tokens)(func(ctx context.Context, req interface{}) (interface{}, error) {
+			principal, _ = auth.FromContext(ctx)
+			return "ok", nil
+		})
+		_, err := handler(tt.ctx, nil)
+		if err != tt.wantErr {
+			t.Errorf("%s: err = %v, want %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if tt.wantErr == nil && (principal == nil || principal.UserID != userID) {
+			t.Errorf("%s: principal = %+v, want user %s", tt.name, principal, userID.Hex())
+		}
+		if tt.wantErr != nil && principal != nil {
+			t.Errorf("%s: handler ran with principal %+v", tt.name, principal)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"src/internal/conf"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2Params are the argon2id cost parameters used for new hashes.
+type Argon2Params struct {
+	Memory      uint32 // KiB
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// DefaultArgon2Params follow the OWASP recommendation for argon2id.
+var DefaultArgon2Params = Argon2Params{
+	Memory:      64 * 1024,
+	Iterations:  3,
+	Parallelism: 2,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+const argon2idPrefix = "$argon2id$"
+
+// PasswordHasher hashes and verifies passwords with argon2id. Hashes are
+// stored in the PHC string format so the parameters travel with the hash:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>
+type PasswordHasher struct {
+	params Argon2Params
+	// dummyHash is made with params, so verifying against it costs as much
+	// as verifying a real password
+	dummyHash string
+}
+
+func NewPasswordHasher(params Argon2Params) *PasswordHasher {
+	h := &PasswordHasher{params: params}
+	salt := make([]byte, params.SaltLength)
+	key := argon2.IDKey([]byte("dummy"), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	h.dummyHash = h.encode(salt, key)
+	return h
+}
+
+// NewPasswordHasherFromConf overrides the default cost parameters with any
+// that are set in the config.
+func NewPasswordHasherFromConf(c *conf.Auth_Password) *PasswordHasher {
+	params := DefaultArgon2Params
+	if c.GetMemoryKib() > 0 {
+		params.Memory = c.GetMemoryKib()
+	}
+	if c.GetIterations() > 0 {
+		params.Iterations = c.GetIterations()
+	}
+	if c.GetParallelism() > 0 {
+		params.Parallelism = uint8(c.GetParallelism())
+	}
+	return NewPasswordHasher(params)
+}
+
+func (h *PasswordHasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.params.Iterations, h.params.Memory, h.params.Parallelism, h.params.KeyLength)
+	return h.encode(salt, key), nil
+}
+
+func (h *PasswordHasher) encode(salt, key []byte) string {
+	return fmt.Sprintf("%sv=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2idPrefix,
+		argon2.Version,
+		h.params.Memory,
+		h.params.Iterations,
+		h.params.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key),
+	)
+}
+
+// VerifyDummy does the work of a failed Verify with the current parameters.
+// Call it when there is no hash to check against, e.g. for an unknown email,
+// so that the response takes as long as for a wrong password.
+func (h *PasswordHasher) VerifyDummy(password string) {
+	_, _, _ = h.Verify(password, h.dummyHash)
+}
+
+// Verify reports whether password matches the stored hash, and whether the
+// hash should be replaced because it was made with different parameters.
+// Values that are not argon2id hashes are treated as plaintext passwords
+// stored before hashing was introduced; they always need a rehash.
+func (h *PasswordHasher) Verify(password, encoded string) (match bool, needsRehash bool, err error) {
+	if !strings.HasPrefix(encoded, argon2idPrefix) {
+		match = subtle.ConstantTimeCompare([]byte(password), []byte(encoded)) == 1
+		return match, true, nil
+	}
+
+	params, salt, key, err := decodeArgon2Hash(encoded)
+	if err != nil {
+		return false, false, err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	match = subtle.ConstantTimeCompare(key, otherKey) == 1
+
+	needsRehash = params.Memory != h.params.Memory ||
+		params.Iterations != h.params.Iterations ||
+		params.Parallelism != h.params.Parallelism ||
+		params.KeyLength != h.params.KeyLength ||
+		params.SaltLength != h.params.SaltLength
+	return match, needsRehash, nil
+}
+
+func decodeArgon2Hash(encoded string) (Argon2Params, []byte, []byte, error) {
+	var params Argon2Params
+
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", salt, key
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 {
+		return params, nil, nil, fmt.Errorf("invalid argon2id hash format")
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id version: %w", err)
+	}
+	if version != argon2.Version {
+		return params, nil, nil, fmt.Errorf("unsupported argon2id version %d", version)
+	}
+
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism); err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id parameters: %w", err)
+	}
+	// argon2 panics on these instead of returning an error
+	if params.Iterations == 0 || params.Parallelism == 0 || params.Memory < 8*uint32(params.Parallelism) {
+		return params, nil, nil, fmt.Errorf("invalid argon2id parameters %q", parts[3])
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id salt: %w", err)
+	}
+	key, err := base64.RawStdEncoding.DecodeString(parts[5])
+	if err != nil {
+		return params, nil, nil, fmt.Errorf("invalid argon2id key: %w", err)
+	}
+	// An empty key would match every password
+	if len(salt) == 0 || len(key) == 0 {
+		return params, nil, nil, fmt.Errorf("invalid argon2id hash: empty salt or key")
+	}
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
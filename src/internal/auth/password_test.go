@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+)
+
+// testArgon2Params keep the tests fast; they are far too cheap for real use.
+var testArgon2Params = Argon2Params{
+	Memory:      64,
+	Iterations:  1,
+	Parallelism: 1,
+	SaltLength:  16,
+	KeyLength:   32,
+}
+
+func TestHashVerifyRoundTrip(t *testing.T) {
+	h := NewPasswordHasher(testArgon2Params)
+	encoded, err := h.Hash("correct horse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(encoded, "$argon2id$v=19$m=64,t=1,p=1$") {
+		t.Errorf("Hash = %q, want a PHC string with the parameters", encoded)
+	}
+	if again, _ := h.Hash("correct horse"); again == encoded {
+		t.Error("Hash returned the same string twice, want a fresh salt")
+	}
+
+	tests := []struct {
+		password string
+		match    bool
+	}{
+		{"correct horse", true},
+		{"correct horse ", false},
+		{"Correct horse", false},
+		{"", false},
+	}
+	for _, tt := range tests {
+		match, needsRehash, err := h.Verify(tt.password, encoded)
+		if err != nil {
+			t.Fatalf("Verify(%q): %v", tt.password, err)
+		}
+		if match != tt.match || needsRehash {
+			t.Errorf("Verify(%q) = %v, %v, want %v, false", tt.password, match, needsRehash, tt.match)
+		}
+	}
+}
+
+func TestVerifyNeedsRehashWhenParamsChange(t *testing.T) {
+	encoded, err := NewPasswordHasher(testArgon2Params).Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(*Argon2Params)
+		rehash bool
+	}{
+		{"same", func(*Argon2Params) {}, false},
+		{"memory", func(p *Argon2Params) { p.Memory = 128 }, true},
+		{"iterations", func(p *Argon2Params) { p.Iterations = 2 }, true},
+		{"parallelism", func(p *Argon2Params) { p.Parallelism = 2 }, true},
+		{"salt length", func(p *Argon2Params) { p.SaltLength = 32 }, true},
+		{"key length", func(p *Argon2Params) { p.KeyLength = 64 }, true},
+	}
+	for _, tt := range tests {
+		params := testArgon2Params
+		tt.change(&params)
+		// The old hash still verifies, it was made with its own parameters
+		match, needsRehash, err := NewPasswordHasher(params).Verify("secret", encoded)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if !match || needsRehash != tt.rehash {
+			t.Errorf("%s: Verify = %v, %v, want true, %v", tt.name, match, needsRehash, tt.rehash)
+		}
+	}
+}
+
+func TestVerifyLegacyPlaintext(t *testing.T) {
+	h := NewPasswordHasher(testArgon2Params)
+	tests := []struct {
+		password, stored string
+		match            bool
+	}{
+		{"hunter2", "hunter2", true},
+		{"hunter2", "hunter3", false},
+		{"hunter", "hunter2", false},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		match, needsRehash, err := h.Verify(tt.password, tt.stored)
+		if err != nil {
+			t.Fatalf("Verify(%q, %q): %v", tt.password, tt.stored, err)
+		}
+		if match != tt.match || !needsRehash {
+			t.Errorf("Verify(%q, %q) = %v, %v, want %v, true", tt.password, tt.stored, match, needsRehash, tt.match)
+		}
+	}
+}
+
+func TestVerifyRejectsMalformedHashes(t *testing.T) {
+	h := NewPasswordHasher(testArgon2Params)
+	valid, err := h.Hash("secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := strings.Split(valid, "$")
+	salt, key := parts[4], parts[5]
+
+	tests := []string{
+		"$argon2id$",
+		"$argon2id$v=19$m=64,t=1,p=1$" + salt,
+		"$argon2id$v=19$m=64,t=1,p=1$" + salt + "$" + key + "$extra",
+		"$argon2id$v=16$m=64,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$version$m=64,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=64;t=1;p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=64,t=1,p=256$" + salt + "$" + key,
+		"$argon2id$v=19$m=64,t=0,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=64,t=1,p=0$" + salt + "$" + key,
+		"$argon2id$v=19$m=4,t=1,p=1$" + salt + "$" + key,
+		"$argon2id$v=19$m=64,t=1,p=1$!!!$" + key,
+		"$argon2id$v=19$m=64,t=1,p=1$" + salt + "$!!!",
+		"$argon2id$v=19$m=64,t=1,p=1$$" + key,
+		"$argon2id$v=19$m=64,t=1,p=1$" + salt + "$",
+	}
+	for _, encoded := range tests {
+		match, _, err := h.Verify("secret", encoded)
+		if err == nil || match {
+			t.Errorf("Verify(%q) = %v, %v, want an error", encoded, match, err)
+		}
+	}
+}
+
+func TestVerifyDummyUsesConfiguredParams(t *testing.T) {
+	h := NewPasswordHasher(testArgon2Params)
+	match, needsRehash, err := h.Verify("secret", h.dummyHash)
+	if err != nil || match || needsRehash {
+		t.Errorf("Verify against the dummy hash = %v, %v, %v, want a clean mismatch", match, needsRehash, err)
+	}
+}
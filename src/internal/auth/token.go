@@ -0,0 +1,147 @@
+package auth
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+type TokenType string
+
+const (
+	AccessToken  TokenType = "access"
+	RefreshToken TokenType = "refresh"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+
+	// minSecretLength is the HS256 key size; shorter secrets can be
+	// brute-forced from a single token
+	minSecretLength = 32
+)
+
+// placeholderSecrets were committed as examples and are public, so tokens
+// signed with them can be forged by anyone.
+var placeholderSecrets = []string{
+	"local-development-secret-change-me",
+}
+
+// Claims are the JWT claims of both token types. The subject is the user ID.
+type Claims struct {
+	TokenType TokenType `json:"typ"`
+	jwt.RegisteredClaims
+}
+
+type TokenPair struct {
+	AccessToken           string
+	AccessTokenExpiresAt  time.Time
+	RefreshToken          string
+	RefreshTokenExpiresAt time.Time
+}
+
+// TokenIssuer signs and verifies HS256 access and refresh tokens.
+type TokenIssuer struct {
+	secret     []byte
+	issuer     string
+	accessTTL  time.Duration
+	refreshTTL time.Duration
+}
+
+func NewTokenIssuer(c *conf.Auth_Token) (*TokenIssuer, error) {
+	secret := c.GetSecret()
+	if secret == "" {
+		return nil, fmt.Errorf("auth token secret is not configured")
+	}
+	if slices.Contains(placeholderSecrets, secret) {
+		return nil, fmt.Errorf("auth token secret is a published placeholder")
+	}
+	if len(secret) < minSecretLength {
+		return nil, fmt.Errorf("auth token secret must be at least %d bytes", minSecretLength)
+	}
+
+	accessTTL := c.GetAccessTtl().AsDuration()
+	if accessTTL <= 0 {
+		accessTTL = defaultAccessTokenTTL
+	}
+	refreshTTL := c.GetRefreshTtl().AsDuration()
+	if refreshTTL <= 0 {
+		refreshTTL = defaultRefreshTokenTTL
+	}
+
+	return &TokenIssuer{
+		secret:     []byte(secret),
+		issuer:     c.GetIssuer(),
+		accessTTL:  accessTTL,
+		refreshTTL: refreshTTL,
+	}, nil
+}
+
+// Issue creates a new access/refresh token pair for the user.
+func (i *TokenIssuer) Issue(userID string) (*TokenPair, error) {
+	now := time.Now().UTC()
+
+	accessToken, accessExpiresAt, err := i.sign(userID, AccessToken, now, i.accessTTL)
+	if err != nil {
+		return nil, err
+	}
+	refreshToken, refreshExpiresAt, err := i.sign(userID, RefreshToken, now, i.refreshTTL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenPair{
+		AccessToken:           accessToken,
+		AccessTokenExpiresAt:  accessExpiresAt,
+		RefreshToken:          refreshToken,
+		RefreshTokenExpiresAt: refreshExpiresAt,
+	}, nil
+}
+
+// Parse verifies the token signature, expiry, issuer and type.
+func (i *TokenIssuer) Parse(token string, tokenType TokenType) (*Claims, error) {
+	claims := &Claims{}
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	}
+	if i.issuer != "" {
+		options = append(options, jwt.WithIssuer(i.issuer))
+	}
+
+	_, err := jwt.ParseWithClaims(token, claims, func(*jwt.Token) (interface{}, error) {
+		return i.secret, nil
+	}, options...)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != tokenType {
+		return nil, fmt.Errorf("expected %s token, got %q", tokenType, claims.TokenType)
+	}
+	return claims, nil
+}
+
+func (i *TokenIssuer) sign(userID string, tokenType TokenType, now time.Time, ttl time.Duration) (string, time.Time, error) {
+	expiresAt := now.Add(ttl)
+	claims := &Claims{
+		TokenType: tokenType,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   userID,
+			Issuer:    i.issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+		},
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(i.secret)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return token, expiresAt, nil
+}
@@ -0,0 +1,134 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"src/internal/conf"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const testSecret = "test-secret-of-at-least-32-bytes"
+
+func newTestIssuer(t *testing.T, secret, issuer string) *TokenIssuer {
+	t.Helper()
+	tokens, err := NewTokenIssuer(&conf.Auth_Token{
+		Secret:     secret,
+		Issuer:     issuer,
+		AccessTtl:  durationpb.New(time.Minute),
+		RefreshTtl: durationpb.New(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tokens
+}
+
+func TestNewTokenIssuerSecrets(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret string
+		ok     bool
+	}{
+		{"empty", "", false},
+		{"placeholder", "local-development-secret-change-me", false},
+		{"short", "too-short", false},
+		{"31 bytes", strings.Repeat("s", 31), false},
+		{"32 bytes", strings.Repeat("s", 32), true},
+	}
+	for _, tt := range tests {
+		_, err := NewTokenIssuer(&conf.Auth_Token{Secret: tt.secret})
+		if (err == nil) != tt.ok {
+			t.Errorf("%s: NewTokenIssuer err = %v, want ok %v", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestIssueParseRoundTrip(t *testing.T) {
+	tokens := newTestIssuer(t, testSecret, "test")
+	before := time.Now().UTC().Truncate(time.Second)
+	pair, err := tokens.Issue("user-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		token     string
+		tokenType TokenType
+		expiresAt time.Time
+		ttl       time.Duration
+	}{
+		{pair.AccessToken, AccessToken, pair.AccessTokenExpiresAt, time.Minute},
+		{pair.RefreshToken, RefreshToken, pair.RefreshTokenExpiresAt, time.Hour},
+	}
+	for _, tt := range tests {
+		claims, err := tokens.Parse(tt.token, tt.tokenType)
+		if err != nil {
+			t.Fatalf("Parse(%s): %v", tt.tokenType, err)
+		}
+		if claims.Subject != "user-1" || claims.Issuer != "test" || claims.TokenType != tt.tokenType {
+			t.Errorf("Parse(%s) = %+v, want subject user-1, issuer test", tt.tokenType, claims)
+		}
+		if !claims.ExpiresAt.Time.Equal(tt.expiresAt.Truncate(time.Second)) {
+			t.Errorf("%s expires at %v, pair says %v", tt.tokenType, claims.ExpiresAt.Time, tt.expiresAt)
+		}
+		if lifetime := tt.expiresAt.Sub(before); lifetime < tt.ttl || lifetime > tt.ttl+time.Second {
+			t.Errorf("%s lives %v, want %v", tt.tokenType, lifetime, tt.ttl)
+		}
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	tokens := newTestIssuer(t, testSecret, "test")
+	now := time.Now().UTC()
+	sign := func(issuer *TokenIssuer, tokenType TokenType, issuedAt time.Time, ttl time.Duration) string {
+		token, _, err := issuer.sign("user-1", tokenType, issuedAt, ttl)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+	access := sign(tokens, AccessToken, now, time.Minute)
+	parts := strings.Split(access, ".")
+	unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, &Claims{
+		TokenType:        AccessToken,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1", Issuer: "test", ExpiresAt: jwt.NewNumericDate(now.Add(time.Minute))},
+	}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	noExpiry, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{
+		TokenType:        AccessToken,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "user-1", Issuer: "test"},
+	}).SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		token     string
+		tokenType TokenType
+	}{
+		{"expired", sign(tokens, AccessToken, now.Add(-2*time.Minute), time.Minute), AccessToken},
+		{"not yet valid", sign(tokens, AccessToken, now.Add(time.Hour), time.Minute), AccessToken},
+		{"refresh as access", sign(tokens, RefreshToken, now, time.Hour), AccessToken},
+		{"access as refresh", access, RefreshToken},
+		{"tampered payload", parts[0] + "." + strings.TrimRight(parts[1], "=") + "x." + parts[2], AccessToken},
+		{"tampered signature", parts[0] + "." + parts[1] + "." + strings.Repeat("A", len(parts[2])), AccessToken},
+		{"other secret", sign(newTestIssuer(t, strings.Repeat("o", 32), "test"), AccessToken, now, time.Minute), AccessToken},
+		{"other issuer", sign(newTestIssuer(t, testSecret, "other"), AccessToken, now, time.Minute), AccessToken},
+		{"alg none", unsigned, AccessToken},
+		{"no expiry", noExpiry, AccessToken},
+		{"garbage", "not-a-token", AccessToken},
+		{"empty", "", AccessToken},
+	}
+	for _, tt := range tests {
+		if claims, err := tokens.Parse(tt.token, tt.tokenType); err == nil {
+			t.Errorf("%s: Parse = %+v, want an error", tt.name, claims)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"src/internal/errors"
+	commonpb "src/protos/YM.Common"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// accountTransitions lists the statuses an account may move to from each
+// status. Closed (Delete) accounts cannot be reopened.
+var accountTransitions = map[commonpb.AccountStatus][]commonpb.AccountStatus{
+	commonpb.AccountStatus_AccountStatusNone: {
+		commonpb.AccountStatus_Suspend,
+		commonpb.AccountStatus_Delete,
+	},
+	commonpb.AccountStatus_Active: {
+		commonpb.AccountStatus_Suspend,
+		commonpb.AccountStatus_Delete,
+	},
+	commonpb.AccountStatus_Pending: {
+		commonpb.AccountStatus_Active,
+		commonpb.AccountStatus_Suspend,
+		commonpb.AccountStatus_Delete,
+	},
+	commonpb.AccountStatus_Inactive: {
+		commonpb.AccountStatus_Active,
+		commonpb.AccountStatus_Suspend,
+		commonpb.AccountStatus_Delete,
+	},
+	commonpb.AccountStatus_Suspend: {
+		commonpb.AccountStatus_Active,
+		commonpb.AccountStatus_Delete,
+	},
+	commonpb.AccountStatus_SelfExclude: {
+		commonpb.AccountStatus_Delete,
+	},
+}
+
+func canTransition(from, to commonpb.AccountStatus) bool {
+	for _, status := range accountTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+// SuspendUser blocks an account until an admin reactivates it.
+func (s *SocialService) SuspendUser(ctx context.Context, userID primitive.ObjectID, reason string) error {
+	return s.changeAccountStatus(ctx, userID, commonpb.AccountStatus_Suspend, reason)
+}
+
+// ReactivateUser lifts a suspension, or activates a pending or inactive
+// account.
+func (s *SocialService) ReactivateUser(ctx context.Context, userID primitive.ObjectID) error {
+	return s.changeAccountStatus(ctx, userID, commonpb.AccountStatus_Active, "")
+}
+
+// CloseUser closes an account for good. Unlike DeleteUser the account is
+// kept, so its tips and comments stay attributed.
+func (s *SocialService) CloseUser(ctx context.Context, userID primitive.ObjectID, reason string) error {
+	return s.changeAccountStatus(ctx, userID, commonpb.AccountStatus_Delete, reason)
+}
+
+func (s *SocialService) changeAccountStatus(ctx context.Context, userID primitive.ObjectID, to commonpb.AccountStatus, reason string) error {
+	admin, err := s.requireAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if admin.ID == userID {
+		return errors.ErrPermissionDenied
+	}
+
+	user, err := s.Repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if !canTransition(user.Status, to) {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	err = s.Repo.UpdateStatus(ctx, userID, user.Status, to, reason, time.Now().UTC())
+	if errors.IsNotFound(err) {
+		// The status changed since it was read
+		return errors.ErrInvalidStatusTransition
+	}
+	return err
+}
+
+// activeCaller returns the caller's ID if their account may post tips,
+// comment, like and follow.
+func (s *SocialService) activeCaller(ctx context.Context) (primitive.ObjectID, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if err := s.checkSelfExclusion(ctx, user); err != nil {
+		return primitive.NilObjectID, err
+	}
+	if !isActive(user) {
+		return primitive.NilObjectID, errors.ErrAccountNotActive
+	}
+	return user.ID, nil
+}
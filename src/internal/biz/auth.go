@@ -0,0 +1,126 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"src/internal/auth"
+	"src/internal/errors"
+	"src/internal/model"
+	commonpb "src/protos/YM.Common"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// VerifyCredentials checks an email/password pair and returns the account
+// it belongs to. Hashes made with outdated parameters, and passwords stored
+// before hashing was introduced, are rehashed on a successful check.
+func (s *SocialService) VerifyCredentials(ctx context.Context, email, password string) (*model.User, error) {
+	user, err := s.Repo.GetUserByEmail(ctx, email)
+	if err != nil {
+		// Lookups of missing accounts take as long as failed passwords
+		if errors.IsNotFound(err) {
+			s.Passwords.VerifyDummy(password)
+			return nil, errors.ErrInvalidCredentials
+		}
+		return nil, errors.ToRpcError(err)
+	}
+	// Accounts created from an external identity have no password
+	if user.Password == "" {
+		s.Passwords.VerifyDummy(password)
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	match, needsRehash, err := s.Passwords.Verify(password, user.Password)
+	if err != nil {
+		s.Logger.Log(log.LevelError, "failed to verify password hash", "user_id", user.ID.Hex(), "error", err)
+		return nil, errors.ErrInvalidCredentials
+	}
+	if !match {
+		return nil, errors.ErrInvalidCredentials
+	}
+
+	if !canSignIn(user) {
+		return nil, errors.ErrAccountNotActive
+	}
+
+	if needsRehash {
+		s.rehashPassword(ctx, user, password)
+	}
+	return user, nil
+}
+
+// rehashPassword upgrades the stored hash to the current parameters. The
+// login has already succeeded, so failures are only logged.
+func (s *SocialService) rehashPassword(ctx context.Context, user *model.User, password string) {
+	passwordHash, err := s.Passwords.Hash(password)
+	if err != nil {
+		s.Logger.Log(log.LevelError, "failed to rehash password", "user_id", user.ID.Hex(), "error", err)
+		return
+	}
+	if err := s.Repo.UpdatePassword(ctx, user.ID, passwordHash, time.Now().UTC()); err != nil {
+		s.Logger.Log(log.LevelError, "failed to store rehashed password", "user_id", user.ID.Hex(), "error", err)
+	}
+}
+
+// Login verifies the credentials and issues a new token pair.
+func (s *SocialService) Login(ctx context.Context, email, password string) (*model.User, *auth.TokenPair, error) {
+	user, err := s.VerifyCredentials(ctx, email, password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tokens, err := s.Tokens.Issue(user.ID.Hex())
+	if err != nil {
+		return nil, nil, errors.ToRpcError(err)
+	}
+	return user, tokens, nil
+}
+
+// RefreshTokens exchanges a refresh token for a new token pair, as long as
+// the account is still active.
+func (s *SocialService) RefreshTokens(ctx context.Context, refreshToken string) (*auth.TokenPair, error) {
+	claims, err := s.Tokens.Parse(refreshToken, auth.RefreshToken)
+	if err != nil {
+		return nil, errors.ErrInvalidToken
+	}
+	userID, err := primitive.ObjectIDFromHex(claims.Subject)
+	if err != nil {
+		return nil, errors.ErrInvalidToken
+	}
+
+	user, err := s.Repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, errors.ErrInvalidToken
+	}
+	if !canSignIn(user) {
+		return nil, errors.ErrAccountNotActive
+	}
+
+	tokens, err := s.Tokens.Issue(user.ID.Hex())
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+	return tokens, nil
+}
+
+// callerID returns the user authenticated by the auth middleware.
+func callerID(ctx context.Context) (primitive.ObjectID, error) {
+	principal, ok := auth.FromContext(ctx)
+	if !ok {
+		return primitive.NilObjectID, errors.ErrUnauthenticated
+	}
+	return principal.UserID, nil
+}
+
+// canSignIn lets self-excluded users sign in so they can still manage their
+// account; the tip and interaction RPCs turn them away.
+func canSignIn(user *model.User) bool {
+	return isActive(user) || user.Status == commonpb.AccountStatus_SelfExclude
+}
+
+// isActive treats users stored before account statuses existed as active.
+func isActive(user *model.User) bool {
+	return user.Status == commonpb.AccountStatus_Active || user.Status == commonpb.AccountStatus_AccountStatusNone
+}
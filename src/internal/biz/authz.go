@@ -0,0 +1,108 @@
+package biz
+
+import (
+	"context"
+
+	"src/internal/errors"
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// caller loads the account of the authenticated user so its role can be
+// checked.
+func (s *SocialService) caller(ctx context.Context) (*model.User, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.Repo.GetUser(ctx, userID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, errors.ErrUnauthenticated
+		}
+		return nil, err
+	}
+	return user, nil
+}
+
+// roleOf treats users stored before roles existed as punters.
+func roleOf(user *model.User) string {
+	if user.Role == "" {
+		return model.RolePunter
+	}
+	return user.Role
+}
+
+func isAdmin(user *model.User) bool {
+	return roleOf(user) == model.RoleAdmin
+}
+
+// canModerate reports whether the user may remove content written by others.
+func canModerate(user *model.User) bool {
+	role := roleOf(user)
+	return role == model.RoleModerator || role == model.RoleAdmin
+}
+
+// authorizeContentEdit allows only the author to change a tip or comment.
+func (s *SocialService) authorizeContentEdit(ctx context.Context, authorID string) error {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	if user.ID.Hex() != authorID {
+		return errors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// authorizeContentRemoval allows the author, moderators and admins to remove
+// a tip or comment, and returns the caller.
+func (s *SocialService) authorizeContentRemoval(ctx context.Context, authorID string) (*model.User, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID.Hex() != authorID && !canModerate(user) {
+		return nil, errors.ErrPermissionDenied
+	}
+	return user, nil
+}
+
+// authorizeSettlement allows the tipster, moderators and admins to settle a
+// tip, and returns the caller.
+func (s *SocialService) authorizeSettlement(ctx context.Context, tipsterID string) (*model.User, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if user.ID.Hex() != tipsterID && !canModerate(user) {
+		return nil, errors.ErrPermissionDenied
+	}
+	return user, nil
+}
+
+// authorizeAccountUpdate allows users to update their own account, and
+// admins to update any account.
+func (s *SocialService) authorizeAccountUpdate(ctx context.Context, userID primitive.ObjectID) error {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return err
+	}
+	if user.ID != userID && !isAdmin(user) {
+		return errors.ErrPermissionDenied
+	}
+	return nil
+}
+
+// requireAdmin returns the caller if they are an admin.
+func (s *SocialService) requireAdmin(ctx context.Context) (*model.User, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin(user) {
+		return nil, errors.ErrPermissionDenied
+	}
+	return user, nil
+}
@@ -2,16 +2,30 @@ package biz
 
 import (
 	"context"
+	"maps"
+	"slices"
 	"src/internal/errors"
 	"src/internal/model"
 	pb "src/protos/Tipster"
 	"time"
 
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func (s *SocialService) CreateComment(ctx context.Context, req *pb.CommentOnTipRequest) (*pb.CommentInfo, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tipID, err := primitive.ObjectIDFromHex(req.TipId)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	if err := s.checkTipNotBlocked(ctx, tipID, userID); err != nil {
+		return nil, err
+	}
 	currentTime := time.Now().UTC()
 	newCommentID := primitive.NewObjectID()
 	// If parentId is empty, use the new comment's ID as parentId
@@ -22,7 +36,7 @@ func (s *SocialService) CreateComment(ctx context.Context, req *pb.CommentOnTipR
 	comment := &model.Comment{
 		ID:        newCommentID,
 		TipID:     req.TipId,
-		UserID:    req.UserId,
+		UserID:    userID.Hex(),
 		ParentID:  parentID,
 		Content:   req.Content,
 		Likes:     []primitive.ObjectID{},
@@ -35,11 +49,12 @@ func (s *SocialService) CreateComment(ctx context.Context, req *pb.CommentOnTipR
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
+	s.recordFeedEvent(ctx, userID.Hex(), model.FeedActionCommentTip, req.TipId, commentID.Hex())
 
 	return &pb.CommentInfo{
 		CommentId: commentID.Hex(),
 		TipId:     req.TipId,
-		UserId:    req.UserId,
+		UserId:    userID.Hex(),
 		ParentId:  parentID,
 		Content:   req.Content,
 		CreatedAt: timestamppb.New(currentTime),
@@ -50,16 +65,42 @@ func (s *SocialService) CreateComment(ctx context.Context, req *pb.CommentOnTipR
 }
 
 func (s *SocialService) UpdateComment(ctx context.Context, commentID primitive.ObjectID, req *pb.UpdateCommentRequest) error {
+	comment, err := s.Repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if err := s.authorizeContentEdit(ctx, comment.UserID); err != nil {
+		return err
+	}
+
 	currentTime := time.Now().UTC()
 	return s.Repo.UpdateComment(ctx, commentID, req.Content, currentTime)
 }
 
 func (s *SocialService) DeleteComment(ctx context.Context, commentID primitive.ObjectID) error {
+	comment, err := s.Repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.authorizeContentRemoval(ctx, comment.UserID); err != nil {
+		return err
+	}
+
 	return s.Repo.DeleteComment(ctx, commentID)
 }
 
+// ListTipComments returns the comments on the tip, hiding those of users the
+// caller has blocked or muted. Comments on tips the caller may not see are
+// reported as missing.
 func (s *SocialService) ListTipComments(ctx context.Context, tipID string) ([]*model.Comment, error) {
-	comments, err := s.Repo.ListTipComments(ctx, tipID)
+	if err := s.checkTipVisible(ctx, tipID); err != nil {
+		return nil, err
+	}
+	hiddenIDs, err := s.hiddenAuthorIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	comments, err := s.Repo.ListTipComments(ctx, tipID, hiddenIDs)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
@@ -67,19 +108,45 @@ func (s *SocialService) ListTipComments(ctx context.Context, tipID string) ([]*m
 	return comments, nil
 }
 
-func (s *SocialService) LikeComment(ctx context.Context, commentID primitive.ObjectID, userID primitive.ObjectID) (int32, error) {
+func (s *SocialService) LikeComment(ctx context.Context, commentID primitive.ObjectID) (int32, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkCommentNotBlocked(ctx, commentID, userID); err != nil {
+		return 0, err
+	}
 	return s.Repo.LikeComment(ctx, commentID, userID)
 }
 
-func (s *SocialService) UnlikeComment(ctx context.Context, commentID primitive.ObjectID, userID primitive.ObjectID) (int32, error) {
+func (s *SocialService) UnlikeComment(ctx context.Context, commentID primitive.ObjectID) (int32, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkCommentNotBlocked(ctx, commentID, userID); err != nil {
+		return 0, err
+	}
 	return s.Repo.UnlikeComment(ctx, commentID, userID)
 }
 
 func (s *SocialService) CreateReply(ctx context.Context, req *pb.ReplyCommentRequest) (*pb.ReplyCommentResponse_ReplyData, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	parentID, err := primitive.ObjectIDFromHex(req.ParentCommentId)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	if err := s.checkCommentNotBlocked(ctx, parentID, userID); err != nil {
+		return nil, err
+	}
+
 	// Create new reply document
 	currentTime := time.Now().UTC()
 	reply := &model.Comment{
-		UserID:    req.UserId,
+		UserID:    userID.Hex(),
 		ParentID:  req.ParentCommentId,
 		Content:   req.Content,
 		Likes:     []primitive.ObjectID{},
@@ -96,16 +163,142 @@ func (s *SocialService) CreateReply(ctx context.Context, req *pb.ReplyCommentReq
 	return &pb.ReplyCommentResponse_ReplyData{
 		ReplyId:         replyID.Hex(),
 		ParentCommentId: req.ParentCommentId,
-		UserId:          req.UserId,
+		UserId:          userID.Hex(),
 		Content:         req.Content,
 		DateCreated:     timestamppb.New(currentTime),
 	}, nil
 }
 
+// ListReplies returns the replies to the comment, hiding those of users the
+// caller has blocked or muted. Replies on tips the caller may not see are
+// reported as missing.
 func (s *SocialService) ListReplies(ctx context.Context, parentCommentID string) ([]*model.Comment, error) {
-	return s.Repo.ListReplies(ctx, parentCommentID)
+	parentID, err := primitive.ObjectIDFromHex(parentCommentID)
+	if err != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	tipID, err := s.commentTipID(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkTipVisible(ctx, tipID.Hex()); err != nil {
+		return nil, err
+	}
+	hiddenIDs, err := s.hiddenAuthorIDs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.Repo.ListReplies(ctx, parentCommentID, hiddenIDs)
 }
 
+// ListComments returns a page of all comments and replies, hiding those of
+// users the caller has blocked or muted and those on tips the caller may
+// not see.
 func (s *SocialService) ListComments(ctx context.Context, pageSize int64, nextCursor string) ([]*model.Comment, string, error) {
-	return s.Repo.ListComments(ctx, pageSize, nextCursor)
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	hiddenIDs, err := s.hiddenAuthorIDs(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	comments, nextCursor, err := s.Repo.ListComments(ctx, hiddenIDs, pageSize, nextCursor)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+
+	// The cursor was taken before, so a short page does not end the list
+	tipIDs, err := s.commentTipIDs(ctx, comments)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	visible, err := s.visibleTipIDs(ctx, viewerID, slices.Collect(maps.Values(tipIDs)))
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	comments = slices.DeleteFunc(comments, func(comment *model.Comment) bool {
+		tipID, ok := tipIDs[comment.ID]
+		return !ok || !visible[tipID.Hex()]
+	})
+	return comments, nextCursor, nil
+}
+
+// commentTipIDs returns the tip of each comment, walking up the threads of
+// replies whose parent is not among the comments. Comments whose thread is
+// broken are left out.
+func (s *SocialService) commentTipIDs(ctx context.Context, comments []*model.Comment) (map[primitive.ObjectID]primitive.ObjectID, error) {
+	tipIDs := make(map[primitive.ObjectID]primitive.ObjectID, len(comments))
+	// Comments come oldest first, so parents are resolved before replies
+	for _, comment := range comments {
+		if comment.TipID != "" {
+			if tipID, err := primitive.ObjectIDFromHex(comment.TipID); err == nil {
+				tipIDs[comment.ID] = tipID
+			}
+			continue
+		}
+		parentID, err := primitive.ObjectIDFromHex(comment.ParentID)
+		if err != nil {
+			continue
+		}
+		if tipID, ok := tipIDs[parentID]; ok {
+			tipIDs[comment.ID] = tipID
+			continue
+		}
+		tipID, err := s.commentTipID(ctx, parentID)
+		if errors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		tipIDs[comment.ID] = tipID
+	}
+	return tipIDs, nil
+}
+
+// checkCommentNotBlocked returns ErrBlocked if the comment's author has
+// blocked the user. Comments on tips the user may not see are reported as
+// missing.
+func (s *SocialService) checkCommentNotBlocked(ctx context.Context, commentID, userID primitive.ObjectID) error {
+	comment, err := s.Repo.GetComment(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	tipID, err := s.commentTipID(ctx, commentID)
+	if err != nil {
+		return err
+	}
+	if _, err := s.visibleTip(ctx, userID, tipID); err != nil {
+		return err
+	}
+	return s.checkNotBlocked(ctx, comment.UserID, userID)
+}
+
+// checkTipVisible returns ErrNoDocuments unless the caller may see the tip.
+func (s *SocialService) checkTipVisible(ctx context.Context, tipID string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+	tipObjID, err := primitive.ObjectIDFromHex(tipID)
+	if err != nil {
+		return mongo.ErrNoDocuments
+	}
+	_, err = s.visibleTip(ctx, userID, tipObjID)
+	return err
+}
+
+// hiddenAuthorIDs returns the hex IDs of the users the caller has blocked
+// or muted.
+func (s *SocialService) hiddenAuthorIDs(ctx context.Context) ([]string, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hiddenIDs, err := s.Repo.ListRestrictedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+	return hexIDs(hiddenIDs), nil
 }
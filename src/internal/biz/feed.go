@@ -0,0 +1,138 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"src/internal/errors"
+	"src/internal/model"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// recordFeedEvent stores an activity event for the author's followers.
+// The action that triggered it has already succeeded, so failures are
+// logged rather than returned to the caller.
+func (s *SocialService) recordFeedEvent(ctx context.Context, authorID, action, targetID, extraInfo string) {
+	authorObjID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		s.Logger.Log(log.LevelWarn, "skipping feed event with invalid author ID", "author_id", authorID, "action", action)
+		return
+	}
+
+	event := &model.FeedEvent{
+		AuthorID:  authorObjID,
+		Action:    action,
+		TargetID:  targetID,
+		ExtraInfo: extraInfo,
+		CreatedAt: time.Now().UTC(),
+	}
+	if _, err := s.Repo.CreateFeedEvent(ctx, event); err != nil {
+		s.Logger.Log(log.LevelError, "failed to record feed event", "action", action, "target_id", targetID, "error", err)
+	}
+}
+
+func (s *SocialService) ListFollowingFeed(ctx context.Context, pageSize int64, nextCursor string) ([]*model.FeedEvent, string, error) {
+	userID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	hiddenIDs, err := s.Repo.ListRestrictedUserIDs(ctx, userID)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	events, nextCursor, err := s.Repo.ListFollowingFeed(ctx, userID, hiddenIDs, pageSize, nextCursor)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+
+	// Drop events about tips and shares the visibility policy hides. The
+	// cursor was taken before, so a short page does not end the feed.
+	visible, err := s.visibleTargets(ctx, userID, events)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	visibleShares, err := s.visibleShares(ctx, userID, events)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	events = slices.DeleteFunc(events, func(event *model.FeedEvent) bool {
+		if !visible[event.TargetID] {
+			return true
+		}
+		// Shares recorded before they were stored on their own carry the
+		// share type instead of a share ID
+		if event.Action == model.FeedActionShareTip && primitive.IsValidObjectID(event.ExtraInfo) {
+			return !visibleShares[event.ExtraInfo]
+		}
+		return false
+	})
+	return events, nextCursor, nil
+}
+
+// visibleShares returns the hex IDs of the events' shares the viewer may
+// see.
+func (s *SocialService) visibleShares(ctx context.Context, viewerID primitive.ObjectID, events []*model.FeedEvent) (map[string]bool, error) {
+	shareIDs := []primitive.ObjectID{}
+	for _, event := range events {
+		if event.Action != model.FeedActionShareTip {
+			continue
+		}
+		if shareID, err := primitive.ObjectIDFromHex(event.ExtraInfo); err == nil {
+			shareIDs = append(shareIDs, shareID)
+		}
+	}
+	visible := map[string]bool{}
+	if len(shareIDs) == 0 {
+		return visible, nil
+	}
+
+	filter, err := s.visibleSharesFilter(ctx, viewerID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = bson.M{"$in": shareIDs}
+	shares, _, err := s.Repo.ListShares(ctx, filter, int64(len(shareIDs)), "")
+	if err != nil {
+		return nil, err
+	}
+	for _, share := range shares {
+		visible[share.ID.Hex()] = true
+	}
+	return visible, nil
+}
+
+// visibleTargets returns the hex IDs of the events' tips the viewer may see.
+func (s *SocialService) visibleTargets(ctx context.Context, viewerID primitive.ObjectID, events []*model.FeedEvent) (map[string]bool, error) {
+	tipIDs := make([]primitive.ObjectID, 0, len(events))
+	for _, event := range events {
+		if tipID, err := primitive.ObjectIDFromHex(event.TargetID); err == nil {
+			tipIDs = append(tipIDs, tipID)
+		}
+	}
+	return s.visibleTipIDs(ctx, viewerID, tipIDs)
+}
+
+// visibleTipIDs returns the hex IDs of the tips the viewer may see.
+func (s *SocialService) visibleTipIDs(ctx context.Context, viewerID primitive.ObjectID, tipIDs []primitive.ObjectID) (map[string]bool, error) {
+	visible := map[string]bool{}
+	if len(tipIDs) == 0 {
+		return visible, nil
+	}
+
+	filter, err := s.visibleTipsFilter(ctx, viewerID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	filter["_id"] = bson.M{"$in": tipIDs}
+	tips, _, err := s.Repo.ListTips(ctx, filter, int64(len(tipIDs)), "")
+	if err != nil {
+		return nil, err
+	}
+	for _, tip := range tips {
+		visible[tip.ID.Hex()] = true
+	}
+	return visible, nil
+}
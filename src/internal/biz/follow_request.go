@@ -0,0 +1,86 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"src/internal/model"
+	pb "src/protos/Tipster"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// requestFollow asks a private tipster for approval, unless the user
+// already follows them.
+func (s *SocialService) requestFollow(ctx context.Context, userID, tipsterID primitive.ObjectID) (*pb.FollowTipsterResponse_FollowData, error) {
+	data := &pb.FollowTipsterResponse_FollowData{
+		TipsterId: tipsterID.Hex(),
+		UserId:    userID.Hex(),
+	}
+
+	following, err := s.Repo.IsFollowing(ctx, userID, tipsterID)
+	if err != nil {
+		return nil, err
+	}
+	if following {
+		data.IsFollowing = true
+		data.AlreadyFollowing = true
+		return data, nil
+	}
+
+	_, err = s.Repo.CreateFollowRequest(ctx, &model.FollowRequest{
+		RequesterID: userID,
+		TargetID:    tipsterID,
+		CreatedAt:   time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	data.Pending = true
+	return data, nil
+}
+
+// ListFollowRequests returns the pending requests to follow the caller.
+func (s *SocialService) ListFollowRequests(ctx context.Context, pageSize int64, nextCursor string) ([]*model.FollowRequest, string, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	return s.Repo.ListFollowRequests(ctx, userID, pageSize, nextCursor)
+}
+
+// ApproveFollowRequest makes the requester a follower of the caller.
+func (s *SocialService) ApproveFollowRequest(ctx context.Context, requestID primitive.ObjectID) error {
+	request, err := s.ownFollowRequest(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	return s.Repo.ApproveFollowRequest(ctx, request)
+}
+
+// RejectFollowRequest drops the request without telling the requester.
+func (s *SocialService) RejectFollowRequest(ctx context.Context, requestID primitive.ObjectID) error {
+	request, err := s.ownFollowRequest(ctx, requestID)
+	if err != nil {
+		return err
+	}
+	return s.Repo.RejectFollowRequest(ctx, request.ID)
+}
+
+// ownFollowRequest loads a request addressed to the caller. Requests to
+// other users are reported as missing.
+func (s *SocialService) ownFollowRequest(ctx context.Context, requestID primitive.ObjectID) (*model.FollowRequest, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	request, err := s.Repo.GetFollowRequest(ctx, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if request.TargetID != userID {
+		return nil, mongo.ErrNoDocuments
+	}
+	return request, nil
+}
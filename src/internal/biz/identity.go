@@ -0,0 +1,133 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"src/internal/auth"
+	"src/internal/errors"
+	"src/internal/model"
+	commonpb "src/protos/YM.Common"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// verifyIdentity checks the credential with the provider's verifier.
+func (s *SocialService) verifyIdentity(ctx context.Context, provider commonpb.IdendityProvider, credential string) (*auth.ExternalIdentity, error) {
+	verifier, ok := s.Identities[provider]
+	if !ok {
+		return nil, errors.ErrIdentityProviderDisabled
+	}
+	identity, err := verifier.Verify(ctx, credential)
+	if err != nil {
+		s.Logger.Log(log.LevelWarn, "identity verification failed", "provider", provider.String(), "error", err)
+		return nil, errors.ErrInvalidIdentity
+	}
+	return identity, nil
+}
+
+func newIdentity(external *auth.ExternalIdentity, linkedAt time.Time) model.Identity {
+	identity := model.Identity{
+		Key:      auth.IdentityKey(external.Provider, external.Subject),
+		Provider: external.Provider,
+		Subject:  external.Subject,
+		LinkedAt: linkedAt,
+	}
+	if external.EmailVerified {
+		identity.Email = external.Email
+	}
+	return identity
+}
+
+// LoginWithIdentity signs in the user linked to the external identity. On
+// first sign-in a new account is created for it; created reports whether
+// that happened. An identity whose email already belongs to an account is
+// not linked automatically, the owner has to sign in and call LinkIdentity.
+func (s *SocialService) LoginWithIdentity(ctx context.Context, provider commonpb.IdendityProvider, credential string) (user *model.User, tokens *auth.TokenPair, created bool, err error) {
+	external, err := s.verifyIdentity(ctx, provider, credential)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	key := auth.IdentityKey(external.Provider, external.Subject)
+
+	user, err = s.Repo.GetUserByIdentity(ctx, key)
+	if errors.IsNotFound(err) {
+		user, err = s.createIdentityUser(ctx, external)
+		if mongo.IsDuplicateKeyError(err) {
+			// A concurrent first sign-in created the account
+			user, err = s.Repo.GetUserByIdentity(ctx, key)
+		} else {
+			created = err == nil
+		}
+	}
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	if !canSignIn(user) {
+		return nil, nil, false, errors.ErrAccountNotActive
+	}
+	tokens, err = s.Tokens.Issue(user.ID.Hex())
+	if err != nil {
+		return nil, nil, false, errors.ToRpcError(err)
+	}
+	return user, tokens, created, nil
+}
+
+func (s *SocialService) createIdentityUser(ctx context.Context, external *auth.ExternalIdentity) (*model.User, error) {
+	currentTime := time.Now().UTC()
+	identity := newIdentity(external, currentTime)
+
+	username := external.Name
+	if username == "" {
+		username = external.Provider.String() + " user"
+	}
+
+	user := &model.User{
+		ID:         primitive.NewObjectID(),
+		Username:   username,
+		Email:      identity.Email,
+		Tags:       []string{},
+		Status:     commonpb.AccountStatus_Active,
+		Role:       model.RolePunter,
+		Identities: []model.Identity{identity},
+		CreatedAt:  currentTime,
+		UpdatedAt:  currentTime,
+	}
+	userID, err := s.Repo.CreateUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if userID == "exist" {
+		return nil, errors.ErrEmailAlreadyExists
+	}
+	return user, nil
+}
+
+// LinkIdentity adds an external identity to the caller's account. Each
+// provider can be linked once per account.
+func (s *SocialService) LinkIdentity(ctx context.Context, provider commonpb.IdendityProvider, credential string) (*model.User, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !canSignIn(user) {
+		return nil, errors.ErrAccountNotActive
+	}
+
+	external, err := s.verifyIdentity(ctx, provider, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.Repo.AddIdentity(ctx, user.ID, newIdentity(external, time.Now().UTC()))
+	if errors.IsNotFound(err) || mongo.IsDuplicateKeyError(err) {
+		return nil, errors.ErrIdentityAlreadyLinked
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.Repo.GetUser(ctx, user.ID)
+}
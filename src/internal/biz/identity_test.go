@@ -0,0 +1,130 @@
+package biz
+
+import (
+	"context"
+	"testing"
+
+	"src/internal/auth"
+	"src/internal/auth/authtest"
+	"src/internal/conf"
+	"src/internal/errors"
+	commonpb "src/protos/YM.Common"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type identityFixture struct {
+	service  *SocialService
+	repo     *fakeRepo
+	google   *authtest.IdentityIssuer
+	telegram *authtest.IdentityIssuer
+}
+
+func newIdentityFixture(t *testing.T) *identityFixture {
+	t.Helper()
+	tokens, err := auth.NewTokenIssuer(&conf.Auth_Token{Secret: "test-secret-of-at-least-32-bytes", Issuer: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &identityFixture{
+		repo:     newFakeRepo(),
+		google:   authtest.NewIdentityIssuer(commonpb.IdendityProvider_Google),
+		telegram: authtest.NewIdentityIssuer(commonpb.IdendityProvider_Telegram),
+	}
+	f.service = &SocialService{
+		Repo:   f.repo,
+		Tokens: tokens,
+		Identities: auth.IdentityVerifiers{
+			commonpb.IdendityProvider_Google:   f.google,
+			commonpb.IdendityProvider_Telegram: f.telegram,
+		},
+		Logger: log.DefaultLogger,
+	}
+	return f
+}
+
+func signedIn(userID primitive.ObjectID) context.Context {
+	return auth.NewContext(context.Background(), &auth.Principal{UserID: userID})
+}
+
+func TestLoginWithIdentityCreatesUserOnFirstSignIn(t *testing.T) {
+	f := newIdentityFixture(t)
+	credential := f.google.Issue("google-subject", "punter@example.com")
+
+	user, tokens, created, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Google, credential)
+	if err != nil {
+		t.Fatalf("first sign-in: %v", err)
+	}
+	if !created {
+		t.Error("first sign-in did not report a created account")
+	}
+	if tokens == nil || tokens.AccessToken == "" {
+		t.Error("first sign-in issued no tokens")
+	}
+	if user.Email != "punter@example.com" {
+		t.Errorf("email = %q, want the verified email of the identity", user.Email)
+	}
+	if len(user.Identities) != 1 || user.Identities[0].Key != auth.IdentityKey(commonpb.IdendityProvider_Google, "google-subject") {
+		t.Errorf("identities = %+v, want the Google identity", user.Identities)
+	}
+
+	again, _, created, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Google, credential)
+	if err != nil {
+		t.Fatalf("second sign-in: %v", err)
+	}
+	if created || again.ID != user.ID {
+		t.Errorf("second sign-in created = %v, user = %s; want the existing account %s", created, again.ID.Hex(), user.ID.Hex())
+	}
+}
+
+func TestLinkIdentityAddsSecondProviderToAccount(t *testing.T) {
+	f := newIdentityFixture(t)
+	user, _, _, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Google, f.google.Issue("google-subject", ""))
+	if err != nil {
+		t.Fatalf("sign-in: %v", err)
+	}
+
+	telegramCredential := f.telegram.Issue("telegram-subject", "")
+	linked, err := f.service.LinkIdentity(signedIn(user.ID), commonpb.IdendityProvider_Telegram, telegramCredential)
+	if err != nil {
+		t.Fatalf("link: %v", err)
+	}
+	if len(linked.Identities) != 2 {
+		t.Errorf("identities = %+v, want Google and Telegram", linked.Identities)
+	}
+
+	viaTelegram, _, created, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Telegram, telegramCredential)
+	if err != nil {
+		t.Fatalf("sign-in with the linked provider: %v", err)
+	}
+	if created || viaTelegram.ID != user.ID {
+		t.Errorf("sign-in with the linked provider created = %v, user = %s; want the existing account %s", created, viaTelegram.ID.Hex(), user.ID.Hex())
+	}
+}
+
+func TestLinkIdentityRejectsSubjectLinkedElsewhere(t *testing.T) {
+	f := newIdentityFixture(t)
+	googleCredential := f.google.Issue("google-subject", "")
+	owner, _, _, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Google, googleCredential)
+	if err != nil {
+		t.Fatalf("owner sign-in: %v", err)
+	}
+	other, _, _, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Telegram, f.telegram.Issue("telegram-subject", ""))
+	if err != nil {
+		t.Fatalf("other sign-in: %v", err)
+	}
+
+	_, err = f.service.LinkIdentity(signedIn(other.ID), commonpb.IdendityProvider_Google, googleCredential)
+	if err != errors.ErrIdentityAlreadyLinked {
+		t.Fatalf("err = %v, want ErrIdentityAlreadyLinked", err)
+	}
+	unchanged, _ := f.repo.GetUser(context.Background(), other.ID)
+	if len(unchanged.Identities) != 1 {
+		t.Errorf("identities = %+v, want only Telegram", unchanged.Identities)
+	}
+	stillOwner, _, _, err := f.service.LoginWithIdentity(context.Background(), commonpb.IdendityProvider_Google, googleCredential)
+	if err != nil || stillOwner.ID != owner.ID {
+		t.Errorf("Google sign-in = %v, %v; want the original owner %s", stillOwner, err, owner.ID.Hex())
+	}
+}
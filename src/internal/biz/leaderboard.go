@@ -0,0 +1,129 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"src/internal/errors"
+	"src/internal/model"
+	pb "src/protos/Tipster"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// defaultLeaderboardMinTips keeps tipsters with a lucky handful of tips off
+// the leaderboards unless the caller asks for them. Only decided tips count,
+// void and pushed tips are never priced.
+const defaultLeaderboardMinTips = 10
+
+// leaderboardSortFields are the entry fields each metric ranks by.
+var leaderboardSortFields = map[pb.LeaderboardMetric]string{
+	pb.LeaderboardMetric_LEADERBOARD_METRIC_PROFIT:      "stats.profit",
+	pb.LeaderboardMetric_LEADERBOARD_METRIC_ROI:         "stats.roi",
+	pb.LeaderboardMetric_LEADERBOARD_METRIC_STRIKE_RATE: "stats.strikeRate",
+}
+
+// ListLeaderboard returns a page of the latest leaderboard of the window,
+// ranked by the metric. Unset windows list the last 30 days and unset
+// metrics rank by profit. Tipsters the caller cannot see are left out of the
+// page without changing the ranks of the others. The snapshot is nil until
+// the window has been computed once. Entries are scoped by sport or by tag,
+// so asking for both is rejected rather than listing an empty board.
+func (s *SocialService) ListLeaderboard(ctx context.Context, req *pb.ListLeaderboardRequest, pageSize int64) (*model.LeaderboardSnapshot, []*model.LeaderboardEntry, string, error) {
+	if req.Sport != "" && req.Tag != "" {
+		return nil, nil, "", errors.ErrInvalidLeaderboardFilter
+	}
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, nil, "", err
+	}
+
+	var snapshot *model.LeaderboardSnapshot
+	var offset int64
+	if req.NextCursor != "" {
+		var snapshotID primitive.ObjectID
+		snapshotID, offset, err = parseLeaderboardCursor(req.NextCursor)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		snapshot, err = s.Repo.GetLeaderboardSnapshot(ctx, snapshotID)
+	} else {
+		snapshot, err = s.Repo.GetLatestLeaderboardSnapshot(ctx, leaderboardWindowOf(req.Window))
+		if errors.IsNotFound(err) {
+			return nil, []*model.LeaderboardEntry{}, "", nil
+		}
+	}
+	if err != nil {
+		return nil, nil, "", errors.ToRpcError(err)
+	}
+
+	minTips := int64(req.MinTips)
+	if minTips <= 0 {
+		minTips = defaultLeaderboardMinTips
+	}
+	sortField, ok := leaderboardSortFields[req.Metric]
+	if !ok {
+		sortField = leaderboardSortFields[pb.LeaderboardMetric_LEADERBOARD_METRIC_PROFIT]
+	}
+	filter := bson.M{
+		"sport":             req.Sport,
+		"tag":               req.Tag,
+		"stats.decidedTips": bson.M{"$gte": minTips},
+	}
+	entries, err := s.Repo.ListLeaderboardEntries(ctx, snapshot.ID, filter, sortField, offset, pageSize)
+	if err != nil {
+		return nil, nil, "", errors.ToRpcError(err)
+	}
+
+	nextCursor := ""
+	if int64(len(entries)) == pageSize {
+		nextCursor = leaderboardCursor(snapshot.ID, offset+pageSize)
+	}
+
+	hiddenIDs, err := s.Repo.ListRestrictedUserIDs(ctx, viewerID)
+	if err != nil {
+		return nil, nil, "", errors.ToRpcError(err)
+	}
+	hidden := map[string]bool{}
+	for _, id := range hiddenIDs {
+		hidden[id.Hex()] = true
+	}
+	visible := make([]*model.LeaderboardEntry, 0, len(entries))
+	for i, entry := range entries {
+		entry.Rank = offset + int64(i) + 1
+		if !hidden[entry.Stats.TipsterID] {
+			visible = append(visible, entry)
+		}
+	}
+	return snapshot, visible, nextCursor, nil
+}
+
+// leaderboardWindowOf converts a requested window to the stored one.
+func leaderboardWindowOf(window pb.LeaderboardWindow) string {
+	if window == pb.LeaderboardWindow_LEADERBOARD_WINDOW_UNSPECIFIED {
+		return model.WindowLast30Days
+	}
+	return strings.TrimPrefix(window.String(), "LEADERBOARD_WINDOW_")
+}
+
+// Leaderboard cursors pin the snapshot, so every page of a listing comes
+// from the same run of the leaderboard job.
+func leaderboardCursor(snapshotID primitive.ObjectID, offset int64) string {
+	return fmt.Sprintf("%s:%d", snapshotID.Hex(), offset)
+}
+
+func parseLeaderboardCursor(cursor string) (primitive.ObjectID, int64, error) {
+	snapshotHex, offsetText, _ := strings.Cut(cursor, ":")
+	snapshotID, err := primitive.ObjectIDFromHex(snapshotHex)
+	if err != nil {
+		return primitive.NilObjectID, 0, errors.ErrInvalidCursor
+	}
+	offset, err := strconv.ParseInt(offsetText, 10, 64)
+	if err != nil || offset < 0 {
+		return primitive.NilObjectID, 0, errors.ErrInvalidCursor
+	}
+	return snapshotID, offset, nil
+}
@@ -0,0 +1,154 @@
+package biz
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"src/internal/errors"
+	"src/internal/ledger"
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ledgerTipBatch bounds how many tips are loaded at once while a ledger is
+// verified.
+const ledgerTipBatch = 500
+
+// tipEntries are the entries a ledger holds for one tip, any of which may
+// be missing.
+type tipEntries struct {
+	created, settled, deleted *model.LedgerEntry
+}
+
+// last returns the latest of the entries.
+func (e *tipEntries) last() *model.LedgerEntry {
+	last := e.created
+	for _, entry := range []*model.LedgerEntry{e.settled, e.deleted} {
+		if entry != nil && (last == nil || entry.Sequence > last.Sequence) {
+			last = entry
+		}
+	}
+	return last
+}
+
+// VerifyTipsterLedger recomputes the tipster's ledger and checks the tips
+// it recorded against it. Besides breaks in the chain it reports tips that
+// were deleted without a deleted entry, and tips whose content at posting
+// or whose settlement no longer match their entries. Tips posted before the
+// ledger existed are not covered.
+func (s *SocialService) VerifyTipsterLedger(ctx context.Context, tipsterID primitive.ObjectID) (*ledger.Verifier, error) {
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// The ledger outlives the account, so deleted and purged tipsters can
+	// still be verified
+	tipster, err := s.Repo.GetUser(ctx, tipsterID)
+	purged := errors.IsNotFound(err)
+	if err != nil && !purged {
+		return nil, err
+	}
+	if !purged && tipster.Deletion == nil {
+		if err := s.checkNotBlocked(ctx, tipsterID.Hex(), viewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	verifier := &ledger.Verifier{}
+	var tipIDs []primitive.ObjectID
+	recorded := map[primitive.ObjectID]*tipEntries{}
+	err = s.Repo.ForEachLedgerEntry(ctx, tipsterID.Hex(), func(entry *model.LedgerEntry) error {
+		verifier.Add(entry)
+		entries := recorded[entry.TipID]
+		if entries == nil {
+			entries = &tipEntries{}
+			recorded[entry.TipID] = entries
+			tipIDs = append(tipIDs, entry.TipID)
+		}
+		switch entry.Kind {
+		case model.LedgerTipCreated:
+			entries.created = entry
+		case model.LedgerTipSettled:
+			entries.settled = entry
+		case model.LedgerTipDeleted:
+			entries.deleted = entry
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+	if purged && verifier.Entries() == 0 {
+		return nil, errors.ToRpcError(mongo.ErrNoDocuments)
+	}
+
+	for start := 0; start < len(tipIDs); start += ledgerTipBatch {
+		batch := tipIDs[start:min(start+ledgerTipBatch, len(tipIDs))]
+		tips, _, err := s.Repo.ListTips(ctx, bson.M{"_id": bson.M{"$in": batch}}, 0, "")
+		if err != nil {
+			return nil, errors.ToRpcError(err)
+		}
+		found := make(map[primitive.ObjectID]*model.Tip, len(tips))
+		for _, tip := range tips {
+			found[tip.ID] = tip
+		}
+		for _, tipID := range batch {
+			err := s.checkLedgerTip(ctx, verifier, found[tipID], recorded[tipID])
+			if err != nil {
+				return nil, errors.ToRpcError(err)
+			}
+		}
+	}
+	return verifier, nil
+}
+
+// checkLedgerTip reports a tip that was deleted without a deleted entry, or
+// that no longer matches its entries. The content at posting is the tip
+// before its first revision. Deleted tips are kept, so they are checked like
+// any other unless they were removed with their tipster's account.
+func (s *SocialService) checkLedgerTip(ctx context.Context, verifier *ledger.Verifier, tip *model.Tip, entries *tipEntries) error {
+	created, settled := entries.created, entries.settled
+	if entries.deleted == nil && (tip == nil || tip.DeletedAt != nil) {
+		detail := "Tip was deleted"
+		if settled != nil && settled.Payload.Settlement != nil {
+			detail = fmt.Sprintf("Tip was deleted after it was settled as %s", strings.ToLower(settled.Payload.Settlement.Result))
+		}
+		verifier.Report(ledger.TipDeleted, entries.last(), detail)
+	}
+	if tip == nil {
+		return nil
+	}
+	if entries.deleted != nil && tip.DeletedAt == nil {
+		verifier.Report(ledger.TipMutated, entries.deleted, "Tip was restored after it was deleted")
+	}
+
+	if created != nil {
+		posted := *tip
+		if tip.Revisions > 0 {
+			revisions, _, err := s.Repo.ListTipRevisions(ctx, tip.ID, 1, "")
+			if err != nil {
+				return err
+			}
+			if len(revisions) > 0 {
+				before := revisions[0].Before
+				posted.Title, posted.Content, posted.Tags = before.Title, before.Content, before.Tags
+				posted.ShareType, posted.Selection = before.ShareType, before.Selection
+			}
+		}
+		if !ledger.SamePayload(created.Payload, ledger.CreatedPayload(&posted)) {
+			verifier.Report(ledger.TipMutated, created, "Tip no longer matches what was posted")
+		}
+	}
+
+	switch {
+	case settled != nil && !ledger.SamePayload(settled.Payload, ledger.SettledPayload(tip)):
+		verifier.Report(ledger.TipMutated, settled, "Settlement no longer matches what was recorded")
+	case settled == nil && created != nil && tip.Settlement != nil:
+		verifier.Report(ledger.TipMutated, created, "Tip was settled without a ledger entry")
+	}
+	return nil
+}
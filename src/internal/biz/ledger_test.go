@@ -0,0 +1,238 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/ledger"
+	"src/internal/model"
+	commonpb "src/protos/YM.Common"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+type ledgerFixture struct {
+	service *SocialService
+	repo    *fakeRepo
+	tipster *model.User
+	now     time.Time
+}
+
+func newLedgerFixture(t *testing.T) *ledgerFixture {
+	t.Helper()
+	repo := newFakeRepo()
+	tipster := &model.User{ID: primitive.NewObjectID(), Status: commonpb.AccountStatus_Active}
+	repo.users[tipster.ID] = tipster
+	return &ledgerFixture{
+		service: &SocialService{Repo: repo, Logger: log.DefaultLogger},
+		repo:    repo,
+		tipster: tipster,
+		now:     time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC),
+	}
+}
+
+// post stores the tip and appends its created entry, as CreateTip does.
+func (f *ledgerFixture) post(t *testing.T, title string) *model.Tip {
+	t.Helper()
+	tip := &model.Tip{
+		ID:        primitive.NewObjectID(),
+		TipsterID: f.tipster.ID.Hex(),
+		Title:     title,
+		Content:   "Home side to win",
+		CreatedAt: f.now,
+		Selection: &model.Selection{
+			Sport:      "Football",
+			EventName:  "Home v Away",
+			EventStart: f.now.Add(time.Hour),
+			Market:     "Match Result",
+			Pick:       "Home",
+			Odds:       model.Odds{Num: 5, Den: 2},
+			Stake:      1,
+		},
+	}
+	f.repo.tips[tip.ID] = tip
+	f.append(t, ledger.Created(tip, f.now))
+	return tip
+}
+
+// settle settles the tip and appends its settled entry, as SettleTip does.
+func (f *ledgerFixture) settle(t *testing.T, tip *model.Tip, result string, profit float64) {
+	t.Helper()
+	tip.Settlement = &model.Settlement{Result: result, Profit: profit, SettledAt: f.now.Add(2 * time.Hour)}
+	f.append(t, ledger.Settled(tip, f.now.Add(2*time.Hour)))
+}
+
+// remove deletes the tip and appends its deleted entry, as DeleteTip does.
+func (f *ledgerFixture) remove(t *testing.T, tip *model.Tip) {
+	t.Helper()
+	deletedAt := f.now.Add(3 * time.Hour)
+	tip.DeletedAt = &deletedAt
+	f.append(t, ledger.Deleted(tip, deletedAt))
+}
+
+func (f *ledgerFixture) append(t *testing.T, entry *model.LedgerEntry) {
+	t.Helper()
+	var prev *model.LedgerEntry
+	if len(f.repo.ledger) > 0 {
+		prev = f.repo.ledger[len(f.repo.ledger)-1]
+	}
+	if err := ledger.Seal(entry, prev); err != nil {
+		t.Fatal(err)
+	}
+	// Store a copy, as the database would, so changing the tip later does
+	// not reach into the entry
+	doc, err := bson.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored model.LedgerEntry
+	if err := bson.Unmarshal(doc, &stored); err != nil {
+		t.Fatal(err)
+	}
+	f.repo.ledger = append(f.repo.ledger, &stored)
+}
+
+func (f *ledgerFixture) verify(t *testing.T) []ledger.Issue {
+	t.Helper()
+	verifier, err := f.service.VerifyTipsterLedger(signedIn(f.tipster.ID), f.tipster.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return verifier.Issues()
+}
+
+func TestCheckLedgerTipReportsTipDeletedAfterLoss(t *testing.T) {
+	f := newLedgerFixture(t)
+	tip := f.post(t, "Banker")
+	f.settle(t, tip, model.ResultLost, -1)
+	created, settled := f.repo.ledger[0], f.repo.ledger[1]
+
+	verifier := &ledger.Verifier{}
+	entries := &tipEntries{created: created, settled: settled}
+	if err := f.service.checkLedgerTip(context.Background(), verifier, nil, entries); err != nil {
+		t.Fatal(err)
+	}
+	issues := verifier.Issues()
+	if len(issues) != 1 {
+		t.Fatalf("issues = %+v, want one", issues)
+	}
+	issue := issues[0]
+	if issue.Kind != ledger.TipDeleted || issue.Sequence != settled.Sequence || issue.TipID != tip.ID {
+		t.Errorf("issue = %+v, want TIP_DELETED at the settled entry of the tip", issue)
+	}
+	if !strings.Contains(issue.Detail, "settled as lost") {
+		t.Errorf("detail = %q, want it to say the tip lost", issue.Detail)
+	}
+}
+
+func TestVerifyTipsterLedgerAcceptsUntouchedTips(t *testing.T) {
+	f := newLedgerFixture(t)
+	f.settle(t, f.post(t, "Winner"), model.ResultWon, 1.5)
+	f.post(t, "Pending")
+
+	if issues := f.verify(t); len(issues) != 0 {
+		t.Fatalf("issues = %+v, want none", issues)
+	}
+}
+
+func TestVerifyTipsterLedgerReportsDeletedLoser(t *testing.T) {
+	f := newLedgerFixture(t)
+	f.settle(t, f.post(t, "Winner"), model.ResultWon, 1.5)
+	loser := f.post(t, "Loser")
+	f.settle(t, loser, model.ResultLost, -1)
+	delete(f.repo.tips, loser.ID)
+
+	issues := f.verify(t)
+	if len(issues) != 1 || issues[0].Kind != ledger.TipDeleted || issues[0].TipID != loser.ID || issues[0].Sequence != 4 {
+		t.Fatalf("issues = %+v, want TIP_DELETED of the loser at 4", issues)
+	}
+}
+
+func TestVerifyTipsterLedgerReportsMutatedTips(t *testing.T) {
+	f := newLedgerFixture(t)
+	rewritten := f.post(t, "Rewritten")
+	flipped := f.post(t, "Flipped")
+	f.settle(t, flipped, model.ResultLost, -1)
+	unrecorded := f.post(t, "Unrecorded")
+
+	rewritten.Selection.Pick = "Away"
+	flipped.Settlement.Result = model.ResultWon
+	unrecorded.Settlement = &model.Settlement{Result: model.ResultWon, Profit: 1.5}
+
+	issues := f.verify(t)
+	want := []struct {
+		tipID    primitive.ObjectID
+		sequence int64
+	}{{rewritten.ID, 1}, {flipped.ID, 3}, {unrecorded.ID, 4}}
+	if len(issues) != len(want) {
+		t.Fatalf("issues = %+v, want %d", issues, len(want))
+	}
+	for i, w := range want {
+		if issues[i].Kind != ledger.TipMutated || issues[i].TipID != w.tipID || issues[i].Sequence != w.sequence {
+			t.Errorf("issue %d = %+v, want TIP_MUTATED of %s at %d", i, issues[i], w.tipID.Hex(), w.sequence)
+		}
+	}
+}
+
+func TestVerifyTipsterLedgerAcceptsRecordedDeletions(t *testing.T) {
+	f := newLedgerFixture(t)
+	loser := f.post(t, "Loser")
+	f.settle(t, loser, model.ResultLost, -1)
+	f.remove(t, loser)
+	f.remove(t, f.post(t, "Withdrawn"))
+
+	if issues := f.verify(t); len(issues) != 0 {
+		t.Fatalf("issues = %+v, want none", issues)
+	}
+}
+
+func TestVerifyTipsterLedgerReportsUnrecordedDeletion(t *testing.T) {
+	f := newLedgerFixture(t)
+	loser := f.post(t, "Loser")
+	f.settle(t, loser, model.ResultLost, -1)
+	deletedAt := f.now.Add(3 * time.Hour)
+	loser.DeletedAt = &deletedAt
+
+	issues := f.verify(t)
+	if len(issues) != 1 || issues[0].Kind != ledger.TipDeleted || issues[0].Sequence != 2 {
+		t.Fatalf("issues = %+v, want TIP_DELETED at 2", issues)
+	}
+}
+
+func TestVerifyTipsterLedgerReportsRestoredTip(t *testing.T) {
+	f := newLedgerFixture(t)
+	tip := f.post(t, "Withdrawn")
+	f.remove(t, tip)
+	tip.DeletedAt = nil
+
+	issues := f.verify(t)
+	if len(issues) != 1 || issues[0].Kind != ledger.TipMutated || issues[0].Sequence != 2 {
+		t.Fatalf("issues = %+v, want TIP_MUTATED at 2", issues)
+	}
+}
+
+func TestVerifyTipsterLedgerOutlivesTheAccount(t *testing.T) {
+	f := newLedgerFixture(t)
+	f.settle(t, f.post(t, "Winner"), model.ResultWon, 1.5)
+	viewer := &model.User{ID: primitive.NewObjectID(), Status: commonpb.AccountStatus_Active}
+	f.repo.users[viewer.ID] = viewer
+	delete(f.repo.users, f.tipster.ID)
+
+	verifier, err := f.service.VerifyTipsterLedger(signedIn(viewer.ID), f.tipster.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if verifier.Entries() != 2 || len(verifier.Issues()) != 0 {
+		t.Fatalf("entries = %d, issues = %+v, want 2 entries and no issues", verifier.Entries(), verifier.Issues())
+	}
+
+	_, err = f.service.VerifyTipsterLedger(signedIn(viewer.ID), primitive.NewObjectID())
+	if !errors.IsNotFound(err) {
+		t.Fatalf("err = %v for a user that never existed, want not found", err)
+	}
+}
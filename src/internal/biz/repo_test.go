@@ -0,0 +1,293 @@
+package biz
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"src/internal/model"
+	"src/internal/repository"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// fakeRepo keeps users, tips, ledger entries and a leaderboard in memory
+// and enforces the unique indexes the real repository relies on. Methods a
+// test does not need are left to the embedded interface and panic when
+// called.
+type fakeRepo struct {
+	repository.SocialRepository
+
+	mu          sync.Mutex
+	users       map[primitive.ObjectID]*model.User
+	tips        map[primitive.ObjectID]*model.Tip
+	ledger      []*model.LedgerEntry
+	snapshot    *model.LeaderboardSnapshot
+	leaderboard []*model.LeaderboardEntry
+}
+
+func newFakeRepo() *fakeRepo {
+	return &fakeRepo{
+		users: map[primitive.ObjectID]*model.User{},
+		tips:  map[primitive.ObjectID]*model.Tip{},
+	}
+}
+
+// duplicateKeyError is what the driver returns when a unique index rejects
+// a write.
+var duplicateKeyError = mongo.WriteException{WriteErrors: []mongo.WriteError{{Code: 11000, Message: "duplicate key"}}}
+
+func (r *fakeRepo) identityTaken(key string) bool {
+	for _, user := range r.users {
+		for _, identity := range user.Identities {
+			if identity.Key == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *fakeRepo) CreateUser(ctx context.Context, user *model.User) (string, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, existing := range r.users {
+		if user.Email != "" && existing.Email == user.Email {
+			return "exist", nil
+		}
+	}
+	for _, identity := range user.Identities {
+		if r.identityTaken(identity.Key) {
+			return "", duplicateKeyError
+		}
+	}
+	if user.ID.IsZero() {
+		user.ID = primitive.NewObjectID()
+	}
+	stored := *user
+	r.users[user.ID] = &stored
+	return user.ID.Hex(), nil
+}
+
+func (r *fakeRepo) GetUser(ctx context.Context, userID primitive.ObjectID) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userID]
+	if !ok {
+		return nil, mongo.ErrNoDocuments
+	}
+	found := *user
+	return &found, nil
+}
+
+func (r *fakeRepo) GetUserByIdentity(ctx context.Context, key string) (*model.User, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, user := range r.users {
+		for _, identity := range user.Identities {
+			if identity.Key == key {
+				found := *user
+				return &found, nil
+			}
+		}
+	}
+	return nil, mongo.ErrNoDocuments
+}
+
+func (r *fakeRepo) AddIdentity(ctx context.Context, userID primitive.ObjectID, identity model.Identity) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	user, ok := r.users[userID]
+	if !ok {
+		return mongo.ErrNoDocuments
+	}
+	for _, linked := range user.Identities {
+		if linked.Provider == identity.Provider {
+			return mongo.ErrNoDocuments
+		}
+	}
+	if r.identityTaken(identity.Key) {
+		return duplicateKeyError
+	}
+	user.Identities = append(user.Identities, identity)
+	return nil
+}
+
+func (r *fakeRepo) IsBlocked(ctx context.Context, ownerID, targetID primitive.ObjectID) (bool, error) {
+	return false, nil
+}
+
+// ListTips only supports the {"_id": {"$in": ids}} filter of the ledger
+// verification.
+func (r *fakeRepo) ListTips(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.Tip, primitive.ObjectID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var tips []*model.Tip
+	for _, id := range filter["_id"].(bson.M)["$in"].([]primitive.ObjectID) {
+		if tip, ok := r.tips[id]; ok {
+			found := *tip
+			tips = append(tips, &found)
+		}
+	}
+	return tips, primitive.NilObjectID, nil
+}
+
+func (r *fakeRepo) ForEachLedgerEntry(ctx context.Context, tipsterID string, fn func(entry *model.LedgerEntry) error) error {
+	r.mu.Lock()
+	entries := slices.Clone(r.ledger)
+	r.mu.Unlock()
+
+	for _, entry := range entries {
+		if entry.TipsterID != tipsterID {
+			continue
+		}
+		if err := fn(entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cloneTip copies the tip the way storing and loading it would, so callers
+// cannot reach into the stored tip.
+func cloneTip(tip *model.Tip) *model.Tip {
+	doc, err := bson.Marshal(tip)
+	if err != nil {
+		panic(err)
+	}
+	var clone model.Tip
+	if err := bson.Unmarshal(doc, &clone); err != nil {
+		panic(err)
+	}
+	return &clone
+}
+
+func (r *fakeRepo) GetTip(ctx context.Context, tipID primitive.ObjectID) (*model.Tip, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tip, ok := r.tips[tipID]
+	if !ok || tip.DeletedAt != nil {
+		return nil, mongo.ErrNoDocuments
+	}
+	return cloneTip(tip), nil
+}
+
+func (r *fakeRepo) SettleTip(ctx context.Context, tipID primitive.ObjectID, settlement *model.Settlement, entry *model.LedgerEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tip, ok := r.tips[tipID]
+	if !ok || tip.DeletedAt != nil || tip.Settlement != nil {
+		return mongo.ErrNoDocuments
+	}
+	settled := *settlement
+	tip.Settlement = &settled
+	return nil
+}
+
+func (r *fakeRepo) SettleTipLeg(ctx context.Context, tipID primitive.ObjectID, legResults []string, leg int, result string, settlement *model.Settlement, entry *model.LedgerEntry) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	tip, ok := r.tips[tipID]
+	if !ok || tip.DeletedAt != nil || tip.Settlement != nil {
+		return mongo.ErrNoDocuments
+	}
+	for i, legResult := range legResults {
+		if tip.Selection.Legs[i].Result != legResult {
+			return mongo.ErrNoDocuments
+		}
+	}
+	tip.Selection.Legs[leg].Result = result
+	if settlement != nil {
+		settled := *settlement
+		tip.Settlement = &settled
+	}
+	return nil
+}
+
+// ForEachSettledTip only supports filtering by tipster.
+func (r *fakeRepo) ForEachSettledTip(ctx context.Context, filter bson.M, fn func(tip *model.Tip) error) error {
+	r.mu.Lock()
+	var tips []*model.Tip
+	for _, tip := range r.tips {
+		if tip.Settlement != nil && (filter["tipsterId"] == nil || filter["tipsterId"] == tip.TipsterID) {
+			tips = append(tips, cloneTip(tip))
+		}
+	}
+	r.mu.Unlock()
+
+	slices.SortFunc(tips, func(a, b *model.Tip) int {
+		return a.Selection.EventStart.Compare(b.Selection.EventStart)
+	})
+	for _, tip := range tips {
+		if err := fn(tip); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *fakeRepo) ListRestrictedUserIDs(ctx context.Context, ownerID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) GetLatestLeaderboardSnapshot(ctx context.Context, window string) (*model.LeaderboardSnapshot, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.snapshot == nil || r.snapshot.Window != window {
+		return nil, mongo.ErrNoDocuments
+	}
+	return r.snapshot, nil
+}
+
+// ListLeaderboardEntries supports equality on the scope and $gte on the
+// stats, and does not sort.
+func (r *fakeRepo) ListLeaderboardEntries(ctx context.Context, snapshotID primitive.ObjectID, filter bson.M, sortField string, offset, limit int64) ([]*model.LeaderboardEntry, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	entries := []*model.LeaderboardEntry{}
+	for _, entry := range r.leaderboard {
+		doc, err := bson.Marshal(entry)
+		if err != nil {
+			return nil, err
+		}
+		var stored bson.M
+		if err := bson.Unmarshal(doc, &stored); err != nil {
+			return nil, err
+		}
+		if entry.SnapshotID == snapshotID && matchesLeaderboardFilter(stored, filter) {
+			found := *entry
+			entries = append(entries, &found)
+		}
+	}
+	return entries, nil
+}
+
+func matchesLeaderboardFilter(doc, filter bson.M) bool {
+	for key, want := range filter {
+		var value interface{} = doc
+		for _, field := range strings.Split(key, ".") {
+			value = value.(bson.M)[field]
+		}
+		if gte, ok := want.(bson.M); ok {
+			if value.(int64) < gte["$gte"].(int64) {
+				return false
+			}
+		} else if value != want {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,100 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BlockUser blocks the target for the caller and removes the follows and
+// follow requests between them in both directions.
+func (s *SocialService) BlockUser(ctx context.Context, targetID primitive.ObjectID) error {
+	restriction, err := s.newRestriction(ctx, targetID, model.RestrictionBlock)
+	if err != nil {
+		return err
+	}
+	return s.Repo.BlockUser(ctx, restriction)
+}
+
+// UnblockUser lifts the caller's block on the target. Removed follows are
+// not restored.
+func (s *SocialService) UnblockUser(ctx context.Context, targetID primitive.ObjectID) error {
+	return s.unrestrict(ctx, targetID, model.RestrictionBlock)
+}
+
+// MuteUser hides the target's content from the caller.
+func (s *SocialService) MuteUser(ctx context.Context, targetID primitive.ObjectID) error {
+	restriction, err := s.newRestriction(ctx, targetID, model.RestrictionMute)
+	if err != nil {
+		return err
+	}
+	return s.Repo.AddRestriction(ctx, restriction)
+}
+
+func (s *SocialService) UnmuteUser(ctx context.Context, targetID primitive.ObjectID) error {
+	return s.unrestrict(ctx, targetID, model.RestrictionMute)
+}
+
+// newRestriction returns a block or mute of an existing user for the caller.
+func (s *SocialService) newRestriction(ctx context.Context, targetID primitive.ObjectID, kind string) (*model.Restriction, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID == targetID {
+		return nil, errors.ErrCannotRestrictSelf
+	}
+
+	target, err := s.Repo.GetUser(ctx, targetID)
+	if err != nil {
+		return nil, err
+	}
+	if target.Deletion != nil {
+		return nil, errors.ToRpcError(mongo.ErrNoDocuments)
+	}
+
+	return &model.Restriction{
+		OwnerID:   userID,
+		TargetID:  targetID,
+		Kind:      kind,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+func (s *SocialService) unrestrict(ctx context.Context, targetID primitive.ObjectID, kind string) error {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return err
+	}
+	return s.Repo.RemoveRestriction(ctx, userID, targetID, kind)
+}
+
+// checkNotBlocked returns ErrBlocked if the author of the content, given as
+// a hex ID, has blocked the user.
+func (s *SocialService) checkNotBlocked(ctx context.Context, authorID string, userID primitive.ObjectID) error {
+	authorObjID, err := primitive.ObjectIDFromHex(authorID)
+	if err != nil {
+		return nil
+	}
+	blocked, err := s.Repo.IsBlocked(ctx, authorObjID, userID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return errors.ErrBlocked
+	}
+	return nil
+}
+
+func hexIDs(ids []primitive.ObjectID) []string {
+	hexIDs := make([]string, 0, len(ids))
+	for _, id := range ids {
+		hexIDs = append(hexIDs, id.Hex())
+	}
+	return hexIDs
+}
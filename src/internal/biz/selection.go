@@ -0,0 +1,242 @@
+package biz
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/model"
+	"src/internal/odds"
+	pb "src/protos/Tipster"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// maxStakeUnits caps the stake of a selection. Tipsters stake on a 1 to 10
+// unit scale, anything far above it is a typo.
+const maxStakeUnits = 100
+
+// maxAccumulatorLegs caps the legs of an accumulator.
+const maxAccumulatorLegs = 20
+
+// selectionOf validates a requested selection and converts it to the stored
+// one. Selections can only be set before their event starts, and
+// accumulators before their first leg starts.
+func selectionOf(req *pb.TipSelection, now time.Time) (*model.Selection, error) {
+	if math.IsNaN(req.Stake) || req.Stake <= 0 || req.Stake > maxStakeUnits {
+		return nil, errors.InvalidSelection("Stake must be greater than 0 and at most %d units", maxStakeUnits)
+	}
+	if len(req.Legs) > 0 {
+		return accumulatorOf(req, now)
+	}
+
+	leg, err := legOf(&pb.TipLeg{
+		Sport:         req.Sport,
+		Competition:   req.Competition,
+		EventName:     req.EventName,
+		EventStartsAt: req.EventStartsAt,
+		Market:        req.Market,
+		Selection:     req.Selection,
+		Price:         req.Price,
+		PriceFormat:   req.PriceFormat,
+	}, req.Odds, now)
+	if err != nil {
+		return nil, err
+	}
+	return &model.Selection{
+		Sport:       leg.Sport,
+		Competition: leg.Competition,
+		EventName:   leg.EventName,
+		EventStart:  leg.EventStart,
+		Market:      leg.Market,
+		Pick:        leg.Pick,
+		Odds:        leg.Odds,
+		Stake:       req.Stake,
+	}, nil
+}
+
+// accumulatorOf validates the legs of a requested accumulator and sums them
+// up into the stored selection.
+func accumulatorOf(req *pb.TipSelection, now time.Time) (*model.Selection, error) {
+	if len(req.Legs) < 2 || len(req.Legs) > maxAccumulatorLegs {
+		return nil, errors.InvalidSelection("Accumulators must have between 2 and %d legs", maxAccumulatorLegs)
+	}
+
+	selection := &model.Selection{
+		Market: model.MarketAccumulator,
+		Stake:  req.Stake,
+		Legs:   make([]model.Leg, 0, len(req.Legs)),
+	}
+	prices := make([]odds.Odds, 0, len(req.Legs))
+	picks := make([]string, 0, len(req.Legs))
+	for i, reqLeg := range req.Legs {
+		leg, err := legOf(reqLeg, 0, now)
+		if err != nil {
+			return nil, errors.InvalidSelection("Leg %d: %s", i+1, errors.Message(err))
+		}
+		price, _ := odds.FromFraction(leg.Odds.Num, leg.Odds.Den)
+		prices = append(prices, price)
+		picks = append(picks, leg.Pick)
+
+		if i == 0 {
+			selection.Sport = leg.Sport
+			selection.Competition = leg.Competition
+			selection.EventStart = leg.EventStart
+		}
+		if leg.Sport != selection.Sport {
+			selection.Sport = model.SportMultiple
+		}
+		if leg.Competition != selection.Competition {
+			selection.Competition = ""
+		}
+		if leg.EventStart.Before(selection.EventStart) {
+			selection.EventStart = leg.EventStart
+		}
+		selection.Legs = append(selection.Legs, *leg)
+	}
+
+	price, err := odds.Combine(prices...)
+	if err != nil {
+		return nil, errors.InvalidSelection("Combined odds are too long")
+	}
+	selection.Odds = storedOdds(price)
+	selection.EventName = fmt.Sprintf("%d-fold accumulator", len(selection.Legs))
+	selection.Pick = strings.Join(picks, " / ")
+	return selection, nil
+}
+
+// legOf validates one selection of a bet. deprecatedOdds are the decimal
+// odds read when the leg has no price.
+func legOf(req *pb.TipLeg, deprecatedOdds float64, now time.Time) (*model.Leg, error) {
+	leg := &model.Leg{
+		Sport:       strings.TrimSpace(req.Sport),
+		Competition: strings.TrimSpace(req.Competition),
+		EventName:   strings.TrimSpace(req.EventName),
+		Market:      strings.TrimSpace(req.Market),
+		Pick:        strings.TrimSpace(req.Selection),
+	}
+
+	switch {
+	case leg.Sport == "":
+		return nil, errors.InvalidSelection("Sport is required")
+	case leg.EventName == "":
+		return nil, errors.InvalidSelection("Event name is required")
+	case leg.Market == "":
+		return nil, errors.InvalidSelection("Market is required")
+	case leg.Pick == "":
+		return nil, errors.InvalidSelection("Selection is required")
+	case !req.EventStartsAt.IsValid():
+		return nil, errors.InvalidSelection("Event start time is required")
+	}
+
+	price, err := selectionOdds(req.Price, req.PriceFormat, deprecatedOdds)
+	if err != nil {
+		return nil, err
+	}
+	leg.Odds = storedOdds(price)
+
+	leg.EventStart = req.EventStartsAt.AsTime()
+	if !leg.EventStart.After(now) {
+		return nil, errors.InvalidSelection("Event has already started")
+	}
+	return leg, nil
+}
+
+// selectionOdds reads a requested price, or the deprecated decimal odds when
+// there is no price.
+func selectionOdds(priceText string, priceFormat pb.OddsFormat, deprecatedOdds float64) (odds.Odds, error) {
+	format := oddsFormatOf(priceFormat)
+	var price odds.Odds
+	var err error
+	if priceText == "" && deprecatedOdds != 0 {
+		format = odds.Decimal
+		price, err = odds.FromFloat(deprecatedOdds)
+	} else {
+		price, err = odds.Parse(priceText, format)
+	}
+
+	if err == odds.ErrRange {
+		return odds.Odds{}, errors.InvalidSelection("Odds must pay back more than the stake")
+	}
+	if err != nil {
+		return odds.Odds{}, errors.InvalidSelection("Price is not valid %s odds", strings.ToLower(string(format)))
+	}
+	return price, nil
+}
+
+// oddsFormatOf converts a requested odds format. Unset requests use decimal
+// odds.
+func oddsFormatOf(format pb.OddsFormat) odds.Format {
+	if format == pb.OddsFormat_ODDS_FORMAT_UNSPECIFIED {
+		return odds.Decimal
+	}
+	return odds.Format(strings.TrimPrefix(format.String(), "ODDS_FORMAT_"))
+}
+
+func storedOdds(price odds.Odds) model.Odds {
+	num, den := price.Fraction()
+	return model.Odds{Num: num, Den: den}
+}
+
+// SelectionData converts a stored selection for the API, with the odds
+// written in format.
+func SelectionData(selection *model.Selection, format pb.OddsFormat) *pb.TipSelection {
+	if selection == nil {
+		return nil
+	}
+	data := &pb.TipSelection{
+		Sport:         selection.Sport,
+		Competition:   selection.Competition,
+		EventName:     selection.EventName,
+		EventStartsAt: timestamppb.New(selection.EventStart),
+		Market:        selection.Market,
+		Selection:     selection.Pick,
+		Stake:         selection.Stake,
+	}
+	price, err := odds.FromFraction(selection.Odds.Num, selection.Odds.Den)
+	if err != nil {
+		return data
+	}
+	if format == pb.OddsFormat_ODDS_FORMAT_UNSPECIFIED {
+		format = pb.OddsFormat_ODDS_FORMAT_DECIMAL
+	}
+	data.Price = price.Format(oddsFormatOf(format))
+	data.PriceFormat = format
+	data.Odds = price.Float64()
+	data.ImpliedProbability, _ = price.ImpliedProbability().Float64()
+	for _, leg := range selection.Legs {
+		data.Legs = append(data.Legs, legData(leg, format))
+	}
+	return data
+}
+
+func legData(leg model.Leg, format pb.OddsFormat) *pb.TipLeg {
+	data := &pb.TipLeg{
+		Sport:         leg.Sport,
+		Competition:   leg.Competition,
+		EventName:     leg.EventName,
+		EventStartsAt: timestamppb.New(leg.EventStart),
+		Market:        leg.Market,
+		Selection:     leg.Pick,
+		Result:        tipResultOf(leg.Result),
+	}
+	price, err := odds.FromFraction(leg.Odds.Num, leg.Odds.Den)
+	if err != nil {
+		return data
+	}
+	data.Price = price.Format(oddsFormatOf(format))
+	data.PriceFormat = format
+	data.ImpliedProbability, _ = price.ImpliedProbability().Float64()
+	return data
+}
+
+// tipResultOf converts a stored result for the API. Empty results are
+// unspecified.
+func tipResultOf(result string) pb.TipResult {
+	if result == "" {
+		return pb.TipResult_TIP_RESULT_UNSPECIFIED
+	}
+	return pb.TipResult(pb.TipResult_value["TIP_RESULT_"+result])
+}
@@ -0,0 +1,121 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/model"
+	commonpb "src/protos/YM.Common"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// selfExclusionEnd returns when a self-exclusion of the given period started
+// at start runs out, or nil if it is permanent.
+func selfExclusionEnd(period string, start time.Time) (*time.Time, bool) {
+	var end time.Time
+	switch period {
+	case model.SelfExclusion24Hours:
+		end = start.Add(24 * time.Hour)
+	case model.SelfExclusion7Days:
+		end = start.AddDate(0, 0, 7)
+	case model.SelfExclusion6Months:
+		end = start.AddDate(0, 6, 0)
+	case model.SelfExclusionPermanent:
+		return nil, true
+	default:
+		return nil, false
+	}
+	return &end, true
+}
+
+// SelfExclude locks the caller out of tips for the given period. It can only
+// be started from an active account and cannot be lifted early, not even by
+// an admin.
+func (s *SocialService) SelfExclude(ctx context.Context, period string) (*model.SelfExclusion, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isActive(user) {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+
+	startedAt := time.Now().UTC()
+	endsAt, ok := selfExclusionEnd(period, startedAt)
+	if !ok {
+		return nil, errors.ErrInvalidSelfExclusionPeriod
+	}
+
+	exclusion := &model.SelfExclusion{
+		ID:        primitive.NewObjectID(),
+		UserID:    user.ID,
+		Period:    period,
+		StartedAt: startedAt,
+		EndsAt:    endsAt,
+	}
+	err = s.Repo.StartSelfExclusion(ctx, exclusion)
+	if errors.IsNotFound(err) {
+		// The status changed since it was read
+		return nil, errors.ErrInvalidStatusTransition
+	}
+	if err != nil {
+		return nil, err
+	}
+	return exclusion, nil
+}
+
+// ListSelfExclusions returns exclusion records for compliance reporting,
+// optionally limited to one user. Only admins may list them.
+func (s *SocialService) ListSelfExclusions(ctx context.Context, userID string, pageSize int64, nextCursor string) ([]*model.SelfExclusion, string, error) {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return nil, "", err
+	}
+
+	filter := bson.M{}
+	if userID != "" {
+		objID, err := primitive.ObjectIDFromHex(userID)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["userId"] = objID
+	}
+	return s.Repo.ListSelfExclusions(ctx, filter, pageSize, nextCursor)
+}
+
+// checkSelfExclusion returns ErrSelfExcluded while the user's self-exclusion
+// is running. An exclusion that has run out is released on the spot rather
+// than waiting for the expiry job.
+func (s *SocialService) checkSelfExclusion(ctx context.Context, user *model.User) error {
+	if user.Status != commonpb.AccountStatus_SelfExclude {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if user.SelfExcludedUntil == nil || user.SelfExcludedUntil.After(now) {
+		return errors.ErrSelfExcluded
+	}
+	released, err := s.Repo.ExpireSelfExclusion(ctx, user.ID, now)
+	if err != nil {
+		return err
+	}
+	if released {
+		user.Status = commonpb.AccountStatus_Active
+		user.SelfExcludedUntil = nil
+	}
+	return nil
+}
+
+// viewerID returns the caller's ID if they may view tips.
+func (s *SocialService) viewerID(ctx context.Context) (primitive.ObjectID, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	if err := s.checkSelfExclusion(ctx, user); err != nil {
+		return primitive.NilObjectID, err
+	}
+	return user.ID, nil
+}
@@ -0,0 +1,263 @@
+package biz
+
+import (
+	"context"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/ledger"
+	"src/internal/model"
+	"src/internal/odds"
+	pb "src/protos/Tipster"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// settleAttempts bounds how often a settlement is retried when the tip
+// changed while it was being settled.
+const settleAttempts = 3
+
+// SettleTip records the result of the tip's selection and the profit it
+// made. A tip is settled once, after its event started; void results can be
+// recorded earlier for events that were called off.
+//
+// Accumulators are settled one leg at a time, under the same rules per leg.
+// The accumulator itself is settled once a leg is lost or every leg is
+// settled; until then the returned tip has no settlement.
+func (s *SocialService) SettleTip(ctx context.Context, tipID primitive.ObjectID, result pb.TipResult, leg int) (*model.Tip, error) {
+	for attempt := 1; ; attempt++ {
+		// Conflicts mean the tip changed since it was loaded, e.g. another
+		// leg of the accumulator was settled
+		tip, err := s.settleTip(ctx, tipID, strings.TrimPrefix(result.String(), "TIP_RESULT_"), leg)
+		if err != errors.ErrSettlementConflict || attempt == settleAttempts {
+			return tip, err
+		}
+	}
+}
+
+func (s *SocialService) settleTip(ctx context.Context, tipID primitive.ObjectID, outcome string, leg int) (*model.Tip, error) {
+	tip, err := s.Repo.GetTip(ctx, tipID)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.authorizeSettlement(ctx, tip.TipsterID)
+	if err != nil {
+		return nil, err
+	}
+	if tip.Selection == nil {
+		return nil, errors.ErrNoSelection
+	}
+	if tip.Settlement != nil {
+		return nil, errors.ErrTipSettled
+	}
+
+	currentTime := time.Now().UTC()
+	legs := tip.Selection.Legs
+	if len(legs) == 0 {
+		if leg != 0 {
+			return nil, errors.InvalidSelection("Only accumulators are settled by leg")
+		}
+		if outcome != model.ResultVoid && currentTime.Before(tip.Selection.EventStart) {
+			return nil, errors.ErrEventNotStarted
+		}
+		profit, err := settlementProfit(tip.Selection, outcome)
+		if err != nil {
+			return nil, err
+		}
+		tip.Settlement = &model.Settlement{
+			Result:    outcome,
+			Profit:    profit,
+			SettledAt: currentTime,
+			SettledBy: user.ID,
+		}
+		err = s.Repo.SettleTip(ctx, tipID, tip.Settlement, ledger.Settled(tip, currentTime))
+	} else {
+		if leg < 1 || leg > len(legs) {
+			return nil, errors.InvalidSelection("Accumulators are settled by leg, numbered 1 to %d", len(legs))
+		}
+		if legs[leg-1].Result != "" {
+			return nil, errors.ErrLegSettled
+		}
+		if outcome != model.ResultVoid && currentTime.Before(legs[leg-1].EventStart) {
+			return nil, errors.ErrEventNotStarted
+		}
+
+		legResults := make([]string, len(legs))
+		for i := range legs {
+			legResults[i] = legs[i].Result
+		}
+		legs[leg-1].Result = outcome
+		result, profit, settled, err := accumulatorSettlement(tip.Selection)
+		if err != nil {
+			return nil, err
+		}
+		var entry *model.LedgerEntry
+		if settled {
+			tip.Settlement = &model.Settlement{
+				Result:    result,
+				Profit:    profit,
+				SettledAt: currentTime,
+				SettledBy: user.ID,
+			}
+			entry = ledger.Settled(tip, currentTime)
+		}
+		err = s.Repo.SettleTipLeg(ctx, tipID, legResults, leg-1, outcome, tip.Settlement, entry)
+	}
+	if errors.IsNotFound(err) {
+		return nil, errors.ErrSettlementConflict
+	}
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+	return tip, nil
+}
+
+// settlementProfit returns the profit in units of the selection settled
+// with result. It is computed exactly and only rounded to a float at the
+// end.
+func settlementProfit(selection *model.Selection, result string) (float64, error) {
+	price, err := odds.FromFraction(selection.Odds.Num, selection.Odds.Den)
+	if err != nil {
+		return 0, err
+	}
+	stake, err := stakeOf(selection)
+	if err != nil {
+		return 0, err
+	}
+
+	profit := new(big.Rat)
+	switch result {
+	case model.ResultWon:
+		profit.Mul(stake, price.Profit())
+	case model.ResultHalfWon:
+		profit.Mul(stake, price.Profit()).Mul(profit, half)
+	case model.ResultLost:
+		profit.Neg(stake)
+	case model.ResultHalfLost:
+		profit.Mul(stake, half).Neg(profit)
+	}
+	units, _ := profit.Float64()
+	return units, nil
+}
+
+// accumulatorSettlement works out the result of an accumulator from its
+// legs' results, reporting whether it can be settled yet. A lost leg loses
+// the accumulator. Won legs multiply the return by their odds, void and
+// pushed legs drop out, half won legs return half the stake at the odds and
+// half at evens, and half lost legs halve the return. What is left is won
+// when it made a profit, a push when it broke even, half lost when only
+// part of the stake came back, and void when every leg was void.
+func accumulatorSettlement(selection *model.Selection) (result string, profit float64, settled bool, err error) {
+	stake, err := stakeOf(selection)
+	if err != nil {
+		return "", 0, false, err
+	}
+
+	returns := big.NewRat(1, 1)
+	pending, void := false, true
+	for _, leg := range selection.Legs {
+		if leg.Result == model.ResultLost {
+			units, _ := new(big.Rat).Neg(stake).Float64()
+			return model.ResultLost, units, true, nil
+		}
+		if leg.Result == "" {
+			pending = true
+			continue
+		}
+		if leg.Result != model.ResultVoid {
+			void = false
+		}
+
+		price, err := odds.FromFraction(leg.Odds.Num, leg.Odds.Den)
+		if err != nil {
+			return "", 0, false, err
+		}
+		switch leg.Result {
+		case model.ResultWon:
+			returns.Mul(returns, price.Price())
+		case model.ResultHalfWon:
+			halfWon := price.Price()
+			halfWon.Add(halfWon, big.NewRat(1, 1)).Mul(halfWon, half)
+			returns.Mul(returns, halfWon)
+		case model.ResultHalfLost:
+			returns.Mul(returns, half)
+		}
+	}
+	if pending {
+		return "", 0, false, nil
+	}
+
+	net := returns.Sub(returns, big.NewRat(1, 1))
+	net.Mul(net, stake)
+	units, _ := net.Float64()
+	switch {
+	case void:
+		result = model.ResultVoid
+	case net.Sign() > 0:
+		result = model.ResultWon
+	case net.Sign() == 0:
+		result = model.ResultPush
+	default:
+		result = model.ResultHalfLost
+	}
+	return result, units, true, nil
+}
+
+var half = big.NewRat(1, 2)
+
+// hasSettledLegs reports whether any leg of the accumulator is settled.
+func hasSettledLegs(selection *model.Selection) bool {
+	if selection == nil {
+		return false
+	}
+	for _, leg := range selection.Legs {
+		if leg.Result != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// stakeOf returns the exact stake of the selection.
+func stakeOf(selection *model.Selection) (*big.Rat, error) {
+	stake, ok := new(big.Rat).SetString(strconv.FormatFloat(selection.Stake, 'f', -1, 64))
+	if !ok {
+		return nil, errors.InvalidSelection("Stake is not a number")
+	}
+	return stake, nil
+}
+
+// ListUnsettledTips returns a page of tips whose event started but that are
+// not settled yet. Tipsters only see their own tips, moderators and admins
+// see everyone's, optionally filtered by tipster.
+func (s *SocialService) ListUnsettledTips(ctx context.Context, req *pb.ListUnsettledTipsRequest, pageSize int64) ([]*model.Tip, primitive.ObjectID, error) {
+	user, err := s.caller(ctx)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	tipsterID := req.TipsterId
+	if !canModerate(user) {
+		if tipsterID != "" && tipsterID != user.ID.Hex() {
+			return nil, primitive.NilObjectID, errors.ErrPermissionDenied
+		}
+		tipsterID = user.ID.Hex()
+	}
+
+	filter := bson.M{
+		"selection.eventStart": bson.M{"$lte": time.Now().UTC()},
+		"settlement":           bson.M{"$exists": false},
+		"deletedAt":            bson.M{"$exists": false},
+	}
+	if tipsterID != "" {
+		filter["tipsterId"] = tipsterID
+	}
+	tips, lastTipID, err := s.Repo.ListTips(ctx, filter, pageSize, req.NextCursor)
+	if err != nil {
+		return nil, primitive.NilObjectID, errors.ToRpcError(err)
+	}
+	return tips, lastTipID, nil
+}
@@ -0,0 +1,258 @@
+package biz
+
+import (
+	"testing"
+	"time"
+
+	"src/internal/model"
+	pb "src/protos/Tipster"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// leg returns an accumulator leg at decimal odds num/den with result.
+func leg(num, den int64, result string) model.Leg {
+	return model.Leg{Odds: model.Odds{Num: num, Den: den}, Result: result}
+}
+
+func TestSettlementProfit(t *testing.T) {
+	tests := []struct {
+		name   string
+		odds   model.Odds
+		stake  float64
+		result string
+		want   float64
+	}{
+		{"won", model.Odds{Num: 5, Den: 2}, 2, model.ResultWon, 3},
+		{"half won", model.Odds{Num: 5, Den: 2}, 2, model.ResultHalfWon, 1.5},
+		{"lost", model.Odds{Num: 5, Den: 2}, 2, model.ResultLost, -2},
+		{"half lost", model.Odds{Num: 5, Den: 2}, 2, model.ResultHalfLost, -1},
+		{"void", model.Odds{Num: 5, Den: 2}, 2, model.ResultVoid, 0},
+		{"push", model.Odds{Num: 5, Den: 2}, 2, model.ResultPush, 0},
+		// 0.1 * 1.1 is 0.11000000000000001 in floats
+		{"exact", model.Odds{Num: 21, Den: 10}, 0.1, model.ResultWon, 0.11},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := settlementProfit(&model.Selection{Odds: tt.odds, Stake: tt.stake}, tt.result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("profit = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAccumulatorSettlement(t *testing.T) {
+	tests := []struct {
+		name        string
+		stake       float64
+		legs        []model.Leg
+		wantResult  string
+		wantProfit  float64
+		wantSettled bool
+	}{
+		{
+			name:        "all won",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(3, 2, model.ResultWon)},
+			wantResult:  model.ResultWon,
+			wantProfit:  2,
+			wantSettled: true,
+		},
+		{
+			name:        "stake scales the return",
+			stake:       2.5,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(2, 1, model.ResultWon)},
+			wantResult:  model.ResultWon,
+			wantProfit:  7.5,
+			wantSettled: true,
+		},
+		{
+			name:        "void leg drops out",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(3, 1, model.ResultVoid)},
+			wantResult:  model.ResultWon,
+			wantProfit:  1,
+			wantSettled: true,
+		},
+		{
+			name:        "pushed leg drops out",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(3, 1, model.ResultPush)},
+			wantResult:  model.ResultWon,
+			wantProfit:  1,
+			wantSettled: true,
+		},
+		{
+			name:        "all void",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultVoid), leg(3, 1, model.ResultVoid)},
+			wantResult:  model.ResultVoid,
+			wantProfit:  0,
+			wantSettled: true,
+		},
+		{
+			name:        "void and pushed legs break even",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultVoid), leg(3, 1, model.ResultPush)},
+			wantResult:  model.ResultPush,
+			wantProfit:  0,
+			wantSettled: true,
+		},
+		{
+			name:  "half won returns half at the odds and half at evens",
+			stake: 1,
+			// (3 + 1) / 2 * 2
+			legs:        []model.Leg{leg(3, 1, model.ResultHalfWon), leg(2, 1, model.ResultWon)},
+			wantResult:  model.ResultWon,
+			wantProfit:  3,
+			wantSettled: true,
+		},
+		{
+			name:        "half lost halves the return",
+			stake:       1,
+			legs:        []model.Leg{leg(3, 2, model.ResultWon), leg(2, 1, model.ResultHalfLost)},
+			wantResult:  model.ResultHalfLost,
+			wantProfit:  -0.25,
+			wantSettled: true,
+		},
+		{
+			name:        "half lost can break even",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(2, 1, model.ResultHalfLost)},
+			wantResult:  model.ResultPush,
+			wantProfit:  0,
+			wantSettled: true,
+		},
+		{
+			name:        "half lost alone",
+			stake:       4,
+			legs:        []model.Leg{leg(2, 1, model.ResultHalfLost), leg(2, 1, model.ResultVoid)},
+			wantResult:  model.ResultHalfLost,
+			wantProfit:  -2,
+			wantSettled: true,
+		},
+		{
+			name:        "lost leg settles while others are pending",
+			stake:       2,
+			legs:        []model.Leg{leg(2, 1, ""), leg(2, 1, model.ResultLost), leg(2, 1, "")},
+			wantResult:  model.ResultLost,
+			wantProfit:  -2,
+			wantSettled: true,
+		},
+		{
+			name:        "lost leg beats won legs",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(2, 1, model.ResultLost)},
+			wantResult:  model.ResultLost,
+			wantProfit:  -1,
+			wantSettled: true,
+		},
+		{
+			name:        "pending leg waits",
+			stake:       1,
+			legs:        []model.Leg{leg(2, 1, model.ResultWon), leg(2, 1, "")},
+			wantSettled: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, profit, settled, err := accumulatorSettlement(&model.Selection{Stake: tt.stake, Legs: tt.legs})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if settled != tt.wantSettled {
+				t.Fatalf("settled = %v, want %v", settled, tt.wantSettled)
+			}
+			if result != tt.wantResult || profit != tt.wantProfit {
+				t.Errorf("settlement = %s %v, want %s %v", result, profit, tt.wantResult, tt.wantProfit)
+			}
+		})
+	}
+}
+
+func TestAccumulatorSettlementRejectsInvalidOdds(t *testing.T) {
+	selection := &model.Selection{Stake: 1, Legs: []model.Leg{leg(2, 1, model.ResultWon), leg(1, 0, model.ResultWon)}}
+	if _, _, _, err := accumulatorSettlement(selection); err == nil {
+		t.Error("settled a leg with a zero odds denominator")
+	}
+}
+
+// TestSettlementFeedsStats settles tips through the service and checks the
+// record and leaderboard minimum built from them. Void and pushed tips,
+// including accumulators that came back void, count as tips but not as
+// decided tips.
+func TestSettlementFeedsStats(t *testing.T) {
+	f := newLedgerFixture(t)
+	ctx := signedIn(f.tipster.ID)
+	start := time.Now().UTC().Add(-6 * time.Hour)
+	post := func(odds model.Odds, legs ...model.Leg) primitive.ObjectID {
+		tip := &model.Tip{
+			ID:        primitive.NewObjectID(),
+			TipsterID: f.tipster.ID.Hex(),
+			Selection: &model.Selection{EventStart: start, Odds: odds, Stake: 1, Legs: legs},
+		}
+		start = start.Add(time.Hour)
+		f.repo.tips[tip.ID] = tip
+		return tip.ID
+	}
+	settle := func(tipID primitive.ObjectID, result pb.TipResult, leg int) {
+		t.Helper()
+		if _, err := f.service.SettleTip(ctx, tipID, result, leg); err != nil {
+			t.Fatalf("SettleTip(%s, leg %d): %v", result, leg, err)
+		}
+	}
+
+	settle(post(model.Odds{Num: 5, Den: 2}), pb.TipResult_TIP_RESULT_WON, 0)
+	settle(post(model.Odds{Num: 2, Den: 1}), pb.TipResult_TIP_RESULT_LOST, 0)
+	settle(post(model.Odds{Num: 2, Den: 1}), pb.TipResult_TIP_RESULT_VOID, 0)
+	settle(post(model.Odds{Num: 2, Den: 1}), pb.TipResult_TIP_RESULT_PUSH, 0)
+	acc := post(model.Odds{Num: 4, Den: 1}, leg(2, 1, ""), leg(2, 1, ""))
+	settle(acc, pb.TipResult_TIP_RESULT_WON, 1)
+	settle(acc, pb.TipResult_TIP_RESULT_VOID, 2)
+	voidAcc := post(model.Odds{Num: 4, Den: 1}, leg(2, 1, ""), leg(2, 1, ""))
+	settle(voidAcc, pb.TipResult_TIP_RESULT_VOID, 1)
+	settle(voidAcc, pb.TipResult_TIP_RESULT_VOID, 2)
+
+	got, err := f.service.GetTipsterStats(ctx, f.tipster.ID, &pb.GetTipsterStatsRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := model.TipsterStats{
+		TipsterID:            f.tipster.ID.Hex(),
+		Tips:                 6,
+		DecidedTips:          3,
+		Won:                  2,
+		Lost:                 1,
+		Void:                 2,
+		Push:                 1,
+		StrikeRate:           got.StrikeRate,
+		UnitsStaked:          3,
+		Profit:               1.5,
+		ROI:                  50,
+		AverageOdds:          got.AverageOdds,
+		LongestWinningStreak: 1,
+		LongestLosingStreak:  1,
+	}
+	if *got != want {
+		t.Errorf("stats = %+v, want %+v", *got, want)
+	}
+
+	f.repo.snapshot = &model.LeaderboardSnapshot{ID: primitive.NewObjectID(), Window: model.WindowLast30Days}
+	f.repo.leaderboard = []*model.LeaderboardEntry{{SnapshotID: f.repo.snapshot.ID, Stats: *got}}
+	for _, tt := range []struct {
+		minTips int32
+		listed  bool
+	}{{3, true}, {4, false}, {6, false}} {
+		_, entries, _, err := f.service.ListLeaderboard(ctx, &pb.ListLeaderboardRequest{MinTips: tt.minTips}, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if listed := len(entries) == 1; listed != tt.listed {
+			t.Errorf("MinTips %d: listed = %v, want %v", tt.minTips, listed, tt.listed)
+		}
+	}
+}
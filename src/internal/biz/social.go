@@ -2,47 +2,63 @@ package biz
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"src/internal/auth"
 	"src/internal/errors"
 	"src/internal/model"
 	"src/internal/repository"
 	pb "src/protos/Tipster"
+	commonpb "src/protos/YM.Common"
 
+	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type SocialService struct {
-	Repo repository.SocialRepository
+	Repo       repository.SocialRepository
+	Passwords  *auth.PasswordHasher
+	Tokens     *auth.TokenIssuer
+	Identities auth.IdentityVerifiers
+	// How long a deleted user can be restored before it is purged
+	DeletionGracePeriod time.Duration
+	// How long after kick-off typos in a tip's commentary can be fixed
+	TipEditGracePeriod time.Duration
+	Logger             log.Logger
 }
 
-func (s *SocialService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) *pb.CreateUserResponse_UserData {
+func (s *SocialService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse_UserData, error) {
 	currentTime := time.Now().UTC()
 
+	passwordHash, err := s.Passwords.Hash(req.Password)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+
 	user := &model.User{
 		ID:        primitive.NewObjectID(),
 		Username:  req.UserName,
-		Password:  req.Password,
+		Password:  passwordHash,
 		Email:     req.Email,
 		Tags:      req.Tags,
-		Following: []primitive.ObjectID{},
-		Followers: []primitive.ObjectID{},
+		Status:    commonpb.AccountStatus_Active,
+		Role:      model.RolePunter,
 		CreatedAt: currentTime,
 		UpdatedAt: currentTime,
 	}
 
 	userID, err := s.Repo.CreateUser(ctx, user)
 	if err != nil {
-		fmt.Println(errors.ToRpcError(err))
+		return nil, errors.ToRpcError(err)
 	}
 	if userID == "exist" {
 		return &pb.CreateUserResponse_UserData{
 			UserId: "",
 			Email:  req.Email,
-		}
+		}, nil
 	}
 	return &pb.CreateUserResponse_UserData{
 		UserId:    userID,
@@ -51,7 +67,7 @@ func (s *SocialService) CreateUser(ctx context.Context, req *pb.CreateUserReques
 		Tags:      req.Tags,
 		CreatedAt: timestamppb.New(currentTime),
 		UpdatedAt: timestamppb.New(currentTime),
-	}
+	}, nil
 }
 
 func (s *SocialService) GetUser(ctx context.Context, userID primitive.ObjectID) (*model.User, error) {
@@ -63,11 +79,16 @@ func (s *SocialService) GetUser(ctx context.Context, userID primitive.ObjectID)
 }
 
 func (s *SocialService) UpdateUser(ctx context.Context, userID primitive.ObjectID, req *pb.UpdateUserRequest) error {
+	if err := s.authorizeAccountUpdate(ctx, userID); err != nil {
+		return err
+	}
+
 	// Create updates struct
 	updates := &model.UserUpdates{
 		Username:  req.UserName,
 		Email:     req.Email,
 		Tags:      req.Tags,
+		Private:   req.Private,
 		UpdatedAt: time.Now().UTC(),
 	}
 	err := s.Repo.UpdateUser(ctx, userID, updates)
@@ -77,12 +98,77 @@ func (s *SocialService) UpdateUser(ctx context.Context, userID primitive.ObjectI
 	return nil
 }
 
-func (s *SocialService) DeleteUser(ctx context.Context, userID primitive.ObjectID) error {
-	err := s.Repo.DeleteUser(ctx, userID)
+// DeleteUser soft-deletes the user. The account is signed out and can be
+// restored until the grace period is over, then the purge job removes it.
+func (s *SocialService) DeleteUser(ctx context.Context, userID primitive.ObjectID) (*model.UserDeletion, error) {
+	admin, err := s.requireAdmin(ctx)
 	if err != nil {
-		return errors.ToRpcError(err)
+		return nil, err
 	}
-	return nil
+	if admin.ID == userID {
+		return nil, errors.ErrPermissionDenied
+	}
+
+	user, err := s.Repo.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user.Deletion != nil {
+		return nil, errors.ErrInvalidStatusTransition
+	}
+
+	currentTime := time.Now().UTC()
+	deletion := &model.UserDeletion{
+		RequestedAt:    currentTime,
+		RequestedBy:    admin.ID,
+		PurgeAfter:     currentTime.Add(s.DeletionGracePeriod),
+		PreviousStatus: user.Status,
+	}
+	err = s.Repo.MarkUserDeleted(ctx, userID, deletion)
+	if errors.IsNotFound(err) {
+		// Deleted concurrently
+		return nil, errors.ErrInvalidStatusTransition
+	}
+	if err != nil {
+		return nil, err
+	}
+	return deletion, nil
+}
+
+// RestoreUser undoes a deletion during its grace period and puts the
+// account back in the status it had before.
+func (s *SocialService) RestoreUser(ctx context.Context, userID primitive.ObjectID) error {
+	if _, err := s.requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	user, err := s.Repo.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.Deletion == nil {
+		return errors.ErrInvalidStatusTransition
+	}
+
+	err = s.Repo.RestoreDeletedUser(ctx, userID, user.Deletion.PreviousStatus, time.Now().UTC())
+	if errors.IsNotFound(err) {
+		// Restored or purged concurrently
+		return errors.ErrInvalidStatusTransition
+	}
+	return err
+}
+
+// SetUserRole assigns a role to a user. Admins cannot change their own role,
+// so the last admin cannot lock everyone out by accident.
+func (s *SocialService) SetUserRole(ctx context.Context, userID primitive.ObjectID, role string) error {
+	admin, err := s.requireAdmin(ctx)
+	if err != nil {
+		return err
+	}
+	if admin.ID == userID {
+		return errors.ErrPermissionDenied
+	}
+	return s.Repo.UpdateRole(ctx, userID, role, time.Now().UTC())
 }
 func (s *SocialService) ListUsers(ctx context.Context, req *pb.ListUserRequest) ([]*model.User, error) {
 	filter := bson.M{}
@@ -101,20 +187,64 @@ func (s *SocialService) ListUsers(ctx context.Context, req *pb.ListUserRequest)
 	return users, nil
 }
 
-func (s *SocialService) FollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) (*pb.FollowTipsterResponse_FollowData, error) {
-	err := s.Repo.FollowTipster(ctx, userID, tipsterID)
+// FollowTipster makes the caller follow the tipster. Following a tipster
+// again succeeds with AlreadyFollowing set. Private tipsters get a follow
+// request instead, reported as Pending.
+func (s *SocialService) FollowTipster(ctx context.Context, tipsterID primitive.ObjectID) (*pb.FollowTipsterResponse_FollowData, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if userID == tipsterID {
+		return nil, errors.ErrCannotFollowSelf
+	}
+
+	tipster, err := s.Repo.GetUser(ctx, tipsterID)
+	if err != nil {
+		return nil, err
+	}
+	// Deleted accounts wait for the purge and cannot gain followers
+	if tipster.Deletion != nil {
+		return nil, errors.ToRpcError(mongo.ErrNoDocuments)
+	}
+	if err := s.checkNotBlocked(ctx, tipsterID.Hex(), userID); err != nil {
+		return nil, err
+	}
+	// Users who blocked someone have to unblock them before following
+	blocking, err := s.Repo.IsBlocked(ctx, userID, tipsterID)
+	if err != nil {
+		return nil, err
+	}
+	if blocking {
+		return nil, errors.ErrCannotFollowBlocked
+	}
+	if tipster.Private {
+		return s.requestFollow(ctx, userID, tipsterID)
+	}
+
+	created, err := s.Repo.FollowTipster(ctx, userID, tipsterID)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.FollowTipsterResponse_FollowData{
-		IsFollowing: true,
-		TipsterId:   tipsterID.Hex(),
-		UserId:      userID.Hex(),
+		IsFollowing:      true,
+		TipsterId:        tipsterID.Hex(),
+		UserId:           userID.Hex(),
+		AlreadyFollowing: !created,
 	}, nil
 }
 
-func (s *SocialService) UnfollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) (*pb.UnfollowTipsterResponse_UnfollowData, error) {
-	err := s.Repo.UnfollowTipster(ctx, userID, tipsterID)
+func (s *SocialService) UnfollowTipster(ctx context.Context, tipsterID primitive.ObjectID) (*pb.UnfollowTipsterResponse_UnfollowData, error) {
+	userID, err := callerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = s.Repo.UnfollowTipster(ctx, userID, tipsterID)
+	if err != nil {
+		return nil, err
+	}
+	// Unfollowing a private tipster also withdraws a pending request
+	err = s.Repo.CancelFollowRequest(ctx, userID, tipsterID)
 	if err != nil {
 		return nil, err
 	}
@@ -124,3 +254,11 @@ func (s *SocialService) UnfollowTipster(ctx context.Context, userID, tipsterID p
 		UserId:      userID.Hex(),
 	}, nil
 }
+
+func (s *SocialService) ListFollowers(ctx context.Context, userID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error) {
+	return s.Repo.ListFollowers(ctx, userID, pageSize, nextCursor)
+}
+
+func (s *SocialService) ListFollowing(ctx context.Context, userID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error) {
+	return s.Repo.ListFollowing(ctx, userID, pageSize, nextCursor)
+}
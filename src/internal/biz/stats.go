@@ -0,0 +1,70 @@
+package biz
+
+import (
+	"context"
+
+	"src/internal/errors"
+	"src/internal/model"
+	"src/internal/stats"
+	pb "src/protos/Tipster"
+	commonpb "src/protos/YM.Common"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// settledTipsFilter narrows settled tips down by sport, tag and the range
+// their event started in. Empty values do not filter.
+func settledTipsFilter(sport, tag string, dateRange *commonpb.DateRange) bson.M {
+	filter := bson.M{}
+	if sport != "" {
+		filter["selection.sport"] = sport
+	}
+	if tag != "" {
+		filter["tags"] = tag
+	}
+	eventStart := bson.M{}
+	if dateRange.GetDateStart().IsValid() {
+		eventStart["$gte"] = dateRange.GetDateStart().AsTime()
+	}
+	if dateRange.GetDateEnd().IsValid() {
+		eventStart["$lt"] = dateRange.GetDateEnd().AsTime()
+	}
+	if len(eventStart) > 0 {
+		filter["selection.eventStart"] = eventStart
+	}
+	return filter
+}
+
+// GetTipsterStats returns the tipster's record over their settled tips. The
+// record covers every tip, including those the caller may not see, so a
+// tipster cannot hide losing tips from it.
+func (s *SocialService) GetTipsterStats(ctx context.Context, tipsterID primitive.ObjectID, req *pb.GetTipsterStatsRequest) (*model.TipsterStats, error) {
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tipster, err := s.Repo.GetUser(ctx, tipsterID)
+	if err != nil {
+		return nil, err
+	}
+	if tipster.Deletion != nil {
+		return nil, errors.ToRpcError(mongo.ErrNoDocuments)
+	}
+	if err := s.checkNotBlocked(ctx, tipsterID.Hex(), viewerID); err != nil {
+		return nil, err
+	}
+
+	filter := settledTipsFilter(req.Sport, req.Tag, req.DateRange)
+	filter["tipsterId"] = tipsterID.Hex()
+	record := stats.NewAccumulator(tipsterID.Hex())
+	err = s.Repo.ForEachSettledTip(ctx, filter, func(tip *model.Tip) error {
+		record.Add(tip)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+	return record.Result(), nil
+}
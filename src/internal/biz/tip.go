@@ -3,6 +3,7 @@ package biz
 import (
 	"context"
 	"src/internal/errors"
+	"src/internal/ledger"
 	"src/internal/model"
 	pb "src/protos/Tipster"
 	"time"
@@ -13,57 +14,156 @@ import (
 )
 
 func (s *SocialService) CreateTip(ctx context.Context, req *pb.CreateTipRequest) (*pb.TipData, error) {
+	tipsterID, err := s.activeCaller(ctx)
+	if err != nil {
+		return nil, err
+	}
 	currentTime := time.Now().UTC()
+	shareType := shareTypeOf(req.ShareType, model.ShareTypePublic)
+
+	var selection *model.Selection
+	if req.Selection != nil {
+		selection, err = selectionOf(req.Selection, currentTime)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	tip := &model.Tip{
-		TipsterID: req.TipsterId,
+		ID:        primitive.NewObjectID(),
+		TipsterID: tipsterID.Hex(),
 		Title:     req.Title,
 		Content:   req.Content,
 		Tags:      req.Tags,
-		ShareType: req.ShareType,
+		ShareType: shareType,
+		ExpiresAt: storyExpiry(shareType, currentTime),
+		Selection: selection,
 		Likes:     []primitive.ObjectID{},
 		Unlikes:   []primitive.ObjectID{},
 		CreatedAt: currentTime,
 		UpdatedAt: currentTime,
 	}
 
-	tipID, err := s.Repo.CreateTip(ctx, tip)
+	tipID, err := s.Repo.CreateTip(ctx, tip, ledger.Created(tip, currentTime))
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
+	s.recordFeedEvent(ctx, tipsterID.Hex(), model.FeedActionPostTip, tipID.Hex(), req.Title)
 
-	return &pb.TipData{
+	data := &pb.TipData{
 		TipId:     tipID.Hex(),
-		TipsterId: req.TipsterId,
+		TipsterId: tipsterID.Hex(),
 		Title:     req.Title,
 		Content:   req.Content,
 		Tags:      req.Tags,
 		CreatedAt: timestamppb.New(currentTime),
 		UpdatedAt: timestamppb.New(currentTime),
-		ShareType: req.ShareType,
-	}, nil
+		ShareType: pb.ShareType(pb.ShareType_value["SHARE_TYPE_"+shareType]),
+		Selection: SelectionData(selection, req.OddsFormat),
+	}
+	if tip.ExpiresAt != nil {
+		data.ExpiresAt = timestamppb.New(*tip.ExpiresAt)
+	}
+	return data, nil
 }
 
+// GetTip returns the tip if the caller may see it. Tips hidden by the
+// visibility policy are reported as missing.
 func (s *SocialService) GetTip(ctx context.Context, tipID primitive.ObjectID) (*model.Tip, error) {
-	tip, err := s.Repo.GetTip(ctx, tipID)
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tip, err := s.visibleTip(ctx, viewerID, tipID)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
 	return tip, nil
 }
 
+// editAttempts bounds how often an edit is retried when another edit of the
+// tip got in first.
+const editAttempts = 3
+
+// UpdateTip replaces the tip's content and records the change as a new
+// revision. Updates that change nothing are not recorded.
 func (s *SocialService) UpdateTip(ctx context.Context, tipID primitive.ObjectID, req *pb.UpdateTipRequest) (*model.Tip, error) {
+	for attempt := 1; ; attempt++ {
+		tip, err := s.updateTip(ctx, tipID, req)
+		if err != errors.ErrEditConflict || attempt == editAttempts {
+			return tip, err
+		}
+	}
+}
+
+func (s *SocialService) updateTip(ctx context.Context, tipID primitive.ObjectID, req *pb.UpdateTipRequest) (*model.Tip, error) {
+	existing, err := s.Repo.GetTip(ctx, tipID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.authorizeContentEdit(ctx, existing.TipsterID); err != nil {
+		return nil, err
+	}
+
 	currentTime := time.Now().UTC()
-	updates := bson.M{
-		"$set": bson.M{
-			"title":     req.Title,
-			"content":   req.Content,
-			"tags":      req.Tags,
-			"shareType": req.ShareType,
-			"updatedAt": currentTime,
-		},
+	if err := s.checkTipEdit(existing, req, currentTime); err != nil {
+		return nil, err
+	}
+	before := tipVersionOf(existing)
+	after := before
+	after.Title = req.Title
+	after.Content = req.Content
+	after.Tags = req.Tags
+	after.ShareType = shareTypeOf(req.ShareType, existing.ShareType)
+
+	set := bson.M{
+		"title":     req.Title,
+		"content":   req.Content,
+		"tags":      req.Tags,
+		"updatedAt": currentTime,
+	}
+	updates := bson.M{"$set": set}
+	if after.ShareType != existing.ShareType {
+		set["shareType"] = after.ShareType
+		if expiresAt := storyExpiry(after.ShareType, currentTime); expiresAt != nil {
+			set["expiresAt"] = expiresAt
+		} else {
+			updates["$unset"] = bson.M{"expiresAt": ""}
+		}
+	}
+	if req.Selection != nil {
+		// Settled selections are part of the tipster's record, and so are
+		// the settled legs of an accumulator
+		if existing.Settlement != nil || hasSettledLegs(existing.Selection) {
+			return nil, errors.ErrTipSettled
+		}
+		selection, err := selectionOf(req.Selection, currentTime)
+		if err != nil {
+			return nil, err
+		}
+		set["selection"] = selection
+		after.Selection = selection
+	}
+
+	changes := tipChanges(before, after)
+	if len(changes) == 0 {
+		return existing, nil
+	}
+	revision := &model.TipRevision{
+		TipID:    tipID,
+		Number:   existing.Revisions + 1,
+		EditorID: existing.TipsterID,
+		Changes:  changes,
+		Before:   before,
+		After:    after,
+		EditedAt: currentTime,
+	}
+	tip, err := s.Repo.UpdateTip(ctx, tipID, updates, revision)
+	if errors.IsNotFound(err) {
+		// Edited or deleted since it was loaded
+		return nil, errors.ErrEditConflict
 	}
-	tip, err := s.Repo.UpdateTip(ctx, tipID, updates)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
@@ -71,7 +171,21 @@ func (s *SocialService) UpdateTip(ctx context.Context, tipID primitive.ObjectID,
 }
 
 func (s *SocialService) DeleteTip(ctx context.Context, tipID primitive.ObjectID) error {
-	err := s.Repo.DeleteTip(ctx, tipID)
+	tip, err := s.Repo.GetTip(ctx, tipID)
+	if err != nil {
+		return err
+	}
+	user, err := s.authorizeContentRemoval(ctx, tip.TipsterID)
+	if err != nil {
+		return err
+	}
+	// Moderators can still remove abusive tips after kick-off
+	currentTime := time.Now().UTC()
+	if TipLocked(tip, currentTime) && !canModerate(user) {
+		return errors.ErrTipLocked
+	}
+
+	err = s.Repo.DeleteTip(ctx, tipID, currentTime, ledger.Deleted(tip, currentTime))
 	if err != nil {
 		return errors.ToRpcError(err)
 	}
@@ -79,11 +193,25 @@ func (s *SocialService) DeleteTip(ctx context.Context, tipID primitive.ObjectID)
 }
 
 func (s *SocialService) ListTips(ctx context.Context, req *pb.ListTipsRequest) ([]*model.Tip, primitive.ObjectID, error) {
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, primitive.NilObjectID, err
+	}
+	hiddenIDs, err := s.Repo.ListRestrictedUserIDs(ctx, viewerID)
+	if err != nil {
+		return nil, primitive.NilObjectID, errors.ToRpcError(err)
+	}
+
+	filter, err := s.visibleTipsFilter(ctx, viewerID, time.Now().UTC())
+	if err != nil {
+		return nil, primitive.NilObjectID, errors.ToRpcError(err)
+	}
 
-	filter := bson.M{}
+	tipster := bson.M{"$nin": hexIDs(hiddenIDs)}
 	if req.TipsterId != "" {
-		filter["tipsterId"] = req.TipsterId
+		tipster["$eq"] = req.TipsterId
 	}
+	filter["tipsterId"] = tipster
 
 	tips, lastTipID, err := s.Repo.ListTips(ctx, filter, int64(req.PageSize), req.NextCursor)
 	if err != nil {
@@ -92,27 +220,109 @@ func (s *SocialService) ListTips(ctx context.Context, req *pb.ListTipsRequest) (
 	return tips, lastTipID, nil
 }
 
-func (s *SocialService) ShareTip(ctx context.Context, tipID primitive.ObjectID, shareType string) error {
+// ShareTip reshares the tip to the caller's followers. A tip can be shared
+// once per user; sharing it again returns the earlier share with
+// alreadyShared set.
+func (s *SocialService) ShareTip(ctx context.Context, tipID primitive.ObjectID, sharedAs pb.ShareType, comment string) (share *model.Share, alreadyShared bool, err error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.checkTipNotBlocked(ctx, tipID, userID); err != nil {
+		return nil, false, err
+	}
+
 	currentTime := time.Now().UTC()
-	err := s.Repo.ShareTip(ctx, tipID, shareType, currentTime)
+	shareType := shareTypeOf(sharedAs, model.ShareTypePublic)
+	share = &model.Share{
+		TipID:     tipID,
+		SharerID:  userID,
+		Comment:   comment,
+		ShareType: shareType,
+		ExpiresAt: storyExpiry(shareType, currentTime),
+		CreatedAt: currentTime,
+	}
+	created, err := s.Repo.CreateShare(ctx, share)
 	if err != nil {
-		return errors.ToRpcError(err)
+		return nil, false, errors.ToRpcError(err)
 	}
-	return nil
+	if !created {
+		share, err = s.Repo.GetShare(ctx, tipID, userID)
+		if err != nil {
+			return nil, false, errors.ToRpcError(err)
+		}
+		return share, true, nil
+	}
+	s.recordFeedEvent(ctx, userID.Hex(), model.FeedActionShareTip, tipID.Hex(), share.ID.Hex())
+	return share, false, nil
 }
 
-func (s *SocialService) LikeTip(ctx context.Context, tipID primitive.ObjectID, userID primitive.ObjectID) (int32, error) {
+// ListTipShares returns a page of the shares of a tip the caller may see,
+// newest first.
+func (s *SocialService) ListTipShares(ctx context.Context, tipID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.Share, string, error) {
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := s.visibleTip(ctx, viewerID, tipID); err != nil {
+		return nil, "", err
+	}
+	hiddenIDs, err := s.Repo.ListRestrictedUserIDs(ctx, viewerID)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+
+	filter, err := s.visibleSharesFilter(ctx, viewerID, time.Now().UTC())
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	filter["tipId"] = tipID
+	filter["sharerId"] = bson.M{"$nin": hiddenIDs}
+
+	shares, nextCursor, err := s.Repo.ListShares(ctx, filter, pageSize, nextCursor)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	return shares, nextCursor, nil
+}
+
+func (s *SocialService) LikeTip(ctx context.Context, tipID primitive.ObjectID) (int32, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkTipNotBlocked(ctx, tipID, userID); err != nil {
+		return 0, err
+	}
 	totalLikes, err := s.Repo.LikeTip(ctx, tipID, userID)
 	if err != nil {
 		return 0, errors.ToRpcError(err)
 	}
+	s.recordFeedEvent(ctx, userID.Hex(), model.FeedActionLikeTip, tipID.Hex(), "")
 	return totalLikes, nil
 }
 
-func (s *SocialService) UnlikeTip(ctx context.Context, tipID primitive.ObjectID, userID primitive.ObjectID) (int32, error) {
+func (s *SocialService) UnlikeTip(ctx context.Context, tipID primitive.ObjectID) (int32, error) {
+	userID, err := s.activeCaller(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.checkTipNotBlocked(ctx, tipID, userID); err != nil {
+		return 0, err
+	}
 	totalUnlikes, err := s.Repo.UnlikeTip(ctx, tipID, userID)
 	if err != nil {
 		return 0, errors.ToRpcError(err)
 	}
 	return totalUnlikes, nil
 }
+
+// checkTipNotBlocked returns ErrBlocked if the tip's author has blocked the
+// user. Tips the user may not see are reported as missing.
+func (s *SocialService) checkTipNotBlocked(ctx context.Context, tipID, userID primitive.ObjectID) error {
+	tip, err := s.visibleTip(ctx, userID, tipID)
+	if err != nil {
+		return err
+	}
+	return s.checkNotBlocked(ctx, tip.TipsterID, userID)
+}
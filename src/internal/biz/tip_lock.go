@@ -0,0 +1,83 @@
+package biz
+
+import (
+	"slices"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/model"
+	pb "src/protos/Tipster"
+)
+
+// minCosmeticEdit is the number of characters a cosmetic fix may always
+// change, however short the content is.
+const minCosmeticEdit = 10
+
+// TipLocked reports whether the tip's betting content can no longer change:
+// its selection has started, or the first leg of its accumulator.
+func TipLocked(tip *model.Tip, now time.Time) bool {
+	return tip.Selection != nil && !now.Before(tip.Selection.EventStart)
+}
+
+// checkTipEdit rejects updates of a locked tip that touch anything but the
+// content, and content changes that are more than a few typos or come
+// after the grace period. Otherwise a tipster could rewrite a tip once they
+// know how the event went.
+func (s *SocialService) checkTipEdit(tip *model.Tip, req *pb.UpdateTipRequest, now time.Time) error {
+	if !TipLocked(tip, now) {
+		return nil
+	}
+	if req.Selection != nil || req.Title != tip.Title || !slices.Equal(req.Tags, tip.Tags) ||
+		shareTypeOf(req.ShareType, tip.ShareType) != tip.ShareType {
+		return errors.ErrTipLocked
+	}
+	if req.Content == tip.Content {
+		return nil
+	}
+	if now.After(tip.Selection.EventStart.Add(s.TipEditGracePeriod)) || !isCosmeticEdit(tip.Content, req.Content) {
+		return errors.ErrTipLocked
+	}
+	return nil
+}
+
+// isCosmeticEdit reports whether to differs from from by a few characters,
+// at most a tenth of the text or minCosmeticEdit, whichever is more.
+func isCosmeticEdit(from, to string) bool {
+	a, b := []rune(from), []rune(to)
+	limit := max(minCosmeticEdit, max(len(a), len(b))/10)
+	if abs(len(a)-len(b)) > limit {
+		return false
+	}
+
+	// Levenshtein distance over two rows, giving up once every path is over
+	// the limit
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		best := curr[0]
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			best = min(best, curr[j])
+		}
+		if best > limit {
+			return false
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)] <= limit
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
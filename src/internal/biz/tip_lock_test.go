@@ -0,0 +1,127 @@
+package biz
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"src/internal/errors"
+	"src/internal/model"
+	pb "src/protos/Tipster"
+)
+
+func TestTipLocked(t *testing.T) {
+	start := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	tip := &model.Tip{Selection: &model.Selection{EventStart: start}}
+
+	tests := []struct {
+		name string
+		tip  *model.Tip
+		now  time.Time
+		want bool
+	}{
+		{"no selection", &model.Tip{}, start.Add(time.Hour), false},
+		{"before kick-off", tip, start.Add(-time.Nanosecond), false},
+		{"at kick-off", tip, start, true},
+		{"after kick-off", tip, start.Add(time.Hour), true},
+	}
+	for _, tt := range tests {
+		if got := TipLocked(tt.tip, tt.now); got != tt.want {
+			t.Errorf("%s: TipLocked = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCheckTipEdit(t *testing.T) {
+	start := time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+	s := &SocialService{TipEditGracePeriod: 15 * time.Minute}
+	tip := &model.Tip{
+		Title:     "Banker",
+		Content:   "Home side are unbeaten in ten and the away keeper is out injured.",
+		Tags:      []string{"football"},
+		ShareType: model.ShareTypePublic,
+		Selection: &model.Selection{EventStart: start},
+	}
+	unchanged := func() *pb.UpdateTipRequest {
+		return &pb.UpdateTipRequest{Title: tip.Title, Content: tip.Content, Tags: tip.Tags}
+	}
+	typo := strings.Replace(tip.Content, "unbeaten", "unbeatn", 1)
+	rewrite := "Away side look sharper, backing the draw instead of the home win."
+
+	tests := []struct {
+		name    string
+		change  func(req *pb.UpdateTipRequest)
+		now     time.Time
+		wantErr error
+	}{
+		{"anything before kick-off", func(req *pb.UpdateTipRequest) {
+			req.Title, req.Content, req.Selection = "Changed", rewrite, &pb.TipSelection{}
+		}, start.Add(-time.Second), nil},
+		{"nothing at kick-off", func(req *pb.UpdateTipRequest) {}, start, nil},
+		{"unspecified share type", func(req *pb.UpdateTipRequest) {
+			req.ShareType = pb.ShareType_SHARE_TYPE_UNSPECIFIED
+		}, start, nil},
+		{"same share type", func(req *pb.UpdateTipRequest) {
+			req.ShareType = pb.ShareType_SHARE_TYPE_PUBLIC
+		}, start, nil},
+		{"title", func(req *pb.UpdateTipRequest) { req.Title = "Changed" }, start, errors.ErrTipLocked},
+		{"tags", func(req *pb.UpdateTipRequest) { req.Tags = []string{"tennis"} }, start, errors.ErrTipLocked},
+		{"share type", func(req *pb.UpdateTipRequest) {
+			req.ShareType = pb.ShareType_SHARE_TYPE_FRIENDS_ONLY
+		}, start, errors.ErrTipLocked},
+		{"selection", func(req *pb.UpdateTipRequest) { req.Selection = &pb.TipSelection{} }, start, errors.ErrTipLocked},
+		{"typo at kick-off", func(req *pb.UpdateTipRequest) { req.Content = typo }, start, nil},
+		{"typo at the end of the grace period", func(req *pb.UpdateTipRequest) {
+			req.Content = typo
+		}, start.Add(15 * time.Minute), nil},
+		{"typo after the grace period", func(req *pb.UpdateTipRequest) {
+			req.Content = typo
+		}, start.Add(15*time.Minute + time.Nanosecond), errors.ErrTipLocked},
+		{"rewrite in the grace period", func(req *pb.UpdateTipRequest) {
+			req.Content = rewrite
+		}, start.Add(time.Minute), errors.ErrTipLocked},
+	}
+	for _, tt := range tests {
+		req := unchanged()
+		tt.change(req)
+		if err := s.checkTipEdit(tip, req, tt.now); err != tt.wantErr {
+			t.Errorf("%s: checkTipEdit = %v, want %v", tt.name, err, tt.wantErr)
+		}
+	}
+}
+
+func TestIsCosmeticEdit(t *testing.T) {
+	long := strings.Repeat("abcdefghij", 20)
+	// substitute replaces the first n characters of s with "X"
+	substitute := func(s string, n int) string {
+		return strings.Repeat("X", n) + s[n:]
+	}
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     bool
+	}{
+		{"same", "Home win", "Home win", true},
+		{"one typo", "Home win", "Hme win", true},
+		{"short text, 10 changes", "", strings.Repeat("a", 10), true},
+		{"short text, 11 changes", "", strings.Repeat("a", 11), false},
+		{"short text, 10 substitutions", strings.Repeat("a", 20), substitute(strings.Repeat("a", 20), 10), true},
+		{"short text, 11 substitutions", strings.Repeat("a", 20), substitute(strings.Repeat("a", 20), 11), false},
+		{"long text, a tenth changed", long, substitute(long, 20), true},
+		{"long text, over a tenth changed", long, substitute(long, 21), false},
+		{"long text, 22 of 222 appended", long, long + strings.Repeat("a", 22), true},
+		{"long text, 23 of 223 appended", long, long + strings.Repeat("a", 23), false},
+		{"long text, a tenth removed", long, long[20:], true},
+		{"limit taken from the longer text", long[:100], long[:100] + strings.Repeat("a", 11), true},
+		{"characters, not bytes", "Olé, olé, olé", "Ole, ole, ole", true},
+		{"multibyte over the limit", strings.Repeat("é", 11), strings.Repeat("e", 11), false},
+		{"swapped words", "Home to win at evens", "Away to win at evens", true},
+		{"different pick", "Back the home side to win", "Back the away side to lose badly", false},
+	}
+	for _, tt := range tests {
+		if got := isCosmeticEdit(tt.from, tt.to); got != tt.want {
+			t.Errorf("%s: isCosmeticEdit = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
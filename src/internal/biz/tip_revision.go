@@ -0,0 +1,73 @@
+package biz
+
+import (
+	"bytes"
+	"context"
+	"slices"
+
+	"src/internal/errors"
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func tipVersionOf(tip *model.Tip) model.TipVersion {
+	return model.TipVersion{
+		Title:     tip.Title,
+		Content:   tip.Content,
+		Tags:      tip.Tags,
+		ShareType: tip.ShareType,
+		Selection: tip.Selection,
+	}
+}
+
+// tipChanges returns the fields that differ between two versions of a tip.
+// Selections are compared as stored, so a selection sent again unchanged is
+// not a change.
+func tipChanges(before, after model.TipVersion) []string {
+	changes := []string{}
+	if before.Title != after.Title {
+		changes = append(changes, model.TipFieldTitle)
+	}
+	if before.Content != after.Content {
+		changes = append(changes, model.TipFieldContent)
+	}
+	if !slices.Equal(before.Tags, after.Tags) {
+		changes = append(changes, model.TipFieldTags)
+	}
+	if before.ShareType != after.ShareType {
+		changes = append(changes, model.TipFieldShareType)
+	}
+	if !sameSelection(before.Selection, after.Selection) {
+		changes = append(changes, model.TipFieldSelection)
+	}
+	return changes
+}
+
+func sameSelection(a, b *model.Selection) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	aDoc, aErr := bson.Marshal(a)
+	bDoc, bErr := bson.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aDoc, bDoc)
+}
+
+// ListTipRevisions returns a page of the edits of a tip the caller may see,
+// oldest first.
+func (s *SocialService) ListTipRevisions(ctx context.Context, tipID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.TipRevision, string, error) {
+	viewerID, err := s.viewerID(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := s.visibleTip(ctx, viewerID, tipID); err != nil {
+		return nil, "", err
+	}
+
+	revisions, nextCursor, err := s.Repo.ListTipRevisions(ctx, tipID, pageSize, nextCursor)
+	if err != nil {
+		return nil, "", errors.ToRpcError(err)
+	}
+	return revisions, nextCursor, nil
+}
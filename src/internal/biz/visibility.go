@@ -0,0 +1,139 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"src/internal/model"
+	pb "src/protos/Tipster"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// storyLifetime is how long a STORY tip stays visible after it was shared.
+const storyLifetime = 24 * time.Hour
+
+// Tip visibility policy, applied to every read of tips and their comments:
+//
+//	PUBLIC        everyone
+//	FRIENDS_ONLY  the tipster and their approved followers
+//	STORY         everyone, until ExpiresAt
+//
+// Tips with any other share type were stored before share types were
+// validated and are treated as public. Deleted tips are hidden from everyone. Shares follow the same policy with
+// the sharer in place of the tipster, on top of the policy of their tip.
+
+// shareTypeOf converts a requested share type to the stored one. Unset
+// requests keep current.
+func shareTypeOf(shareType pb.ShareType, current string) string {
+	if shareType == pb.ShareType_SHARE_TYPE_UNSPECIFIED {
+		return current
+	}
+	return strings.TrimPrefix(shareType.String(), "SHARE_TYPE_")
+}
+
+// storyExpiry returns when a tip shared as shareType at sharedAt expires,
+// or nil if it does not.
+func storyExpiry(shareType string, sharedAt time.Time) *time.Time {
+	if shareType != model.ShareTypeStory {
+		return nil
+	}
+	expiresAt := sharedAt.Add(storyLifetime)
+	return &expiresAt
+}
+
+// canViewTip applies the policy to a single tip.
+func (s *SocialService) canViewTip(ctx context.Context, viewerID primitive.ObjectID, tip *model.Tip, now time.Time) (bool, error) {
+	if tip.DeletedAt != nil {
+		return false, nil
+	}
+	switch tip.ShareType {
+	case model.ShareTypeFriendsOnly:
+		if tip.TipsterID == viewerID.Hex() {
+			return true, nil
+		}
+		tipsterID, err := primitive.ObjectIDFromHex(tip.TipsterID)
+		if err != nil {
+			return false, nil
+		}
+		// Pending follow requests do not count, only approved followers
+		return s.Repo.IsFollowing(ctx, viewerID, tipsterID)
+	case model.ShareTypeStory:
+		// Stories stored without an expiry are treated as expired
+		return tip.ExpiresAt != nil && tip.ExpiresAt.After(now), nil
+	}
+	return true, nil
+}
+
+// visibleTipsFilter returns the policy as a filter on the tips collection.
+func (s *SocialService) visibleTipsFilter(ctx context.Context, viewerID primitive.ObjectID, now time.Time) (bson.M, error) {
+	friendIDs, err := s.Repo.ListFolloweeIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	friendIDs = append(friendIDs, viewerID)
+	filter := audienceFilter("tipsterId", hexIDs(friendIDs), now)
+	filter["deletedAt"] = bson.M{"$exists": false}
+	return filter, nil
+}
+
+// visibleSharesFilter returns the policy as a filter on the shares
+// collection. It does not check the shared tips.
+func (s *SocialService) visibleSharesFilter(ctx context.Context, viewerID primitive.ObjectID, now time.Time) (bson.M, error) {
+	friendIDs, err := s.Repo.ListFolloweeIDs(ctx, viewerID)
+	if err != nil {
+		return nil, err
+	}
+	friendIDs = append(friendIDs, viewerID)
+	return audienceFilter("sharerId", friendIDs, now), nil
+}
+
+// audienceFilter matches the documents the policy shows to a viewer with
+// the given friends. friendIDs must be stored like authorField: hex strings
+// for tipsterId, ObjectIDs for sharerId.
+func audienceFilter(authorField string, friendIDs interface{}, now time.Time) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"shareType": bson.M{"$nin": bson.A{model.ShareTypeFriendsOnly, model.ShareTypeStory}}},
+		bson.M{"shareType": model.ShareTypeFriendsOnly, authorField: bson.M{"$in": friendIDs}},
+		bson.M{"shareType": model.ShareTypeStory, "expiresAt": bson.M{"$gt": now}},
+	}}
+}
+
+// visibleTip loads a tip the viewer may see. Deleted tips and tips hidden by
+// the policy are reported as missing.
+func (s *SocialService) visibleTip(ctx context.Context, viewerID, tipID primitive.ObjectID) (*model.Tip, error) {
+	tip, err := s.Repo.GetTip(ctx, tipID)
+	if err != nil {
+		return nil, err
+	}
+	visible, err := s.canViewTip(ctx, viewerID, tip, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if !visible {
+		return nil, mongo.ErrNoDocuments
+	}
+	return tip, nil
+}
+
+// commentTipID walks up a reply thread to the tip it belongs to. Replies
+// only reference their parent comment.
+func (s *SocialService) commentTipID(ctx context.Context, commentID primitive.ObjectID) (primitive.ObjectID, error) {
+	for {
+		comment, err := s.Repo.GetComment(ctx, commentID)
+		if err != nil {
+			return primitive.NilObjectID, err
+		}
+		if comment.TipID != "" {
+			return primitive.ObjectIDFromHex(comment.TipID)
+		}
+		parentID, err := primitive.ObjectIDFromHex(comment.ParentID)
+		if err != nil || parentID == comment.ID {
+			return primitive.NilObjectID, mongo.ErrNoDocuments
+		}
+		commentID = parentID
+	}
+}
@@ -22,12 +22,67 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// What the purge does with the user's tips and comments. The user's tip
+// ledger is kept either way; removed tips are recorded as deleted in it
+type Deletion_ContentPolicy int32
+
+const (
+	// Keep the content, detached from the user
+	Deletion_ANONYMIZE Deletion_ContentPolicy = 0
+	// Delete the content, including comments others left on the tips
+	Deletion_REMOVE Deletion_ContentPolicy = 1
+)
+
+// Enum value maps for Deletion_ContentPolicy.
+var (
+	Deletion_ContentPolicy_name = map[int32]string{
+		0: "ANONYMIZE",
+		1: "REMOVE",
+	}
+	Deletion_ContentPolicy_value = map[string]int32{
+		"ANONYMIZE": 0,
+		"REMOVE":    1,
+	}
+)
+
+func (x Deletion_ContentPolicy) Enum() *Deletion_ContentPolicy {
+	p := new(Deletion_ContentPolicy)
+	*p = x
+	return p
+}
+
+func (x Deletion_ContentPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Deletion_ContentPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_internal_conf_conf_proto_enumTypes[0].Descriptor()
+}
+
+func (Deletion_ContentPolicy) Type() protoreflect.EnumType {
+	return &file_src_internal_conf_conf_proto_enumTypes[0]
+}
+
+func (x Deletion_ContentPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Deletion_ContentPolicy.Descriptor instead.
+func (Deletion_ContentPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{8, 0}
+}
+
 type Bootstrap struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Server        *Server                `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	Mongodb       *MongoDbConnection     `protobuf:"bytes,2,opt,name=mongodb,proto3" json:"mongodb,omitempty"`
 	GrpcServer    *GRPCServer            `protobuf:"bytes,3,opt,name=grpc_server,json=grpcServer,proto3" json:"grpc_server,omitempty"`
 	Consul        *Consul                `protobuf:"bytes,4,opt,name=consul,proto3" json:"consul,omitempty"`
+	Feed          *Feed                  `protobuf:"bytes,5,opt,name=feed,proto3" json:"feed,omitempty"`
+	Auth          *Auth                  `protobuf:"bytes,6,opt,name=auth,proto3" json:"auth,omitempty"`
+	Jobs          *Jobs                  `protobuf:"bytes,7,opt,name=jobs,proto3" json:"jobs,omitempty"`
+	Deletion      *Deletion              `protobuf:"bytes,8,opt,name=deletion,proto3" json:"deletion,omitempty"`
+	Tips          *Tips                  `protobuf:"bytes,9,opt,name=tips,proto3" json:"tips,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -90,6 +145,41 @@ func (x *Bootstrap) GetConsul() *Consul {
 	return nil
 }
 
+func (x *Bootstrap) GetFeed() *Feed {
+	if x != nil {
+		return x.Feed
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetAuth() *Auth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetJobs() *Jobs {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetDeletion() *Deletion {
+	if x != nil {
+		return x.Deletion
+	}
+	return nil
+}
+
+func (x *Bootstrap) GetTips() *Tips {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
 type Server struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Http          *Server_HTTP           `protobuf:"bytes,1,opt,name=http,proto3" json:"http,omitempty"`
@@ -135,9 +225,13 @@ func (x *Server) GetHttp() *Server_HTTP {
 }
 
 type MongoDbConnection struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Uri           string                 `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
-	Database      string                 `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Must reach a replica set or a sharded cluster, since writes that touch
+	// several documents run in transactions. The database under database/ is
+	// a single-node replica set, connect to it from the host with
+	// directConnection=true.
+	Uri           string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+	Database      string `protobuf:"bytes,2,opt,name=database,proto3" json:"database,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -282,6 +376,283 @@ func (x *Consul) GetAddress() string {
 	return ""
 }
 
+type Feed struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Tipsters with more followers than this are not fanned out on write;
+	// their events are merged into timelines when the feed is read.
+	FanoutFollowerThreshold int64 `protobuf:"varint,1,opt,name=fanout_follower_threshold,json=fanoutFollowerThreshold,proto3" json:"fanout_follower_threshold,omitempty"`
+	// Number of recent events copied into a timeline on a new follow.
+	BackfillLimit int64 `protobuf:"varint,2,opt,name=backfill_limit,json=backfillLimit,proto3" json:"backfill_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Feed) Reset() {
+	*x = Feed{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Feed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Feed) ProtoMessage() {}
+
+func (x *Feed) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Feed.ProtoReflect.Descriptor instead.
+func (*Feed) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Feed) GetFanoutFollowerThreshold() int64 {
+	if x != nil {
+		return x.FanoutFollowerThreshold
+	}
+	return 0
+}
+
+func (x *Feed) GetBackfillLimit() int64 {
+	if x != nil {
+		return x.BackfillLimit
+	}
+	return 0
+}
+
+type Auth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Password      *Auth_Password         `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	Token         *Auth_Token            `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	Identity      *Auth_Identity         `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth) Reset() {
+	*x = Auth{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth) ProtoMessage() {}
+
+func (x *Auth) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth.ProtoReflect.Descriptor instead.
+func (*Auth) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Auth) GetPassword() *Auth_Password {
+	if x != nil {
+		return x.Password
+	}
+	return nil
+}
+
+func (x *Auth) GetToken() *Auth_Token {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *Auth) GetIdentity() *Auth_Identity {
+	if x != nil {
+		return x.Identity
+	}
+	return nil
+}
+
+type Jobs struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How often self-exclusions that have run out are released and recorded
+	SelfExclusionExpiryInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=self_exclusion_expiry_interval,json=selfExclusionExpiryInterval,proto3" json:"self_exclusion_expiry_interval,omitempty"`
+	// How often deleted users past their grace period are purged
+	UserPurgeInterval *durationpb.Duration `protobuf:"bytes,2,opt,name=user_purge_interval,json=userPurgeInterval,proto3" json:"user_purge_interval,omitempty"`
+	// How often the leaderboards are recomputed from settled tips
+	LeaderboardInterval *durationpb.Duration `protobuf:"bytes,3,opt,name=leaderboard_interval,json=leaderboardInterval,proto3" json:"leaderboard_interval,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *Jobs) Reset() {
+	*x = Jobs{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Jobs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Jobs) ProtoMessage() {}
+
+func (x *Jobs) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Jobs.ProtoReflect.Descriptor instead.
+func (*Jobs) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *Jobs) GetSelfExclusionExpiryInterval() *durationpb.Duration {
+	if x != nil {
+		return x.SelfExclusionExpiryInterval
+	}
+	return nil
+}
+
+func (x *Jobs) GetUserPurgeInterval() *durationpb.Duration {
+	if x != nil {
+		return x.UserPurgeInterval
+	}
+	return nil
+}
+
+func (x *Jobs) GetLeaderboardInterval() *durationpb.Duration {
+	if x != nil {
+		return x.LeaderboardInterval
+	}
+	return nil
+}
+
+type Deletion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long a deleted user can be restored before the purge
+	GracePeriod   *durationpb.Duration   `protobuf:"bytes,1,opt,name=grace_period,json=gracePeriod,proto3" json:"grace_period,omitempty"`
+	ContentPolicy Deletion_ContentPolicy `protobuf:"varint,2,opt,name=content_policy,json=contentPolicy,proto3,enum=kratos.api.Deletion_ContentPolicy" json:"content_policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Deletion) Reset() {
+	*x = Deletion{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Deletion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Deletion) ProtoMessage() {}
+
+func (x *Deletion) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Deletion.ProtoReflect.Descriptor instead.
+func (*Deletion) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Deletion) GetGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.GracePeriod
+	}
+	return nil
+}
+
+func (x *Deletion) GetContentPolicy() Deletion_ContentPolicy {
+	if x != nil {
+		return x.ContentPolicy
+	}
+	return Deletion_ANONYMIZE
+}
+
+type Tips struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long after kick-off the commentary of a tip can still get cosmetic
+	// fixes. Everything else is locked from kick-off
+	EditGracePeriod *durationpb.Duration `protobuf:"bytes,1,opt,name=edit_grace_period,json=editGracePeriod,proto3" json:"edit_grace_period,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Tips) Reset() {
+	*x = Tips{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Tips) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Tips) ProtoMessage() {}
+
+func (x *Tips) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Tips.ProtoReflect.Descriptor instead.
+func (*Tips) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Tips) GetEditGracePeriod() *durationpb.Duration {
+	if x != nil {
+		return x.EditGracePeriod
+	}
+	return nil
+}
+
 type Server_HTTP struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Network       string                 `protobuf:"bytes,1,opt,name=network,proto3" json:"network,omitempty"`
@@ -293,7 +664,7 @@ type Server_HTTP struct {
 
 func (x *Server_HTTP) Reset() {
 	*x = Server_HTTP{}
-	mi := &file_src_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_src_internal_conf_conf_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -305,7 +676,7 @@ func (x *Server_HTTP) String() string {
 func (*Server_HTTP) ProtoMessage() {}
 
 func (x *Server_HTTP) ProtoReflect() protoreflect.Message {
-	mi := &file_src_internal_conf_conf_proto_msgTypes[5]
+	mi := &file_src_internal_conf_conf_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -342,6 +713,300 @@ func (x *Server_HTTP) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+// argon2id cost parameters; unset fields use the built-in defaults.
+// Stored hashes made with other parameters are upgraded on next login.
+type Auth_Password struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MemoryKib     uint32                 `protobuf:"varint,1,opt,name=memory_kib,json=memoryKib,proto3" json:"memory_kib,omitempty"`
+	Iterations    uint32                 `protobuf:"varint,2,opt,name=iterations,proto3" json:"iterations,omitempty"`
+	Parallelism   uint32                 `protobuf:"varint,3,opt,name=parallelism,proto3" json:"parallelism,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Password) Reset() {
+	*x = Auth_Password{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Password) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Password) ProtoMessage() {}
+
+func (x *Auth_Password) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Password.ProtoReflect.Descriptor instead.
+func (*Auth_Password) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{6, 0}
+}
+
+func (x *Auth_Password) GetMemoryKib() uint32 {
+	if x != nil {
+		return x.MemoryKib
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetIterations() uint32 {
+	if x != nil {
+		return x.Iterations
+	}
+	return 0
+}
+
+func (x *Auth_Password) GetParallelism() uint32 {
+	if x != nil {
+		return x.Parallelism
+	}
+	return 0
+}
+
+type Auth_Token struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// HMAC key used to sign access and refresh tokens, at least 32 bytes.
+	// Comes from the deployment, e.g. TIPSTER_AUTH_TOKEN_SECRET, never from
+	// a committed file
+	Secret        string               `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Issuer        string               `protobuf:"bytes,2,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	AccessTtl     *durationpb.Duration `protobuf:"bytes,3,opt,name=access_ttl,json=accessTtl,proto3" json:"access_ttl,omitempty"`
+	RefreshTtl    *durationpb.Duration `protobuf:"bytes,4,opt,name=refresh_ttl,json=refreshTtl,proto3" json:"refresh_ttl,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Token) Reset() {
+	*x = Auth_Token{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Token) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Token) ProtoMessage() {}
+
+func (x *Auth_Token) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Token.ProtoReflect.Descriptor instead.
+func (*Auth_Token) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{6, 1}
+}
+
+func (x *Auth_Token) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *Auth_Token) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *Auth_Token) GetAccessTtl() *durationpb.Duration {
+	if x != nil {
+		return x.AccessTtl
+	}
+	return nil
+}
+
+func (x *Auth_Token) GetRefreshTtl() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshTtl
+	}
+	return nil
+}
+
+// External identity providers; a provider is only enabled when configured.
+type Auth_Identity struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Google        *Auth_Identity_Google   `protobuf:"bytes,1,opt,name=google,proto3" json:"google,omitempty"`
+	Telegram      *Auth_Identity_Telegram `protobuf:"bytes,2,opt,name=telegram,proto3" json:"telegram,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Identity) Reset() {
+	*x = Auth_Identity{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Identity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Identity) ProtoMessage() {}
+
+func (x *Auth_Identity) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Identity.ProtoReflect.Descriptor instead.
+func (*Auth_Identity) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{6, 2}
+}
+
+func (x *Auth_Identity) GetGoogle() *Auth_Identity_Google {
+	if x != nil {
+		return x.Google
+	}
+	return nil
+}
+
+func (x *Auth_Identity) GetTelegram() *Auth_Identity_Telegram {
+	if x != nil {
+		return x.Telegram
+	}
+	return nil
+}
+
+type Auth_Identity_Google struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// OAuth client IDs whose ID tokens are accepted
+	ClientIds []string `protobuf:"bytes,1,rep,name=client_ids,json=clientIds,proto3" json:"client_ids,omitempty"`
+	// Defaults to Google's published signing keys
+	JwksUrl       string `protobuf:"bytes,2,opt,name=jwks_url,json=jwksUrl,proto3" json:"jwks_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Identity_Google) Reset() {
+	*x = Auth_Identity_Google{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Identity_Google) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Identity_Google) ProtoMessage() {}
+
+func (x *Auth_Identity_Google) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Identity_Google.ProtoReflect.Descriptor instead.
+func (*Auth_Identity_Google) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{6, 2, 0}
+}
+
+func (x *Auth_Identity_Google) GetClientIds() []string {
+	if x != nil {
+		return x.ClientIds
+	}
+	return nil
+}
+
+func (x *Auth_Identity_Google) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+type Auth_Identity_Telegram struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Token of the bot behind the Telegram Login Widget
+	BotToken string `protobuf:"bytes,1,opt,name=bot_token,json=botToken,proto3" json:"bot_token,omitempty"`
+	// How old login data may be; defaults to 24h
+	MaxAge        *durationpb.Duration `protobuf:"bytes,2,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Auth_Identity_Telegram) Reset() {
+	*x = Auth_Identity_Telegram{}
+	mi := &file_src_internal_conf_conf_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Auth_Identity_Telegram) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Auth_Identity_Telegram) ProtoMessage() {}
+
+func (x *Auth_Identity_Telegram) ProtoReflect() protoreflect.Message {
+	mi := &file_src_internal_conf_conf_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Auth_Identity_Telegram.ProtoReflect.Descriptor instead.
+func (*Auth_Identity_Telegram) Descriptor() ([]byte, []int) {
+	return file_src_internal_conf_conf_proto_rawDescGZIP(), []int{6, 2, 1}
+}
+
+func (x *Auth_Identity_Telegram) GetBotToken() string {
+	if x != nil {
+		return x.BotToken
+	}
+	return ""
+}
+
+func (x *Auth_Identity_Telegram) GetMaxAge() *durationpb.Duration {
+	if x != nil {
+		return x.MaxAge
+	}
+	return nil
+}
+
 var File_src_internal_conf_conf_proto protoreflect.FileDescriptor
 
 var file_src_internal_conf_conf_proto_rawDesc = string([]byte{
@@ -349,7 +1014,7 @@ var file_src_internal_conf_conf_proto_rawDesc = string([]byte{
 	0x6f, 0x6e, 0x66, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a,
 	0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x01, 0x0a, 0x09, 0x42,
+	0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x03, 0x0a, 0x09, 0x42,
 	0x6f, 0x6f, 0x74, 0x73, 0x74, 0x72, 0x61, 0x70, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f,
 	0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x52, 0x06, 0x73, 0x65,
@@ -363,29 +1028,128 @@ var file_src_internal_conf_conf_proto_rawDesc = string([]byte{
 	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x06, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6c,
 	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
 	0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x52, 0x06, 0x63, 0x6f, 0x6e, 0x73,
-	0x75, 0x6c, 0x22, 0xa0, 0x01, 0x0a, 0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2b, 0x0a,
-	0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x72,
-	0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x48, 0x54, 0x54, 0x50, 0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x1a, 0x69, 0x0a, 0x04, 0x48, 0x54,
-	0x54, 0x50, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04,
-	0x61, 0x64, 0x64, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72,
-	0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69,
-	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22, 0x41, 0x0a, 0x11, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x62,
-	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72,
-	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x47, 0x52, 0x50, 0x43,
-	0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
-	0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x22,
-	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73, 0x75, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x42, 0x18, 0x5a, 0x16, 0x73, 0x72, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e,
-	0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6c, 0x12, 0x24, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x04, 0x66, 0x65, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x04, 0x61, 0x75, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x52, 0x04, 0x61, 0x75, 0x74, 0x68, 0x12, 0x24,
+	0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b,
+	0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x04,
+	0x6a, 0x6f, 0x62, 0x73, 0x12, 0x30, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x69, 0x70, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x52, 0x04, 0x74, 0x69, 0x70, 0x73, 0x22, 0xa0, 0x01, 0x0a,
+	0x06, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x2b, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x52, 0x04,
+	0x68, 0x74, 0x74, 0x70, 0x1a, 0x69, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x64, 0x64, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x61, 0x64, 0x64, 0x72, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x22,
+	0x41, 0x0a, 0x11, 0x4d, 0x6f, 0x6e, 0x67, 0x6f, 0x44, 0x62, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x75, 0x72, 0x69, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x47, 0x52, 0x50, 0x43, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x22, 0x22, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x73,
+	0x75, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x69, 0x0a, 0x04,
+	0x46, 0x65, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x5f, 0x66,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x17, 0x66, 0x61, 0x6e, 0x6f, 0x75, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x25, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x66, 0x69, 0x6c, 0x6c, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x62, 0x61, 0x63, 0x6b, 0x66, 0x69,
+	0x6c, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe7, 0x05, 0x0a, 0x04, 0x41, 0x75, 0x74, 0x68,
+	0x12, 0x35, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x2c, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x52, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x1a, 0x6b, 0x0a, 0x08,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x5f, 0x6b, 0x69, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x6d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x4b, 0x69, 0x62, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61, 0x6c,
+	0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x70, 0x61,
+	0x72, 0x61, 0x6c, 0x6c, 0x65, 0x6c, 0x69, 0x73, 0x6d, 0x1a, 0xad, 0x01, 0x0a, 0x05, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x74,
+	0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x74, 0x6c, 0x12, 0x3a, 0x0a,
+	0x0b, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x5f, 0x74, 0x74, 0x6c, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x74, 0x6c, 0x1a, 0xa5, 0x02, 0x0a, 0x08, 0x49, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x2e, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x52, 0x06, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x12, 0x3e, 0x0a, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x75, 0x74, 0x68, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x54, 0x65,
+	0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d, 0x52, 0x08, 0x74, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x1a, 0x42, 0x0a, 0x06, 0x47, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6a, 0x77, 0x6b,
+	0x73, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x77, 0x6b,
+	0x73, 0x55, 0x72, 0x6c, 0x1a, 0x5b, 0x0a, 0x08, 0x54, 0x65, 0x6c, 0x65, 0x67, 0x72, 0x61, 0x6d,
+	0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x32, 0x0a,
+	0x07, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x41, 0x67,
+	0x65, 0x22, 0xff, 0x01, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x5e, 0x0a, 0x1e, 0x73, 0x65,
+	0x6c, 0x66, 0x5f, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x1b, 0x73,
+	0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x49, 0x0a, 0x13, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x70, 0x75, 0x72, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x50, 0x75, 0x72, 0x67, 0x65, 0x49, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x4c, 0x0a, 0x14, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x13,
+	0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x76, 0x61, 0x6c, 0x22, 0xbf, 0x01, 0x0a, 0x08, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x3c, 0x0a, 0x0c, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0b, 0x67, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x49,
+	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x22, 0x2e, 0x6b, 0x72, 0x61, 0x74, 0x6f, 0x73, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x2a, 0x0a, 0x0d, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0d, 0x0a, 0x09, 0x41, 0x4e,
+	0x4f, 0x4e, 0x59, 0x4d, 0x49, 0x5a, 0x45, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x4d,
+	0x4f, 0x56, 0x45, 0x10, 0x01, 0x22, 0x4d, 0x0a, 0x04, 0x54, 0x69, 0x70, 0x73, 0x12, 0x45, 0x0a,
+	0x11, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x65, 0x64, 0x69, 0x74, 0x47, 0x72, 0x61, 0x63, 0x65, 0x50, 0x65,
+	0x72, 0x69, 0x6f, 0x64, 0x42, 0x18, 0x5a, 0x16, 0x73, 0x72, 0x63, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -400,28 +1164,59 @@ func file_src_internal_conf_conf_proto_rawDescGZIP() []byte {
 	return file_src_internal_conf_conf_proto_rawDescData
 }
 
-var file_src_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_src_internal_conf_conf_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_src_internal_conf_conf_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_src_internal_conf_conf_proto_goTypes = []any{
-	(*Bootstrap)(nil),           // 0: kratos.api.Bootstrap
-	(*Server)(nil),              // 1: kratos.api.Server
-	(*MongoDbConnection)(nil),   // 2: kratos.api.MongoDbConnection
-	(*GRPCServer)(nil),          // 3: kratos.api.GRPCServer
-	(*Consul)(nil),              // 4: kratos.api.Consul
-	(*Server_HTTP)(nil),         // 5: kratos.api.Server.HTTP
-	(*durationpb.Duration)(nil), // 6: google.protobuf.Duration
+	(Deletion_ContentPolicy)(0),    // 0: kratos.api.Deletion.ContentPolicy
+	(*Bootstrap)(nil),              // 1: kratos.api.Bootstrap
+	(*Server)(nil),                 // 2: kratos.api.Server
+	(*MongoDbConnection)(nil),      // 3: kratos.api.MongoDbConnection
+	(*GRPCServer)(nil),             // 4: kratos.api.GRPCServer
+	(*Consul)(nil),                 // 5: kratos.api.Consul
+	(*Feed)(nil),                   // 6: kratos.api.Feed
+	(*Auth)(nil),                   // 7: kratos.api.Auth
+	(*Jobs)(nil),                   // 8: kratos.api.Jobs
+	(*Deletion)(nil),               // 9: kratos.api.Deletion
+	(*Tips)(nil),                   // 10: kratos.api.Tips
+	(*Server_HTTP)(nil),            // 11: kratos.api.Server.HTTP
+	(*Auth_Password)(nil),          // 12: kratos.api.Auth.Password
+	(*Auth_Token)(nil),             // 13: kratos.api.Auth.Token
+	(*Auth_Identity)(nil),          // 14: kratos.api.Auth.Identity
+	(*Auth_Identity_Google)(nil),   // 15: kratos.api.Auth.Identity.Google
+	(*Auth_Identity_Telegram)(nil), // 16: kratos.api.Auth.Identity.Telegram
+	(*durationpb.Duration)(nil),    // 17: google.protobuf.Duration
 }
 var file_src_internal_conf_conf_proto_depIdxs = []int32{
-	1, // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
-	2, // 1: kratos.api.Bootstrap.mongodb:type_name -> kratos.api.MongoDbConnection
-	3, // 2: kratos.api.Bootstrap.grpc_server:type_name -> kratos.api.GRPCServer
-	4, // 3: kratos.api.Bootstrap.consul:type_name -> kratos.api.Consul
-	5, // 4: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
-	6, // 5: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	2,  // 0: kratos.api.Bootstrap.server:type_name -> kratos.api.Server
+	3,  // 1: kratos.api.Bootstrap.mongodb:type_name -> kratos.api.MongoDbConnection
+	4,  // 2: kratos.api.Bootstrap.grpc_server:type_name -> kratos.api.GRPCServer
+	5,  // 3: kratos.api.Bootstrap.consul:type_name -> kratos.api.Consul
+	6,  // 4: kratos.api.Bootstrap.feed:type_name -> kratos.api.Feed
+	7,  // 5: kratos.api.Bootstrap.auth:type_name -> kratos.api.Auth
+	8,  // 6: kratos.api.Bootstrap.jobs:type_name -> kratos.api.Jobs
+	9,  // 7: kratos.api.Bootstrap.deletion:type_name -> kratos.api.Deletion
+	10, // 8: kratos.api.Bootstrap.tips:type_name -> kratos.api.Tips
+	11, // 9: kratos.api.Server.http:type_name -> kratos.api.Server.HTTP
+	12, // 10: kratos.api.Auth.password:type_name -> kratos.api.Auth.Password
+	13, // 11: kratos.api.Auth.token:type_name -> kratos.api.Auth.Token
+	14, // 12: kratos.api.Auth.identity:type_name -> kratos.api.Auth.Identity
+	17, // 13: kratos.api.Jobs.self_exclusion_expiry_interval:type_name -> google.protobuf.Duration
+	17, // 14: kratos.api.Jobs.user_purge_interval:type_name -> google.protobuf.Duration
+	17, // 15: kratos.api.Jobs.leaderboard_interval:type_name -> google.protobuf.Duration
+	17, // 16: kratos.api.Deletion.grace_period:type_name -> google.protobuf.Duration
+	0,  // 17: kratos.api.Deletion.content_policy:type_name -> kratos.api.Deletion.ContentPolicy
+	17, // 18: kratos.api.Tips.edit_grace_period:type_name -> google.protobuf.Duration
+	17, // 19: kratos.api.Server.HTTP.timeout:type_name -> google.protobuf.Duration
+	17, // 20: kratos.api.Auth.Token.access_ttl:type_name -> google.protobuf.Duration
+	17, // 21: kratos.api.Auth.Token.refresh_ttl:type_name -> google.protobuf.Duration
+	15, // 22: kratos.api.Auth.Identity.google:type_name -> kratos.api.Auth.Identity.Google
+	16, // 23: kratos.api.Auth.Identity.telegram:type_name -> kratos.api.Auth.Identity.Telegram
+	17, // 24: kratos.api.Auth.Identity.Telegram.max_age:type_name -> google.protobuf.Duration
+	25, // [25:25] is the sub-list for method output_type
+	25, // [25:25] is the sub-list for method input_type
+	25, // [25:25] is the sub-list for extension type_name
+	25, // [25:25] is the sub-list for extension extendee
+	0,  // [0:25] is the sub-list for field type_name
 }
 
 func init() { file_src_internal_conf_conf_proto_init() }
@@ -434,13 +1229,14 @@ func file_src_internal_conf_conf_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_src_internal_conf_conf_proto_rawDesc), len(file_src_internal_conf_conf_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   6,
+			NumEnums:      1,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_src_internal_conf_conf_proto_goTypes,
 		DependencyIndexes: file_src_internal_conf_conf_proto_depIdxs,
+		EnumInfos:         file_src_internal_conf_conf_proto_enumTypes,
 		MessageInfos:      file_src_internal_conf_conf_proto_msgTypes,
 	}.Build()
 	File_src_internal_conf_conf_proto = out.File
@@ -8,6 +8,64 @@ import (
 )
 
 var ErrEmailAlreadyExists = errors.New(400, "EMAIL_ALREADY_EXISTS", "email already exists")
+var ErrInvalidCredentials = errors.New(401, "INVALID_CREDENTIALS", "invalid email or password")
+var ErrAccountNotActive = errors.New(403, "ACCOUNT_NOT_ACTIVE", "account is not active")
+var ErrUnauthenticated = errors.New(401, "UNAUTHENTICATED", "authentication required")
+var ErrInvalidToken = errors.New(401, "INVALID_TOKEN", "invalid or expired token")
+var ErrPermissionDenied = errors.New(403, "PERMISSION_DENIED", "permission denied")
+var ErrSelfExcluded = errors.New(403, "SELF_EXCLUDED", "account is self-excluded")
+var ErrInvalidIdentity = errors.New(401, "INVALID_IDENTITY", "identity could not be verified")
+var ErrIdentityProviderDisabled = errors.New(400, "IDENTITY_PROVIDER_DISABLED", "identity provider is not enabled")
+var ErrIdentityAlreadyLinked = errors.New(409, "IDENTITY_ALREADY_LINKED", "identity is already linked to an account")
+var ErrInvalidStatusTransition = errors.New(409, "INVALID_STATUS_TRANSITION", "account status cannot be changed this way")
+var ErrInvalidSelfExclusionPeriod = errors.New(400, "INVALID_SELF_EXCLUSION_PERIOD", "invalid self-exclusion period")
+var ErrCannotFollowSelf = errors.New(400, "CANNOT_FOLLOW_SELF", "users cannot follow themselves")
+var ErrCannotRestrictSelf = errors.New(400, "CANNOT_RESTRICT_SELF", "users cannot block or mute themselves")
+var ErrBlocked = errors.New(403, "BLOCKED", "blocked by the user")
+var ErrCannotFollowBlocked = errors.New(409, "CANNOT_FOLLOW_BLOCKED", "unblock the user before following them")
+var ErrInvalidSelection = errors.New(400, "INVALID_SELECTION", "invalid selection")
+var ErrInvalidCursor = errors.New(400, "INVALID_CURSOR", "invalid page cursor")
+var ErrInvalidLeaderboardFilter = errors.New(400, "INVALID_LEADERBOARD_FILTER", "Leaderboards can be narrowed by sport or by tag, not both")
+var ErrNoSelection = errors.New(409, "NO_SELECTION", "tip has no selection")
+var ErrTipSettled = errors.New(409, "TIP_SETTLED", "tip is already settled")
+var ErrEventNotStarted = errors.New(409, "EVENT_NOT_STARTED", "event has not started")
+var ErrLegSettled = errors.New(409, "LEG_SETTLED", "leg is already settled")
+var ErrTipLocked = errors.New(409, "TIP_LOCKED", "tip is locked since its event started")
+var ErrEditConflict = errors.New(409, "EDIT_CONFLICT", "tip changed while it was edited")
+var ErrSettlementConflict = errors.New(409, "SETTLEMENT_CONFLICT", "tip changed while it was settled")
+
+// InvalidSelection returns an ErrInvalidSelection whose message says what is
+// wrong with the selection.
+func InvalidSelection(format string, a ...interface{}) error {
+	return errors.Errorf(int(ErrInvalidSelection.Code), ErrInvalidSelection.Reason, format, a...)
+}
+
+// IsAccessDenied reports whether err rejects the caller rather than the
+// request, so handlers return it as a gRPC error instead of a response code.
+func IsAccessDenied(err error) bool {
+	return errors.Is(err, ErrUnauthenticated) ||
+		errors.Is(err, ErrPermissionDenied) ||
+		errors.Is(err, ErrAccountNotActive) ||
+		errors.Is(err, ErrSelfExcluded) ||
+		errors.Is(err, ErrBlocked)
+}
+
+// IsInvalid reports whether err rejects the request's content. Message
+// returns the reason to show the caller.
+func IsInvalid(err error) bool {
+	return errors.Is(err, ErrInvalidSelection) || errors.Is(err, ErrInvalidLeaderboardFilter)
+}
+
+// Message returns the message of a sentinel error, or of the error it wraps.
+func Message(err error) string {
+	return errors.FromError(err).Message
+}
+
+// IsNotFound reports whether err is a missing record, before or after it
+// was converted by ToRpcError.
+func IsNotFound(err error) bool {
+	return errors.Is(err, mongo.ErrNoDocuments) || status.Code(err) == codes.NotFound
+}
 
 func ToRpcError(err error) error {
 	if errors.Is(err, mongo.ErrNoDocuments) {
@@ -16,5 +74,84 @@ func ToRpcError(err error) error {
 	if errors.Is(err, ErrEmailAlreadyExists) {
 		return status.Errorf(codes.AlreadyExists, "Email already exists")
 	}
+	if errors.Is(err, ErrInvalidCredentials) {
+		return status.Errorf(codes.Unauthenticated, "Invalid email or password")
+	}
+	if errors.Is(err, ErrUnauthenticated) {
+		return status.Errorf(codes.Unauthenticated, "Authentication required")
+	}
+	if errors.Is(err, ErrInvalidToken) {
+		return status.Errorf(codes.Unauthenticated, "Invalid or expired token")
+	}
+	if errors.Is(err, ErrAccountNotActive) {
+		return status.Errorf(codes.PermissionDenied, "Account is not active")
+	}
+	if errors.Is(err, ErrPermissionDenied) {
+		return status.Errorf(codes.PermissionDenied, "Permission denied")
+	}
+	if errors.Is(err, ErrSelfExcluded) {
+		return status.Errorf(codes.PermissionDenied, "Account is self-excluded")
+	}
+	if errors.Is(err, ErrInvalidIdentity) {
+		return status.Errorf(codes.Unauthenticated, "Identity could not be verified")
+	}
+	if errors.Is(err, ErrIdentityProviderDisabled) {
+		return status.Errorf(codes.InvalidArgument, "Identity provider is not enabled")
+	}
+	if errors.Is(err, ErrIdentityAlreadyLinked) {
+		return status.Errorf(codes.AlreadyExists, "Identity is already linked to an account")
+	}
+	if errors.Is(err, ErrInvalidStatusTransition) {
+		return status.Errorf(codes.FailedPrecondition, "Account status cannot be changed this way")
+	}
+	if errors.Is(err, ErrInvalidSelfExclusionPeriod) {
+		return status.Errorf(codes.InvalidArgument, "Invalid self-exclusion period")
+	}
+	if errors.Is(err, ErrCannotFollowSelf) {
+		return status.Errorf(codes.InvalidArgument, "Users cannot follow themselves")
+	}
+	if errors.Is(err, ErrCannotRestrictSelf) {
+		return status.Errorf(codes.InvalidArgument, "Users cannot block or mute themselves")
+	}
+	if errors.Is(err, ErrBlocked) {
+		return status.Errorf(codes.PermissionDenied, "Blocked by the user")
+	}
+	if errors.Is(err, ErrCannotFollowBlocked) {
+		return status.Errorf(codes.FailedPrecondition, "Unblock the user before following them")
+	}
+	if errors.Is(err, ErrInvalidSelection) {
+		return status.Error(codes.InvalidArgument, Message(err))
+	}
+	if errors.Is(err, ErrInvalidCursor) {
+		return status.Errorf(codes.InvalidArgument, "Invalid page cursor")
+	}
+	if errors.Is(err, ErrInvalidLeaderboardFilter) {
+		return status.Error(codes.InvalidArgument, Message(err))
+	}
+	if errors.Is(err, ErrNoSelection) {
+		return status.Errorf(codes.FailedPrecondition, "Tip has no selection")
+	}
+	if errors.Is(err, ErrTipSettled) {
+		return status.Errorf(codes.FailedPrecondition, "Tip is already settled")
+	}
+	if errors.Is(err, ErrEventNotStarted) {
+		return status.Errorf(codes.FailedPrecondition, "Event has not started")
+	}
+	if errors.Is(err, ErrLegSettled) {
+		return status.Errorf(codes.FailedPrecondition, "Leg is already settled")
+	}
+	if errors.Is(err, ErrTipLocked) {
+		return status.Errorf(codes.FailedPrecondition, "Tip is locked since its event started")
+	}
+	if errors.Is(err, ErrEditConflict) {
+		return status.Errorf(codes.Aborted, "Tip changed while it was edited, try again")
+	}
+	if errors.Is(err, ErrSettlementConflict) {
+		return status.Errorf(codes.Aborted, "Tip changed while it was settled, try again")
+	}
+	// Already converted further down the call chain
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
 	return status.Errorf(codes.Internal, "Internal server error: %v", err)
 }
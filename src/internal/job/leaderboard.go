@@ -0,0 +1,207 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"src/internal/conf"
+	"src/internal/model"
+	"src/internal/repository"
+	"src/internal/stats"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	defaultLeaderboardInterval = time.Hour
+	leaderboardBatchSize       = 500
+)
+
+// leaderboardWindows are the windows a leaderboard is computed for, with
+// how far back each reaches. Zero reaches back to the first tip.
+var leaderboardWindows = []struct {
+	name   string
+	length time.Duration
+}{
+	{model.WindowLast7Days, 7 * 24 * time.Hour},
+	{model.WindowLast30Days, 30 * 24 * time.Hour},
+	{model.WindowLast90Days, 90 * 24 * time.Hour},
+	{model.WindowAllTime, 0},
+}
+
+// Leaderboard periodically recomputes the tipster records behind the
+// leaderboards: overall, per sport and per tag, for every window. Each run
+// publishes a new snapshot per window, so readers never see a half-built
+// leaderboard.
+type Leaderboard struct {
+	*periodic
+	repo   repository.SocialRepository
+	logger log.Logger
+}
+
+func NewLeaderboard(repo repository.SocialRepository, jobsConf *conf.Jobs, logger log.Logger) *Leaderboard {
+	interval := jobsConf.GetLeaderboardInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultLeaderboardInterval
+	}
+	j := &Leaderboard{
+		repo:   repo,
+		logger: logger,
+	}
+	j.periodic = newPeriodic(interval, j.run)
+	return j
+}
+
+func (j *Leaderboard) run(ctx context.Context) {
+	// Accounts waiting to be purged no longer show up on leaderboards
+	deleted, err := j.repo.ListUsers(ctx, bson.M{"deletion": bson.M{"$exists": true}}, 0)
+	if err != nil {
+		j.logger.Log(log.LevelError, "msg", "failed to list deleted users", "error", err)
+		return
+	}
+	excluded := map[string]bool{model.DeletedUserID: true}
+	for _, user := range deleted {
+		excluded[user.ID.Hex()] = true
+	}
+
+	now := time.Now().UTC()
+	for _, window := range leaderboardWindows {
+		snapshot := &model.LeaderboardSnapshot{
+			ID:         primitive.NewObjectID(),
+			Window:     window.name,
+			ComputedAt: now,
+		}
+		if window.length > 0 {
+			from := now.Add(-window.length)
+			snapshot.From = &from
+		}
+
+		entries, err := j.compute(ctx, snapshot, excluded)
+		if err == nil {
+			err = j.repo.PublishLeaderboardSnapshot(ctx, snapshot)
+		}
+		if err != nil {
+			j.logger.Log(log.LevelError, "msg", "failed to compute leaderboard", "window", window.name, "error", err)
+			if err := j.repo.DeleteLeaderboardEntries(ctx, snapshot.ID); err != nil {
+				j.logger.Log(log.LevelError, "msg", "failed to remove unpublished leaderboard", "window", window.name, "error", err)
+			}
+			continue
+		}
+		j.logger.Log(log.LevelInfo, "msg", "leaderboard computed", "window", window.name, "entries", entries)
+	}
+}
+
+// compute stores the entries of the snapshot and returns how many there
+// are. Tips come sorted by tipster, so only one tipster's records are held
+// at a time.
+func (j *Leaderboard) compute(ctx context.Context, snapshot *model.LeaderboardSnapshot, excluded map[string]bool) (int, error) {
+	filter := bson.M{}
+	if snapshot.From != nil {
+		filter["selection.eventStart"] = bson.M{"$gte": *snapshot.From}
+	}
+
+	var batch []*model.LeaderboardEntry
+	count := 0
+	flush := func() error {
+		count += len(batch)
+		err := j.repo.InsertLeaderboardEntries(ctx, batch)
+		batch = batch[:0]
+		return err
+	}
+
+	var records *tipsterRecords
+	finish := func() error {
+		if records == nil {
+			return nil
+		}
+		batch = append(batch, records.entries(snapshot.ID)...)
+		records = nil
+		if len(batch) < leaderboardBatchSize {
+			return nil
+		}
+		return flush()
+	}
+
+	err := j.repo.ForEachSettledTip(ctx, filter, func(tip *model.Tip) error {
+		if excluded[tip.TipsterID] {
+			return nil
+		}
+		if records != nil && records.tipsterID != tip.TipsterID {
+			if err := finish(); err != nil {
+				return err
+			}
+		}
+		if records == nil {
+			records = newTipsterRecords(tip.TipsterID)
+		}
+		records.add(tip)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := finish(); err != nil {
+		return 0, err
+	}
+	if err := flush(); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// tipsterRecords is a tipster's record overall, per sport and per tag.
+type tipsterRecords struct {
+	tipsterID string
+	overall   *stats.Accumulator
+	sports    map[string]*stats.Accumulator
+	tags      map[string]*stats.Accumulator
+}
+
+func newTipsterRecords(tipsterID string) *tipsterRecords {
+	return &tipsterRecords{
+		tipsterID: tipsterID,
+		overall:   stats.NewAccumulator(tipsterID),
+		sports:    map[string]*stats.Accumulator{},
+		tags:      map[string]*stats.Accumulator{},
+	}
+}
+
+func (t *tipsterRecords) add(tip *model.Tip) {
+	t.overall.Add(tip)
+	// Accumulators across sports only count overall and per tag
+	if tip.Selection != nil && tip.Selection.Sport != model.SportMultiple {
+		t.accumulator(t.sports, tip.Selection.Sport).Add(tip)
+	}
+	seen := map[string]bool{}
+	for _, tag := range tip.Tags {
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		t.accumulator(t.tags, tag).Add(tip)
+	}
+}
+
+func (t *tipsterRecords) accumulator(scopes map[string]*stats.Accumulator, key string) *stats.Accumulator {
+	record, ok := scopes[key]
+	if !ok {
+		record = stats.NewAccumulator(t.tipsterID)
+		scopes[key] = record
+	}
+	return record
+}
+
+func (t *tipsterRecords) entries(snapshotID primitive.ObjectID) []*model.LeaderboardEntry {
+	entries := []*model.LeaderboardEntry{
+		{ID: primitive.NewObjectID(), SnapshotID: snapshotID, Stats: *t.overall.Result()},
+	}
+	for sport, record := range t.sports {
+		entries = append(entries, &model.LeaderboardEntry{ID: primitive.NewObjectID(), SnapshotID: snapshotID, Sport: sport, Stats: *record.Result()})
+	}
+	for tag, record := range t.tags {
+		entries = append(entries, &model.LeaderboardEntry{ID: primitive.NewObjectID(), SnapshotID: snapshotID, Tag: tag, Stats: *record.Result()})
+	}
+	return entries
+}
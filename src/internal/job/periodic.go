@@ -0,0 +1,44 @@
+package job
+
+import (
+	"context"
+	"time"
+)
+
+// periodic runs a task right away and then at a fixed interval until it is
+// stopped. It implements the kratos transport.Server interface so jobs are
+// started and stopped together with the servers.
+type periodic struct {
+	interval time.Duration
+	task     func(ctx context.Context)
+	stop     chan struct{}
+}
+
+func newPeriodic(interval time.Duration, task func(ctx context.Context)) *periodic {
+	return &periodic{
+		interval: interval,
+		task:     task,
+		stop:     make(chan struct{}),
+	}
+}
+
+func (p *periodic) Start(ctx context.Context) error {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		p.task(ctx)
+		select {
+		case <-ticker.C:
+		case <-p.stop:
+			return nil
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (p *periodic) Stop(ctx context.Context) error {
+	close(p.stop)
+	return nil
+}
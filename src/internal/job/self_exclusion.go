@@ -0,0 +1,46 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"src/internal/conf"
+	"src/internal/repository"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const defaultSelfExclusionExpiryInterval = 5 * time.Minute
+
+// SelfExclusionExpiry periodically releases accounts whose self-exclusion
+// has run out and records the expiry, so the compliance history is complete
+// even for users who never come back.
+type SelfExclusionExpiry struct {
+	*periodic
+	repo   repository.SocialRepository
+	logger log.Logger
+}
+
+func NewSelfExclusionExpiry(repo repository.SocialRepository, jobsConf *conf.Jobs, logger log.Logger) *SelfExclusionExpiry {
+	interval := jobsConf.GetSelfExclusionExpiryInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultSelfExclusionExpiryInterval
+	}
+	j := &SelfExclusionExpiry{
+		repo:   repo,
+		logger: logger,
+	}
+	j.periodic = newPeriodic(interval, j.run)
+	return j
+}
+
+func (j *SelfExclusionExpiry) run(ctx context.Context) {
+	released, err := j.repo.ExpireSelfExclusions(ctx, time.Now().UTC())
+	if err != nil {
+		j.logger.Log(log.LevelError, "msg", "failed to expire self-exclusions", "error", err)
+		return
+	}
+	if released > 0 {
+		j.logger.Log(log.LevelInfo, "msg", "self-exclusions expired", "count", released)
+	}
+}
@@ -0,0 +1,57 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"src/internal/conf"
+	"src/internal/repository"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+const (
+	defaultUserPurgeInterval = time.Hour
+	userPurgeBatchSize       = 100
+)
+
+// UserPurge periodically purges deleted users whose grace period is over,
+// applying the configured content policy.
+type UserPurge struct {
+	*periodic
+	repo          repository.SocialRepository
+	removeContent bool
+	logger        log.Logger
+}
+
+func NewUserPurge(repo repository.SocialRepository, jobsConf *conf.Jobs, deletionConf *conf.Deletion, logger log.Logger) *UserPurge {
+	interval := jobsConf.GetUserPurgeInterval().AsDuration()
+	if interval <= 0 {
+		interval = defaultUserPurgeInterval
+	}
+	j := &UserPurge{
+		repo:          repo,
+		removeContent: deletionConf.GetContentPolicy() == conf.Deletion_REMOVE,
+		logger:        logger,
+	}
+	j.periodic = newPeriodic(interval, j.run)
+	return j
+}
+
+func (j *UserPurge) run(ctx context.Context) {
+	now := time.Now().UTC()
+	userIDs, err := j.repo.ListUsersDueForPurge(ctx, now, userPurgeBatchSize)
+	if err != nil {
+		j.logger.Log(log.LevelError, "msg", "failed to list users due for purge", "error", err)
+		return
+	}
+
+	// A failed purge is retried on the next run
+	for _, userID := range userIDs {
+		if err := j.repo.PurgeUser(ctx, userID, j.removeContent, now); err != nil {
+			j.logger.Log(log.LevelError, "msg", "failed to purge user", "user_id", userID.Hex(), "error", err)
+			continue
+		}
+		j.logger.Log(log.LevelInfo, "msg", "user purged", "user_id", userID.Hex(), "remove_content", j.removeContent)
+	}
+}
@@ -0,0 +1,222 @@
+// Package ledger chains the tips of each tipster into a tamper-evident
+// record. Every entry commits to the hash of the entry before it, the tip as
+// it was when the entry was made and the server time, so changing, removing
+// or reordering an entry breaks the chain from that entry on.
+package ledger
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// IssueKind names what is wrong with a ledger, like the LEDGER_ISSUE_KIND_
+// values of the API without the prefix.
+type IssueKind string
+
+const (
+	// Gap means entries are missing before the entry
+	Gap IssueKind = "GAP"
+	// BrokenLink means the entry does not commit to the entry before it
+	BrokenLink IssueKind = "BROKEN_LINK"
+	// EntryMutated means the entry was changed after it was appended
+	EntryMutated IssueKind = "ENTRY_MUTATED"
+	// TipDeleted means the tip of the entry was deleted without a deleted
+	// entry recording it
+	TipDeleted IssueKind = "TIP_DELETED"
+	// TipMutated means the tip no longer matches what the entry recorded
+	TipMutated IssueKind = "TIP_MUTATED"
+)
+
+// Issue is a problem found in a ledger, at the entry with Sequence.
+type Issue struct {
+	Kind     IssueKind
+	Sequence int64
+	TipID    primitive.ObjectID
+	Detail   string
+}
+
+// Created returns the entry recording that the tip was posted.
+func Created(tip *model.Tip, now time.Time) *model.LedgerEntry {
+	return newEntry(tip, model.LedgerTipCreated, CreatedPayload(tip), now)
+}
+
+// Settled returns the entry recording the tip's settlement.
+func Settled(tip *model.Tip, now time.Time) *model.LedgerEntry {
+	return newEntry(tip, model.LedgerTipSettled, SettledPayload(tip), now)
+}
+
+// Deleted returns the entry recording that the tip was deleted.
+func Deleted(tip *model.Tip, now time.Time) *model.LedgerEntry {
+	return newEntry(tip, model.LedgerTipDeleted, DeletedPayload(tip), now)
+}
+
+func newEntry(tip *model.Tip, kind string, payload model.LedgerPayload, now time.Time) *model.LedgerEntry {
+	return &model.LedgerEntry{
+		TipsterID:  tip.TipsterID,
+		Kind:       kind,
+		TipID:      tip.ID,
+		Payload:    payload,
+		RecordedAt: now,
+	}
+}
+
+// CreatedPayload is what a created entry records of the tip. Results of
+// accumulator legs are left out, they are recorded when the tip is settled.
+func CreatedPayload(tip *model.Tip) model.LedgerPayload {
+	selection := tip.Selection
+	if selection != nil && len(selection.Legs) > 0 {
+		unsettled := *selection
+		unsettled.Legs = make([]model.Leg, len(selection.Legs))
+		for i, leg := range selection.Legs {
+			leg.Result = ""
+			unsettled.Legs[i] = leg
+		}
+		selection = &unsettled
+	}
+	return model.LedgerPayload{
+		Title:     tip.Title,
+		Content:   tip.Content,
+		Tags:      tip.Tags,
+		ShareType: tip.ShareType,
+		Selection: selection,
+		CreatedAt: tip.CreatedAt,
+	}
+}
+
+// SettledPayload is what a settled entry records of the tip.
+func SettledPayload(tip *model.Tip) model.LedgerPayload {
+	return model.LedgerPayload{
+		Selection:  tip.Selection,
+		Settlement: tip.Settlement,
+		CreatedAt:  tip.CreatedAt,
+	}
+}
+
+// DeletedPayload is what a deleted entry records of the tip, so the ledger
+// shows whether it was deleted after it was settled.
+func DeletedPayload(tip *model.Tip) model.LedgerPayload {
+	return model.LedgerPayload{
+		Settlement: tip.Settlement,
+		CreatedAt:  tip.CreatedAt,
+	}
+}
+
+// SamePayload reports whether two payloads are the same once stored.
+func SamePayload(a, b model.LedgerPayload) bool {
+	aDoc, aErr := bson.Marshal(a)
+	bDoc, bErr := bson.Marshal(b)
+	return aErr == nil && bErr == nil && bytes.Equal(aDoc, bDoc)
+}
+
+// Seal links the entry to prev, the last entry of the tipster's ledger or
+// nil for the first one, and computes its hash.
+func Seal(entry, prev *model.LedgerEntry) error {
+	entry.Sequence = 1
+	entry.PrevHash = ""
+	if prev != nil {
+		entry.Sequence = prev.Sequence + 1
+		entry.PrevHash = prev.Hash
+	}
+	hash, err := Hash(entry)
+	if err != nil {
+		return err
+	}
+	entry.Hash = hash
+	return nil
+}
+
+// Hash returns the hex SHA-256 of the entry as stored, without its ID and
+// hash. Times are hashed at the millisecond precision they are stored with.
+func Hash(entry *model.LedgerEntry) (string, error) {
+	doc, err := bson.Marshal(bson.D{
+		{Key: "tipsterId", Value: entry.TipsterID},
+		{Key: "sequence", Value: entry.Sequence},
+		{Key: "kind", Value: entry.Kind},
+		{Key: "tipId", Value: entry.TipID},
+		{Key: "payload", Value: entry.Payload},
+		{Key: "prevHash", Value: entry.PrevHash},
+		{Key: "recordedAt", Value: entry.RecordedAt},
+	})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(doc)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// Verifier recomputes a tipster's chain, fed the entries in sequence order.
+type Verifier struct {
+	prev    *model.LedgerEntry
+	entries int64
+	issues  []Issue
+}
+
+// Add checks the entry against the one before it.
+func (v *Verifier) Add(entry *model.LedgerEntry) {
+	v.entries++
+	expected := int64(1)
+	if v.prev != nil {
+		expected = v.prev.Sequence + 1
+	}
+	if entry.Sequence != expected {
+		v.report(Gap, entry, fmt.Sprintf("Expected entry %d, found entry %d", expected, entry.Sequence))
+	}
+
+	prevHash := ""
+	if v.prev != nil {
+		prevHash = v.prev.Hash
+	}
+	if entry.PrevHash != prevHash {
+		v.report(BrokenLink, entry, "Entry does not commit to the entry before it")
+	}
+	if hash, err := Hash(entry); err != nil || hash != entry.Hash {
+		v.report(EntryMutated, entry, "Entry does not match its hash")
+	}
+	v.prev = entry
+}
+
+// Report records an issue found outside the chain, such as a tip that no
+// longer matches its entry.
+func (v *Verifier) Report(kind IssueKind, entry *model.LedgerEntry, detail string) {
+	v.report(kind, entry, detail)
+}
+
+func (v *Verifier) report(kind IssueKind, entry *model.LedgerEntry, detail string) {
+	v.issues = append(v.issues, Issue{
+		Kind:     kind,
+		Sequence: entry.Sequence,
+		TipID:    entry.TipID,
+		Detail:   detail,
+	})
+}
+
+// Entries returns how many entries were checked.
+func (v *Verifier) Entries() int64 {
+	return v.entries
+}
+
+// Head returns the hash of the last entry, which commits to the whole
+// chain, or "" for an empty ledger.
+func (v *Verifier) Head() string {
+	if v.prev == nil {
+		return ""
+	}
+	return v.prev.Hash
+}
+
+// Issues returns the issues found so far, in ledger order.
+func (v *Verifier) Issues() []Issue {
+	sort.SliceStable(v.issues, func(i, j int) bool {
+		return v.issues[i].Sequence < v.issues[j].Sequence
+	})
+	return v.issues
+}
@@ -0,0 +1,213 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+var recordedAt = time.Date(2026, 3, 14, 15, 0, 0, 0, time.UTC)
+
+func newTip(title string) *model.Tip {
+	return &model.Tip{
+		ID:        primitive.NewObjectID(),
+		TipsterID: "tipster",
+		Title:     title,
+		Content:   "Home side to win",
+		CreatedAt: recordedAt,
+		Selection: &model.Selection{
+			Sport:      "Football",
+			EventName:  "Home v Away",
+			EventStart: recordedAt.Add(time.Hour),
+			Market:     "Match Result",
+			Pick:       "Home",
+			Odds:       model.Odds{Num: 5, Den: 2},
+			Stake:      1,
+		},
+	}
+}
+
+// chain seals a created and a settled entry for each tip, as stored and
+// read back.
+func chain(t *testing.T, tips ...*model.Tip) []*model.LedgerEntry {
+	t.Helper()
+	var entries []*model.LedgerEntry
+	var prev *model.LedgerEntry
+	for _, tip := range tips {
+		created := Created(tip, recordedAt)
+		settledTip := *tip
+		settledTip.Settlement = &model.Settlement{Result: model.ResultLost, Profit: -1, SettledAt: recordedAt.Add(2 * time.Hour)}
+		settled := Settled(&settledTip, recordedAt.Add(2*time.Hour))
+		for _, entry := range []*model.LedgerEntry{created, settled} {
+			if err := Seal(entry, prev); err != nil {
+				t.Fatal(err)
+			}
+			prev = entry
+			entries = append(entries, roundTrip(t, entry))
+		}
+	}
+	return entries
+}
+
+func roundTrip(t *testing.T, entry *model.LedgerEntry) *model.LedgerEntry {
+	t.Helper()
+	doc, err := bson.Marshal(entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var stored model.LedgerEntry
+	if err := bson.Unmarshal(doc, &stored); err != nil {
+		t.Fatal(err)
+	}
+	return &stored
+}
+
+func verify(entries []*model.LedgerEntry) *Verifier {
+	v := &Verifier{}
+	for _, entry := range entries {
+		v.Add(entry)
+	}
+	return v
+}
+
+func kinds(issues []Issue) []IssueKind {
+	kinds := make([]IssueKind, 0, len(issues))
+	for _, issue := range issues {
+		kinds = append(kinds, issue.Kind)
+	}
+	return kinds
+}
+
+func TestVerifierAcceptsIntactChain(t *testing.T) {
+	entries := chain(t, newTip("First"), newTip("Second"))
+	v := verify(entries)
+	if issues := v.Issues(); len(issues) != 0 {
+		t.Fatalf("issues = %+v, want none", issues)
+	}
+	if v.Entries() != 4 {
+		t.Errorf("entries = %d, want 4", v.Entries())
+	}
+	if v.Head() != entries[3].Hash {
+		t.Errorf("head = %s, want the hash of the last entry", v.Head())
+	}
+	for i, entry := range entries {
+		if entry.Sequence != int64(i+1) {
+			t.Errorf("entry %d has sequence %d", i, entry.Sequence)
+		}
+	}
+}
+
+func TestVerifierReportsMutatedPayload(t *testing.T) {
+	entries := chain(t, newTip("First"), newTip("Second"))
+	entries[0].Payload.Title = "Rewritten"
+
+	issues := verify(entries).Issues()
+	if len(issues) != 1 || issues[0].Kind != EntryMutated || issues[0].Sequence != 1 {
+		t.Fatalf("issues = %+v, want ENTRY_MUTATED at 1", issues)
+	}
+}
+
+func TestVerifierReportsMutatedSettlement(t *testing.T) {
+	entries := chain(t, newTip("First"))
+	entries[1].Payload.Settlement.Result = model.ResultWon
+
+	issues := verify(entries).Issues()
+	if len(issues) != 1 || issues[0].Kind != EntryMutated || issues[0].Sequence != 2 {
+		t.Fatalf("issues = %+v, want ENTRY_MUTATED at 2", issues)
+	}
+}
+
+func TestVerifierReportsGap(t *testing.T) {
+	entries := chain(t, newTip("First"), newTip("Second"))
+	// Entry 2 was deleted, so entry 3 no longer follows entry 1
+	entries = append(entries[:1], entries[2:]...)
+
+	issues := verify(entries).Issues()
+	got := kinds(issues)
+	if len(got) != 2 || got[0] != Gap || got[1] != BrokenLink || issues[0].Sequence != 3 {
+		t.Fatalf("issues = %+v, want GAP and BROKEN_LINK at 3", issues)
+	}
+}
+
+func TestVerifierReportsSkippedSequence(t *testing.T) {
+	entries := chain(t, newTip("First"))
+	// A rewritten entry that skips a number and is rehashed to match
+	entries[1].Sequence = 3
+	hash, err := Hash(entries[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries[1].Hash = hash
+
+	issues := verify(entries).Issues()
+	if len(issues) != 1 || issues[0].Kind != Gap || issues[0].Sequence != 3 {
+		t.Fatalf("issues = %+v, want GAP at 3", issues)
+	}
+}
+
+func TestVerifierReportsBrokenLink(t *testing.T) {
+	entries := chain(t, newTip("First"), newTip("Second"))
+	// An entry relinked and rehashed to match itself no longer follows the
+	// entry before it, and the entry after it commits to the old hash
+	entries[2].PrevHash = "0000"
+	hash, err := Hash(entries[2])
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries[2].Hash = hash
+
+	issues := verify(entries).Issues()
+	if len(issues) != 2 || issues[0].Kind != BrokenLink || issues[0].Sequence != 3 ||
+		issues[1].Kind != BrokenLink || issues[1].Sequence != 4 {
+		t.Fatalf("issues = %+v, want BROKEN_LINK at 3 and 4", issues)
+	}
+}
+
+func TestVerifierReportsRehashedEntryThroughNextLink(t *testing.T) {
+	entries := chain(t, newTip("First"), newTip("Second"))
+	// Rehashing a rewritten entry hides it from its own check, but the next
+	// entry still commits to the original hash
+	entries[1].Payload.Settlement.Result = model.ResultWon
+	hash, err := Hash(entries[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	entries[1].Hash = hash
+
+	issues := verify(entries).Issues()
+	if len(issues) != 1 || issues[0].Kind != BrokenLink || issues[0].Sequence != 3 {
+		t.Fatalf("issues = %+v, want BROKEN_LINK at 3", issues)
+	}
+}
+
+func TestHashIgnoresSubMillisecondTimes(t *testing.T) {
+	tip := newTip("First")
+	entry := Created(tip, recordedAt.Add(123456*time.Nanosecond))
+	if err := Seal(entry, nil); err != nil {
+		t.Fatal(err)
+	}
+	if issues := verify([]*model.LedgerEntry{roundTrip(t, entry)}).Issues(); len(issues) != 0 {
+		t.Fatalf("issues = %+v, want none once stored", issues)
+	}
+}
+
+func TestCreatedPayloadLeavesOutLegResults(t *testing.T) {
+	tip := newTip("Double")
+	tip.Selection.Legs = []model.Leg{
+		{EventName: "A v B", Odds: model.Odds{Num: 2, Den: 1}},
+		{EventName: "C v D", Odds: model.Odds{Num: 3, Den: 2}},
+	}
+	posted := CreatedPayload(tip)
+
+	tip.Selection.Legs[0].Result = model.ResultWon
+	if !SamePayload(posted, CreatedPayload(tip)) {
+		t.Error("settling a leg changed the created payload")
+	}
+	if tip.Selection.Legs[0].Result != model.ResultWon {
+		t.Error("CreatedPayload changed the tip")
+	}
+}
@@ -3,22 +3,102 @@ package model
 import (
 	"time"
 
+	commonpb "src/protos/YM.Common"
+
 	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
-// User model
+// User model. A user with the SelfExclude status and no SelfExcludedUntil
+// is excluded permanently. Private users approve their followers through
+// follow requests.
 type User struct {
-	ID        primitive.ObjectID   `bson:"_id,omitempty"`
-	Username  string               `bson:"username"`
-	Password  string               `bson:"password"`
-	Email     string               `bson:"email"`
-	Tags      []string             `bson:"tags"`
-	Following []primitive.ObjectID `bson:"following"`
-	Followers []primitive.ObjectID `bson:"followers"`
-	CreatedAt time.Time            `bson:"createdAt"`
-	UpdatedAt time.Time            `bson:"updatedAt"`
+	ID                primitive.ObjectID     `bson:"_id,omitempty"`
+	Username          string                 `bson:"username"`
+	Password          string                 `bson:"password"`
+	Email             string                 `bson:"email"`
+	Tags              []string               `bson:"tags"`
+	FollowersCount    int64                  `bson:"followersCount"`
+	FollowingCount    int64                  `bson:"followingCount"`
+	Private           bool                   `bson:"private,omitempty"`
+	Status            commonpb.AccountStatus `bson:"status"`
+	StatusReason      string                 `bson:"statusReason,omitempty"`
+	StatusChangedAt   time.Time              `bson:"statusChangedAt,omitempty"`
+	SelfExcludedUntil *time.Time             `bson:"selfExcludedUntil,omitempty"`
+	Identities        []Identity             `bson:"identities,omitempty"`
+	Deletion          *UserDeletion          `bson:"deletion,omitempty"`
+	Role              string                 `bson:"role"`
+	CreatedAt         time.Time              `bson:"createdAt"`
+	UpdatedAt         time.Time              `bson:"updatedAt"`
+}
+
+// User roles recorded on User.Role. Users stored before roles existed have
+// no role and are treated as punters.
+const (
+	RolePunter    = "PUNTER"
+	RoleTipster   = "TIPSTER"
+	RoleModerator = "MODERATOR"
+	RoleAdmin     = "ADMIN"
+)
+
+// Follow is an edge of the follow graph. The follower and followee counts
+// on User are kept in step with these edges.
+type Follow struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	FollowerID primitive.ObjectID `bson:"followerId"`
+	FolloweeID primitive.ObjectID `bson:"followeeId"`
+	CreatedAt  time.Time          `bson:"createdAt"`
+}
+
+// FollowRequest is a pending follow of a private user. Approving it turns
+// it into a Follow, rejecting it deletes it.
+type FollowRequest struct {
+	ID          primitive.ObjectID `bson:"_id,omitempty"`
+	RequesterID primitive.ObjectID `bson:"requesterId"`
+	TargetID    primitive.ObjectID `bson:"targetId"`
+	CreatedAt   time.Time          `bson:"createdAt"`
 }
 
+// Restriction is a block or mute one user placed on another. Both hide the
+// target's content from the owner; a block also removes the follow edges
+// between them and keeps the target from following or interacting with the
+// owner's content.
+type Restriction struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OwnerID   primitive.ObjectID `bson:"ownerId"`
+	TargetID  primitive.ObjectID `bson:"targetId"`
+	Kind      string             `bson:"kind"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+// Restriction kinds
+const (
+	RestrictionBlock = "BLOCK"
+	RestrictionMute  = "MUTE"
+)
+
+// Identity links a user to an account at an external identity provider.
+// Key combines provider and subject so a single unique index covers both.
+type Identity struct {
+	Key      string                    `bson:"key"`
+	Provider commonpb.IdendityProvider `bson:"provider"`
+	Subject  string                    `bson:"subject"`
+	Email    string                    `bson:"email,omitempty"`
+	LinkedAt time.Time                 `bson:"linkedAt"`
+}
+
+// UserDeletion is set on a deleted user until the purge removes the
+// account. Until then the deletion can be undone.
+type UserDeletion struct {
+	RequestedAt    time.Time              `bson:"requestedAt"`
+	RequestedBy    primitive.ObjectID     `bson:"requestedBy"`
+	PurgeAfter     time.Time              `bson:"purgeAfter"`
+	PreviousStatus commonpb.AccountStatus `bson:"previousStatus"`
+}
+
+// DeletedUserID replaces the author of tips and comments that are kept
+// anonymized after their author was purged.
+const DeletedUserID = "000000000000000000000000"
+
 type UserDetail struct {
 	ID       primitive.ObjectID `bson:"_id"`
 	Username string             `bson:"username"`
@@ -28,21 +108,233 @@ type UserUpdates struct {
 	Username  string    `bson:"username,omitempty"`
 	Email     string    `bson:"email,omitempty"`
 	Tags      []string  `bson:"tags,omitempty"`
+	Private   *bool     `bson:"private,omitempty"`
 	UpdatedAt time.Time `bson:"updatedAt"`
 }
 
-// Tip model
+// Tip share types. See the visibility policy in biz for who sees what.
+const (
+	ShareTypePublic      = "PUBLIC"
+	ShareTypeFriendsOnly = "FRIENDS_ONLY"
+	ShareTypeStory       = "STORY"
+)
+
+// Odds are decimal odds stored exactly as a reduced fraction, see the odds
+// package.
+type Odds struct {
+	Num int64 `bson:"num"`
+	Den int64 `bson:"den"`
+}
+
+// Selection is the bet a tip recommends. The stake is in units.
+//
+// Accumulators have two or more Legs. Their odds are the product of the
+// legs' odds, they start when the first leg starts, and the other fields
+// summarise the legs: Sport is the legs' sport or SportMultiple and Market
+// is MarketAccumulator.
+type Selection struct {
+	Sport       string    `bson:"sport"`
+	Competition string    `bson:"competition,omitempty"`
+	EventName   string    `bson:"eventName"`
+	EventStart  time.Time `bson:"eventStart"`
+	Market      string    `bson:"market"`
+	Pick        string    `bson:"pick"`
+	Odds        Odds      `bson:"odds"`
+	Stake       float64   `bson:"stake"`
+	Legs        []Leg     `bson:"legs,omitempty"`
+}
+
+// Summary values of accumulator selections
+const (
+	SportMultiple     = "Multiple"
+	MarketAccumulator = "Accumulator"
+)
+
+// Leg is one selection of an accumulator. Result is set once the leg is
+// settled, to one of the tip results.
+type Leg struct {
+	Sport       string    `bson:"sport"`
+	Competition string    `bson:"competition,omitempty"`
+	EventName   string    `bson:"eventName"`
+	EventStart  time.Time `bson:"eventStart"`
+	Market      string    `bson:"market"`
+	Pick        string    `bson:"pick"`
+	Odds        Odds      `bson:"odds"`
+	Result      string    `bson:"result,omitempty"`
+}
+
+// Tip results recorded on Settlement.Result
+const (
+	ResultWon      = "WON"
+	ResultLost     = "LOST"
+	ResultVoid     = "VOID"
+	ResultPush     = "PUSH"
+	ResultHalfWon  = "HALF_WON"
+	ResultHalfLost = "HALF_LOST"
+)
+
+// Settlement is the outcome of a tip's selection. Profit is in units and
+// negative for a loss.
+type Settlement struct {
+	Result    string             `bson:"result"`
+	Profit    float64            `bson:"profit"`
+	SettledAt time.Time          `bson:"settledAt"`
+	SettledBy primitive.ObjectID `bson:"settledBy"`
+}
+
+// Tip model. ExpiresAt is set on stories only, Selection on tips with a
+// structured bet and Settlement once that bet is settled. Revisions counts
+// the edits recorded as TipRevisions. Deleted tips are kept with DeletedAt
+// set, so their revisions can still be audited.
 type Tip struct {
-	ID        primitive.ObjectID   `bson:"_id,omitempty"`
-	TipsterID string               `bson:"tipsterId"`
-	Title     string               `bson:"title"`
-	Content   string               `bson:"content"`
-	Tags      []string             `bson:"tags"`
-	ShareType string               `bson:"shareType"`
-	Likes     []primitive.ObjectID `bson:"likes"`
-	Unlikes   []primitive.ObjectID `bson:"unlikes"`
-	CreatedAt time.Time            `bson:"createdAt"`
-	UpdatedAt time.Time            `bson:"updatedAt"`
+	ID         primitive.ObjectID   `bson:"_id,omitempty"`
+	TipsterID  string               `bson:"tipsterId"`
+	Title      string               `bson:"title"`
+	Content    string               `bson:"content"`
+	Tags       []string             `bson:"tags"`
+	ShareType  string               `bson:"shareType"`
+	ExpiresAt  *time.Time           `bson:"expiresAt,omitempty"`
+	Likes      []primitive.ObjectID `bson:"likes"`
+	Unlikes    []primitive.ObjectID `bson:"unlikes"`
+	ShareCount int64                `bson:"shareCount"`
+	Selection  *Selection           `bson:"selection,omitempty"`
+	Settlement *Settlement          `bson:"settlement,omitempty"`
+	Revisions  int64                `bson:"revisions"`
+	CreatedAt  time.Time            `bson:"createdAt"`
+	UpdatedAt  time.Time            `bson:"updatedAt"`
+	DeletedAt  *time.Time           `bson:"deletedAt,omitempty"`
+}
+
+// Editable tip fields named in TipRevision.Changes
+const (
+	TipFieldTitle     = "title"
+	TipFieldContent   = "content"
+	TipFieldTags      = "tags"
+	TipFieldShareType = "shareType"
+	TipFieldSelection = "selection"
+)
+
+// TipVersion is the editable content of a tip as of one revision.
+type TipVersion struct {
+	Title     string     `bson:"title"`
+	Content   string     `bson:"content"`
+	Tags      []string   `bson:"tags"`
+	ShareType string     `bson:"shareType"`
+	Selection *Selection `bson:"selection,omitempty"`
+}
+
+// TipRevision is one edit of a tip: the fields it changed with the tip
+// before and after it. Revisions are numbered from 1 and never changed, so
+// followers can see how a tip read when they acted on it.
+type TipRevision struct {
+	ID       primitive.ObjectID `bson:"_id,omitempty"`
+	TipID    primitive.ObjectID `bson:"tipId"`
+	Number   int64              `bson:"number"`
+	EditorID string             `bson:"editorId"`
+	Changes  []string           `bson:"changes"`
+	Before   TipVersion         `bson:"before"`
+	After    TipVersion         `bson:"after"`
+	EditedAt time.Time          `bson:"editedAt"`
+}
+
+// Ledger entry kinds recorded on LedgerEntry.Kind
+const (
+	LedgerTipCreated = "CREATED"
+	LedgerTipSettled = "SETTLED"
+	LedgerTipDeleted = "DELETED"
+)
+
+// LedgerPayload is the part of a tip a ledger entry commits to: its content
+// and selection when it was created, its selection and settlement when it
+// was settled, or its settlement, if any, when it was deleted.
+type LedgerPayload struct {
+	Title      string      `bson:"title,omitempty"`
+	Content    string      `bson:"content,omitempty"`
+	Tags       []string    `bson:"tags,omitempty"`
+	ShareType  string      `bson:"shareType,omitempty"`
+	Selection  *Selection  `bson:"selection,omitempty"`
+	Settlement *Settlement `bson:"settlement,omitempty"`
+	CreatedAt  time.Time   `bson:"createdAt"`
+}
+
+// LedgerEntry is one link of a tipster's hash chain, see the ledger
+// package. Sequence counts from 1 per tipster and PrevHash is empty for the
+// first entry. Entries are only ever appended.
+type LedgerEntry struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	TipsterID  string             `bson:"tipsterId"`
+	Sequence   int64              `bson:"sequence"`
+	Kind       string             `bson:"kind"`
+	TipID      primitive.ObjectID `bson:"tipId"`
+	Payload    LedgerPayload      `bson:"payload"`
+	PrevHash   string             `bson:"prevHash"`
+	Hash       string             `bson:"hash"`
+	RecordedAt time.Time          `bson:"recordedAt"`
+}
+
+// Share is a reshare of a tip by another user to their own followers.
+// ShareType is the audience of the share; the tip's own audience still
+// applies to everyone reading it. ExpiresAt is set on stories only.
+type Share struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	TipID     primitive.ObjectID `bson:"tipId"`
+	SharerID  primitive.ObjectID `bson:"sharerId"`
+	Comment   string             `bson:"comment,omitempty"`
+	ShareType string             `bson:"shareType"`
+	ExpiresAt *time.Time         `bson:"expiresAt,omitempty"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+// TipsterStats is the record of a tipster's settled tips. DecidedTips
+// leaves out void and pushed tips, which return the stake. StrikeRate and
+// ROI are percentages.
+type TipsterStats struct {
+	TipsterID            string  `bson:"tipsterId"`
+	Tips                 int64   `bson:"tips"`
+	DecidedTips          int64   `bson:"decidedTips"`
+	Won                  int64   `bson:"won"`
+	Lost                 int64   `bson:"lost"`
+	Void                 int64   `bson:"void"`
+	Push                 int64   `bson:"push"`
+	HalfWon              int64   `bson:"halfWon"`
+	HalfLost             int64   `bson:"halfLost"`
+	StrikeRate           float64 `bson:"strikeRate"`
+	UnitsStaked          float64 `bson:"unitsStaked"`
+	Profit               float64 `bson:"profit"`
+	ROI                  float64 `bson:"roi"`
+	AverageOdds          float64 `bson:"averageOdds"`
+	LongestWinningStreak int64   `bson:"longestWinningStreak"`
+	LongestLosingStreak  int64   `bson:"longestLosingStreak"`
+}
+
+// Leaderboard windows recorded on LeaderboardSnapshot.Window
+const (
+	WindowLast7Days  = "LAST_7_DAYS"
+	WindowLast30Days = "LAST_30_DAYS"
+	WindowLast90Days = "LAST_90_DAYS"
+	WindowAllTime    = "ALL_TIME"
+)
+
+// LeaderboardSnapshot is one run of the leaderboard job for a window. Its
+// entries cover tips whose event started at or after From; From is nil for
+// all time. Snapshots are never changed, so pages of one stay consistent.
+type LeaderboardSnapshot struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	Window     string             `bson:"window"`
+	From       *time.Time         `bson:"from"`
+	ComputedAt time.Time          `bson:"computedAt"`
+}
+
+// LeaderboardEntry is a tipster's record in a snapshot, over all their tips
+// or only those on Sport or with Tag. At most one of the two is set. Rank
+// depends on the metric listed by, so it is not stored.
+type LeaderboardEntry struct {
+	ID         primitive.ObjectID `bson:"_id,omitempty"`
+	SnapshotID primitive.ObjectID `bson:"snapshotId"`
+	Sport      string             `bson:"sport"`
+	Tag        string             `bson:"tag"`
+	Stats      TipsterStats       `bson:"stats"`
+	Rank       int64              `bson:"-"`
 }
 
 // Comment model
@@ -57,3 +349,53 @@ type Comment struct {
 	CreatedAt time.Time            `bson:"createdAt"`
 	UpdatedAt time.Time            `bson:"updatedAt"`
 }
+
+// Feed actions recorded on FeedEvent.Action
+const (
+	FeedActionPostTip    = "POST_TIP"
+	FeedActionLikeTip    = "LIKE_TIP"
+	FeedActionCommentTip = "COMMENT_TIP"
+	FeedActionShareTip   = "SHARE_TIP"
+)
+
+// FeedEvent model
+type FeedEvent struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	AuthorID  primitive.ObjectID `bson:"authorId"`
+	Action    string             `bson:"action"`
+	TargetID  string             `bson:"targetId"`
+	ExtraInfo string             `bson:"extraInfo"`
+	CreatedAt time.Time          `bson:"createdAt"`
+	// Pulled marks events posted while the author was above the fan-out
+	// threshold. They are never copied into timelines, so followers read
+	// them from the author's history for as long as they follow.
+	Pulled bool `bson:"pulled,omitempty"`
+}
+
+// TimelineEntry is a feed event materialized into a follower's timeline
+type TimelineEntry struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	OwnerID   primitive.ObjectID `bson:"ownerId"`
+	EventID   primitive.ObjectID `bson:"eventId"`
+	AuthorID  primitive.ObjectID `bson:"authorId"`
+	CreatedAt time.Time          `bson:"createdAt"`
+}
+
+// Self-exclusion periods recorded on SelfExclusion.Period
+const (
+	SelfExclusion24Hours   = "24_HOURS"
+	SelfExclusion7Days     = "7_DAYS"
+	SelfExclusion6Months   = "6_MONTHS"
+	SelfExclusionPermanent = "PERMANENT"
+)
+
+// SelfExclusion is the compliance record of a self-exclusion. Records are
+// never deleted; ExpiredAt is set when the account is released.
+type SelfExclusion struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	UserID    primitive.ObjectID `bson:"userId"`
+	Period    string             `bson:"period"`
+	StartedAt time.Time          `bson:"startedAt"`
+	EndsAt    *time.Time         `bson:"endsAt"`
+	ExpiredAt *time.Time         `bson:"expiredAt"`
+}
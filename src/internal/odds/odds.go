@@ -0,0 +1,230 @@
+// Package odds parses, converts and formats betting odds. Odds are held as
+// the exact decimal price, so converting between formats never loses
+// precision; only formatting rounds.
+package odds
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Format is the way odds are written, named like the ODDS_FORMAT_ values of
+// the API without the prefix.
+type Format string
+
+const (
+	// Decimal odds are the total return per unit staked, e.g. "2.50"
+	Decimal Format = "DECIMAL"
+	// Fractional odds are the profit per unit staked, e.g. "6/4" or "evens"
+	Fractional Format = "FRACTIONAL"
+	// American odds are the profit on a 100 stake when positive, or the
+	// stake needed to win 100 when negative, e.g. "+150" or "-200"
+	American Format = "AMERICAN"
+)
+
+var (
+	// ErrSyntax is returned for odds that are not written in the format
+	ErrSyntax = errors.New("odds: invalid syntax")
+	// ErrRange is returned for odds that do not pay more than the stake
+	// back, or whose exact price does not fit the stored fraction
+	ErrRange = errors.New("odds: out of range")
+)
+
+var (
+	one     = big.NewRat(1, 1)
+	hundred = big.NewRat(100, 1)
+)
+
+// Odds are decimal odds held as an exact fraction greater than 1. The zero
+// value is not valid odds.
+type Odds struct {
+	price *big.Rat
+}
+
+// Parse reads odds written in format.
+func Parse(s string, format Format) (Odds, error) {
+	s = strings.TrimSpace(s)
+	switch format {
+	case Decimal:
+		return parseDecimal(s)
+	case Fractional:
+		return parseFractional(s)
+	case American:
+		return parseAmerican(s)
+	}
+	return Odds{}, ErrSyntax
+}
+
+// FromFloat converts decimal odds given as a float. The float is read as
+// its shortest decimal representation, so 2.1 is 21/10 rather than the
+// nearest binary fraction.
+func FromFloat(price float64) (Odds, error) {
+	return parseDecimal(strconv.FormatFloat(price, 'f', -1, 64))
+}
+
+// FromFraction returns the odds whose decimal price is num/den, as stored by
+// Fraction.
+func FromFraction(num, den int64) (Odds, error) {
+	if den <= 0 {
+		return Odds{}, ErrRange
+	}
+	return newOdds(big.NewRat(num, den))
+}
+
+// Combine returns the odds of an accumulator of legs, the product of their
+// prices. It fails with ErrRange when there are no legs or the exact product
+// does not fit the stored fraction.
+func Combine(legs ...Odds) (Odds, error) {
+	if len(legs) == 0 {
+		return Odds{}, ErrRange
+	}
+	price := new(big.Rat).Set(one)
+	for _, leg := range legs {
+		if leg.IsZero() {
+			return Odds{}, ErrRange
+		}
+		price.Mul(price, leg.price)
+	}
+	return newOdds(price)
+}
+
+func newOdds(price *big.Rat) (Odds, error) {
+	if price.Cmp(one) <= 0 || !price.Num().IsInt64() || !price.Denom().IsInt64() {
+		return Odds{}, ErrRange
+	}
+	return Odds{price: price}, nil
+}
+
+func parseDecimal(s string) (Odds, error) {
+	if s == "" || strings.Trim(s, "0123456789.") != "" || strings.Count(s, ".") > 1 {
+		return Odds{}, ErrSyntax
+	}
+	price, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Odds{}, ErrSyntax
+	}
+	return newOdds(price)
+}
+
+func parseFractional(s string) (Odds, error) {
+	switch strings.ToLower(s) {
+	case "evens", "evs", "even":
+		s = "1/1"
+	}
+	numText, denText, ok := strings.Cut(s, "/")
+	if !ok {
+		return Odds{}, ErrSyntax
+	}
+	num, ok := parseCount(numText)
+	if !ok {
+		return Odds{}, ErrSyntax
+	}
+	den, ok := parseCount(denText)
+	if !ok || den.Sign() == 0 {
+		return Odds{}, ErrSyntax
+	}
+	profit := new(big.Rat).SetFrac(num, den)
+	return newOdds(profit.Add(profit, one))
+}
+
+func parseAmerican(s string) (Odds, error) {
+	negative := strings.HasPrefix(s, "-")
+	value, ok := parseCount(strings.TrimLeft(s, "+-"))
+	if !ok || len(s)-len(strings.TrimLeft(s, "+-")) > 1 {
+		return Odds{}, ErrSyntax
+	}
+	line := new(big.Rat).SetInt(value)
+	if line.Cmp(hundred) < 0 {
+		return Odds{}, ErrRange
+	}
+
+	// +150 wins 150/100 per unit, -200 wins 100/200 per unit
+	profit := new(big.Rat).Quo(line, hundred)
+	if negative {
+		profit.Inv(profit)
+	}
+	return newOdds(profit.Add(profit, one))
+}
+
+// parseCount parses a non-negative integer written with digits only.
+func parseCount(s string) (*big.Int, bool) {
+	if s == "" || strings.Trim(s, "0123456789") != "" {
+		return nil, false
+	}
+	return new(big.Int).SetString(s, 10)
+}
+
+// IsZero reports whether o is the zero value.
+func (o Odds) IsZero() bool {
+	return o.price == nil
+}
+
+// Fraction returns the decimal price as a reduced fraction, the canonical
+// form odds are stored in.
+func (o Odds) Fraction() (num, den int64) {
+	return o.price.Num().Int64(), o.price.Denom().Int64()
+}
+
+// Price returns a copy of the exact decimal price.
+func (o Odds) Price() *big.Rat {
+	return new(big.Rat).Set(o.price)
+}
+
+// Profit returns the exact profit per unit staked.
+func (o Odds) Profit() *big.Rat {
+	return new(big.Rat).Sub(o.price, one)
+}
+
+// Float64 returns the decimal price, rounded to the nearest float.
+func (o Odds) Float64() float64 {
+	price, _ := o.price.Float64()
+	return price
+}
+
+// ImpliedProbability returns the exact probability the price implies,
+// 1 / decimal odds.
+func (o Odds) ImpliedProbability() *big.Rat {
+	return new(big.Rat).Inv(o.price)
+}
+
+// Format writes the odds in format. Decimal odds are written exactly when
+// they have at most four decimals and rounded to two otherwise; American
+// odds are rounded to two decimals when they are not whole. Unknown formats
+// are written as decimal odds.
+func (o Odds) Format(format Format) string {
+	switch format {
+	case Fractional:
+		profit := o.Profit()
+		return profit.Num().String() + "/" + profit.Denom().String()
+	case American:
+		profit := o.Profit()
+		if profit.Cmp(one) >= 0 {
+			return "+" + ratText(profit.Mul(profit, hundred))
+		}
+		return "-" + ratText(profit.Quo(hundred, profit))
+	}
+	for places := 2; places <= 4; places++ {
+		if shifted := new(big.Rat).Mul(o.price, pow10(places)); shifted.IsInt() {
+			return o.price.FloatString(places)
+		}
+	}
+	return o.price.FloatString(2)
+}
+
+// String writes the odds as decimal odds.
+func (o Odds) String() string {
+	return o.Format(Decimal)
+}
+
+func ratText(r *big.Rat) string {
+	if r.IsInt() {
+		return r.Num().String()
+	}
+	return r.FloatString(2)
+}
+
+func pow10(places int) *big.Rat {
+	return new(big.Rat).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(places)), nil))
+}
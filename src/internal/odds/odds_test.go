@@ -0,0 +1,207 @@
+package odds
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+)
+
+func mustParse(t *testing.T, s string, format Format) Odds {
+	t.Helper()
+	o, err := Parse(s, format)
+	if err != nil {
+		t.Fatalf("Parse(%q, %s): %v", s, format, err)
+	}
+	return o
+}
+
+func TestParseFormatRoundTrip(t *testing.T) {
+	tests := []struct {
+		in     string
+		format Format
+		want   string
+	}{
+		{"2.50", Decimal, "2.50"},
+		{"2.5", Decimal, "2.50"},
+		{" 3 ", Decimal, "3.00"},
+		{"1.909", Decimal, "1.909"},
+		{"1.3333", Decimal, "1.3333"},
+		{"1.33333", Decimal, "1.33"},
+		{"3/2", Fractional, "3/2"},
+		{"6/4", Fractional, "3/2"},
+		{"100/30", Fractional, "10/3"},
+		{"1/3", Fractional, "1/3"},
+		{"evens", Fractional, "1/1"},
+		{"EVS", Fractional, "1/1"},
+		{"+150", American, "+150"},
+		{"150", American, "+150"},
+		{"-200", American, "-200"},
+		{"-110", American, "-110"},
+		{"+100", American, "+100"},
+		{"-100", American, "+100"},
+		{"+333", American, "+333"},
+	}
+	for _, tt := range tests {
+		if got := mustParse(t, tt.in, tt.format).Format(tt.format); got != tt.want {
+			t.Errorf("Parse(%q, %s).Format = %q, want %q", tt.in, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestConvertBetweenFormats(t *testing.T) {
+	tests := []struct {
+		in         string
+		format     Format
+		decimal    string
+		fractional string
+		american   string
+	}{
+		{"2.50", Decimal, "2.50", "3/2", "+150"},
+		{"6/4", Fractional, "2.50", "3/2", "+150"},
+		{"+150", American, "2.50", "3/2", "+150"},
+		{"1.50", Decimal, "1.50", "1/2", "-200"},
+		{"-110", American, "1.91", "10/11", "-110"},
+		{"10/11", Fractional, "1.91", "10/11", "-110"},
+		{"1/3", Fractional, "1.33", "1/3", "-300"},
+		{"1.3333", Decimal, "1.3333", "3333/10000", "-300.03"},
+		{"evens", Fractional, "2.00", "1/1", "+100"},
+		{"-100", American, "2.00", "1/1", "+100"},
+	}
+	for _, tt := range tests {
+		o := mustParse(t, tt.in, tt.format)
+		if got := o.Format(Decimal); got != tt.decimal {
+			t.Errorf("%q as decimal = %q, want %q", tt.in, got, tt.decimal)
+		}
+		if got := o.Format(Fractional); got != tt.fractional {
+			t.Errorf("%q as fractional = %q, want %q", tt.in, got, tt.fractional)
+		}
+		if got := o.Format(American); got != tt.american {
+			t.Errorf("%q as American = %q, want %q", tt.in, got, tt.american)
+		}
+	}
+}
+
+// Conversions go through the exact price, so converting back yields the
+// same odds rather than a float approximation of them.
+func TestConversionsAreExact(t *testing.T) {
+	for _, in := range []string{"-110", "+137", "-333", "+105"} {
+		o := mustParse(t, in, American)
+		back := mustParse(t, o.Format(Fractional), Fractional)
+		if back.Price().Cmp(o.Price()) != 0 {
+			t.Errorf("%q via fractional = %s, want %s", in, back.Price(), o.Price())
+		}
+		if got := back.Format(American); got != in && "+"+got != in {
+			t.Errorf("%q via fractional back to American = %q", in, got)
+		}
+	}
+}
+
+func TestFraction(t *testing.T) {
+	tests := []struct {
+		in       string
+		format   Format
+		num, den int64
+	}{
+		{"2.5", Decimal, 5, 2},
+		{"2.50", Decimal, 5, 2},
+		{"6/4", Fractional, 5, 2},
+		{"-110", American, 21, 11},
+	}
+	for _, tt := range tests {
+		num, den := mustParse(t, tt.in, tt.format).Fraction()
+		if num != tt.num || den != tt.den {
+			t.Errorf("Parse(%q, %s).Fraction = %d/%d, want %d/%d", tt.in, tt.format, num, den, tt.num, tt.den)
+		}
+		o, err := FromFraction(num, den)
+		if err != nil || o.Price().Cmp(big.NewRat(tt.num, tt.den)) != 0 {
+			t.Errorf("FromFraction(%d, %d) = %v, %v", num, den, o, err)
+		}
+	}
+}
+
+func TestFromFloat(t *testing.T) {
+	o, err := FromFloat(2.1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if num, den := o.Fraction(); num != 21 || den != 10 {
+		t.Errorf("FromFloat(2.1).Fraction = %d/%d, want 21/10", num, den)
+	}
+}
+
+func TestParseRejectsBadInput(t *testing.T) {
+	tests := []struct {
+		in     string
+		format Format
+		want   error
+	}{
+		{"", Decimal, ErrSyntax},
+		{"abc", Decimal, ErrSyntax},
+		{"1.2.3", Decimal, ErrSyntax},
+		{"-2.0", Decimal, ErrSyntax},
+		{"1e3", Decimal, ErrSyntax},
+		{"0", Decimal, ErrRange},
+		{"1", Decimal, ErrRange},
+		{"1.00", Decimal, ErrRange},
+		{"0.5", Decimal, ErrRange},
+		{"1/0", Fractional, ErrSyntax},
+		{"6-4", Fractional, ErrSyntax},
+		{"-1/2", Fractional, ErrSyntax},
+		{"1/2/3", Fractional, ErrSyntax},
+		{"0/1", Fractional, ErrRange},
+		{"+50", American, ErrRange},
+		{"-50", American, ErrRange},
+		{"+99", American, ErrRange},
+		{"0", American, ErrRange},
+		{"+-150", American, ErrSyntax},
+		{"--150", American, ErrSyntax},
+		{"+", American, ErrSyntax},
+		{"+1.5", American, ErrSyntax},
+		{"2.50", Format("MALAYSIAN"), ErrSyntax},
+	}
+	for _, tt := range tests {
+		if _, err := Parse(tt.in, tt.format); !errors.Is(err, tt.want) {
+			t.Errorf("Parse(%q, %s) error = %v, want %v", tt.in, tt.format, err, tt.want)
+		}
+	}
+	if _, err := FromFraction(1, 0); !errors.Is(err, ErrRange) {
+		t.Errorf("FromFraction(1, 0) error = %v, want %v", err, ErrRange)
+	}
+}
+
+func TestImpliedProbability(t *testing.T) {
+	tests := []struct {
+		in     string
+		format Format
+		want   *big.Rat
+	}{
+		{"2.00", Decimal, big.NewRat(1, 2)},
+		{"4", Decimal, big.NewRat(1, 4)},
+		{"6/4", Fractional, big.NewRat(2, 5)},
+		{"-200", American, big.NewRat(2, 3)},
+		{"+300", American, big.NewRat(1, 4)},
+		{"-110", American, big.NewRat(11, 21)},
+	}
+	for _, tt := range tests {
+		if got := mustParse(t, tt.in, tt.format).ImpliedProbability(); got.Cmp(tt.want) != 0 {
+			t.Errorf("Parse(%q, %s).ImpliedProbability = %s, want %s", tt.in, tt.format, got, tt.want)
+		}
+	}
+}
+
+func TestCombine(t *testing.T) {
+	combined, err := Combine(mustParse(t, "2.00", Decimal), mustParse(t, "6/4", Fractional), mustParse(t, "-200", American))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 2 * 5/2 * 3/2
+	if combined.Price().Cmp(big.NewRat(15, 2)) != 0 {
+		t.Errorf("Combine price = %s, want 15/2", combined.Price())
+	}
+	if _, err := Combine(); !errors.Is(err, ErrRange) {
+		t.Errorf("Combine() error = %v, want %v", err, ErrRange)
+	}
+	if _, err := Combine(mustParse(t, "2.00", Decimal), Odds{}); !errors.Is(err, ErrRange) {
+		t.Errorf("Combine with zero odds error = %v, want %v", err, ErrRange)
+	}
+}
@@ -42,6 +42,15 @@ func (r *socialRepository) UpdateComment(ctx context.Context, commentID primitiv
 	return nil
 }
 
+func (r *socialRepository) GetComment(ctx context.Context, commentID primitive.ObjectID) (*model.Comment, error) {
+	var comment model.Comment
+	err := r.commentCollection.FindOne(ctx, bson.M{"_id": commentID}).Decode(&comment)
+	if err != nil {
+		return nil, err
+	}
+	return &comment, nil
+}
+
 func (r *socialRepository) DeleteComment(ctx context.Context, commentID primitive.ObjectID) error {
 	result, err := r.commentCollection.DeleteOne(ctx, bson.M{"_id": commentID})
 	if err != nil {
@@ -52,8 +61,15 @@ func (r *socialRepository) DeleteComment(ctx context.Context, commentID primitiv
 	}
 	return nil
 }
-func (r *socialRepository) ListTipComments(ctx context.Context, tipID string) ([]*model.Comment, error) {
-	cursor, err := r.commentCollection.Find(ctx, bson.M{"tipId": tipID})
+
+// ListTipComments returns the comments on the tip, leaving out those written
+// by excludeUserIDs.
+func (r *socialRepository) ListTipComments(ctx context.Context, tipID string, excludeUserIDs []string) ([]*model.Comment, error) {
+	filter := bson.M{"tipId": tipID}
+	if len(excludeUserIDs) > 0 {
+		filter["userId"] = bson.M{"$nin": excludeUserIDs}
+	}
+	cursor, err := r.commentCollection.Find(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -162,8 +178,14 @@ func (r *socialRepository) CreateReply(ctx context.Context, reply *model.Comment
 	return result.InsertedID.(primitive.ObjectID), nil
 }
 
-func (r *socialRepository) ListReplies(ctx context.Context, parentCommentID string) ([]*model.Comment, error) {
-	cursor, err := r.commentCollection.Find(ctx, bson.M{"parentId": parentCommentID})
+// ListReplies returns the replies to the comment, leaving out those written
+// by excludeUserIDs.
+func (r *socialRepository) ListReplies(ctx context.Context, parentCommentID string, excludeUserIDs []string) ([]*model.Comment, error) {
+	filter := bson.M{"parentId": parentCommentID}
+	if len(excludeUserIDs) > 0 {
+		filter["userId"] = bson.M{"$nin": excludeUserIDs}
+	}
+	cursor, err := r.commentCollection.Find(ctx, filter)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
@@ -181,13 +203,16 @@ func (r *socialRepository) ListReplies(ctx context.Context, parentCommentID stri
 	return replies, cursor.Err()
 }
 
-func (r *socialRepository) ListComments(ctx context.Context, pageSize int64, nextCursor string) ([]*model.Comment, string, error) {
+func (r *socialRepository) ListComments(ctx context.Context, excludeUserIDs []string, pageSize int64, nextCursor string) ([]*model.Comment, string, error) {
 
 	findOptions := options.Find()
 	findOptions.SetLimit(pageSize)
 	findOptions.SetSort(bson.M{"_id": 1})
 
 	filter := bson.M{}
+	if len(excludeUserIDs) > 0 {
+		filter["userId"] = bson.M{"$nin": excludeUserIDs}
+	}
 	if nextCursor != "" {
 		lastID, err := primitive.ObjectIDFromHex(nextCursor)
 		if err != nil {
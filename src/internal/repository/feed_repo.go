@@ -0,0 +1,208 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"src/internal/model"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	defaultFanoutFollowerThreshold = 1000
+	defaultFeedBackfillLimit       = 50
+)
+
+// CreateFeedEvent stores the event and copies it into the timeline of every
+// follower of its author, unless the author is above the fan-out threshold.
+// Such events are marked as pulled, so they stay on the read path even once
+// the author drops back below the threshold.
+func (r *socialRepository) CreateFeedEvent(ctx context.Context, event *model.FeedEvent) (primitive.ObjectID, error) {
+	var author struct {
+		FollowersCount int64 `bson:"followersCount"`
+	}
+	err := r.collection.FindOne(
+		ctx,
+		bson.M{"_id": event.AuthorID},
+		options.FindOne().SetProjection(bson.M{"followersCount": 1}),
+	).Decode(&author)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+
+	if event.ID.IsZero() {
+		event.ID = primitive.NewObjectID()
+	}
+	// High-follower authors are merged in when the feed is read
+	event.Pulled = author.FollowersCount > r.fanoutThreshold
+	result, err := r.feedCollection.InsertOne(ctx, event)
+	if err != nil {
+		return primitive.NilObjectID, err
+	}
+	eventID := result.InsertedID.(primitive.ObjectID)
+	if event.Pulled || author.FollowersCount == 0 {
+		return eventID, nil
+	}
+
+	followerIDs, err := r.connectionIDs(ctx, bson.M{"followeeId": event.AuthorID}, "followerId")
+	if err != nil {
+		return eventID, err
+	}
+	entries := make([]interface{}, 0, len(followerIDs))
+	for _, followerID := range followerIDs {
+		entries = append(entries, &model.TimelineEntry{
+			OwnerID:   followerID,
+			EventID:   eventID,
+			AuthorID:  event.AuthorID,
+			CreatedAt: event.CreatedAt,
+		})
+	}
+	return eventID, r.insertTimelineEntries(ctx, entries)
+}
+
+// ListFollowingFeed returns the user's feed newest-first. Events fanned out
+// on write come from the user's materialized timeline, pulled events of
+// followed tipsters are read from their own history, and both are merged by
+// event ID. Events of excludeAuthorIDs are left out. The cursor is
+// the hex ID of the last event returned.
+func (r *socialRepository) ListFollowingFeed(ctx context.Context, userID primitive.ObjectID, excludeAuthorIDs []primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.FeedEvent, string, error) {
+	var cursorFilter bson.M
+	if nextCursor != "" {
+		lastID, err := primitive.ObjectIDFromHex(nextCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		cursorFilter = bson.M{"$lt": lastID}
+	}
+
+	followingIDs, err := r.connectionIDs(ctx, bson.M{"followerId": userID}, "followeeId")
+	if err != nil {
+		return nil, "", err
+	}
+	followingIDs = slices.DeleteFunc(followingIDs, func(id primitive.ObjectID) bool {
+		return slices.Contains(excludeAuthorIDs, id)
+	})
+
+	// Fan-out-on-write: events already copied into the user's timeline
+	timelineFilter := bson.M{"ownerId": userID}
+	if cursorFilter != nil {
+		timelineFilter["eventId"] = cursorFilter
+	}
+	if len(excludeAuthorIDs) > 0 {
+		timelineFilter["authorId"] = bson.M{"$nin": excludeAuthorIDs}
+	}
+	timelineCursor, err := r.timelineCollection.Find(
+		ctx,
+		timelineFilter,
+		options.Find().SetLimit(pageSize).SetSort(bson.M{"eventId": -1}).SetProjection(bson.M{"eventId": 1}),
+	)
+	if err != nil {
+		return nil, "", err
+	}
+	defer timelineCursor.Close(ctx)
+
+	eventIDs := []primitive.ObjectID{}
+	for timelineCursor.Next(ctx) {
+		var entry model.TimelineEntry
+		if err := timelineCursor.Decode(&entry); err == nil {
+			eventIDs = append(eventIDs, entry.EventID)
+		}
+	}
+	if err := timelineCursor.Err(); err != nil {
+		return nil, "", err
+	}
+
+	// Fan-out-on-read: events followed tipsters posted above the threshold
+	sources := bson.A{bson.M{"_id": bson.M{"$in": eventIDs}}}
+	if len(followingIDs) > 0 {
+		pullFilter := bson.M{"authorId": bson.M{"$in": followingIDs}, "pulled": true}
+		if cursorFilter != nil {
+			pullFilter["_id"] = cursorFilter
+		}
+		sources = append(sources, pullFilter)
+	}
+
+	findOptions := options.Find().SetLimit(pageSize).SetSort(bson.M{"_id": -1})
+	cursor, err := r.feedCollection.Find(ctx, bson.M{"$or": sources}, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	events := []*model.FeedEvent{}
+	var lastEventID primitive.ObjectID
+	for cursor.Next(ctx) {
+		var event model.FeedEvent
+		if err := cursor.Decode(&event); err == nil {
+			events = append(events, &event)
+			lastEventID = event.ID
+		}
+	}
+
+	nextCursor = ""
+	if len(events) == int(pageSize) {
+		nextCursor = lastEventID.Hex()
+	}
+	return events, nextCursor, cursor.Err()
+}
+
+// backfillTimeline copies the tipster's most recent events into the timeline
+// of a new follower so the feed is not empty until the tipster posts again.
+// Pulled events are left out, the follower reads them from the tipster's
+// history.
+func (r *socialRepository) backfillTimeline(ctx context.Context, userID, tipsterID primitive.ObjectID) error {
+	findOptions := options.Find().SetLimit(r.backfillLimit).SetSort(bson.M{"_id": -1})
+	cursor, err := r.feedCollection.Find(ctx, bson.M{"authorId": tipsterID, "pulled": bson.M{"$ne": true}}, findOptions)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []interface{}{}
+	for cursor.Next(ctx) {
+		var event model.FeedEvent
+		if err := cursor.Decode(&event); err == nil {
+			entries = append(entries, &model.TimelineEntry{
+				OwnerID:   userID,
+				EventID:   event.ID,
+				AuthorID:  event.AuthorID,
+				CreatedAt: event.CreatedAt,
+			})
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return err
+	}
+	return r.insertTimelineEntries(ctx, entries)
+}
+
+// backfillNewFollower backfills the timeline once a follow has committed.
+// The edge is what counts, so a failure is only logged: failing the follow
+// would make a retry report that the user already follows the tipster.
+func (r *socialRepository) backfillNewFollower(ctx context.Context, userID, tipsterID primitive.ObjectID) {
+	if err := r.backfillTimeline(ctx, userID, tipsterID); err != nil {
+		r.logger.Log(log.LevelError, "failed to backfill timeline", "user_id", userID.Hex(), "tipster_id", tipsterID.Hex(), "error", err)
+	}
+}
+
+// clearTimeline removes an unfollowed tipster's events from the user's timeline.
+func (r *socialRepository) clearTimeline(ctx context.Context, userID, tipsterID primitive.ObjectID) error {
+	_, err := r.timelineCollection.DeleteMany(ctx, bson.M{"ownerId": userID, "authorId": tipsterID})
+	return err
+}
+
+func (r *socialRepository) insertTimelineEntries(ctx context.Context, entries []interface{}) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	_, err := r.timelineCollection.InsertMany(ctx, entries, options.InsertMany().SetOrdered(false))
+	// Entries that already exist are skipped by the unique (ownerId, eventId) index
+	if err != nil && !mongo.IsDuplicateKeyError(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,173 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FollowTipster adds the follow edge and bumps both counts in one
+// transaction, then backfills the user's timeline. It reports false if the
+// user already follows the tipster, which the unique (followerId,
+// followeeId) index detects.
+func (r *socialRepository) FollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) (bool, error) {
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		_, err := r.followCollection.InsertOne(ctx, &model.Follow{
+			FollowerID: userID,
+			FolloweeID: tipsterID,
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+		return r.incFollowCounts(ctx, userID, tipsterID, 1)
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	r.backfillNewFollower(ctx, userID, tipsterID)
+	return true, nil
+}
+
+// UnfollowTipster removes the follow edge, if there is one, and drops both
+// counts in one transaction.
+func (r *socialRepository) UnfollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) error {
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		result, err := r.followCollection.DeleteOne(ctx, bson.M{"followerId": userID, "followeeId": tipsterID})
+		if err != nil {
+			return err
+		}
+		if result.DeletedCount == 0 {
+			return nil
+		}
+		return r.incFollowCounts(ctx, userID, tipsterID, -1)
+	})
+	if err != nil {
+		return err
+	}
+	return r.clearTimeline(ctx, userID, tipsterID)
+}
+
+// IsFollowing reports whether there is a follow edge from follower to
+// followee.
+func (r *socialRepository) IsFollowing(ctx context.Context, followerID, followeeID primitive.ObjectID) (bool, error) {
+	count, err := r.followCollection.CountDocuments(
+		ctx,
+		bson.M{"followerId": followerID, "followeeId": followeeID},
+		options.Count().SetLimit(1),
+	)
+	return count > 0, err
+}
+
+// ListFolloweeIDs returns the IDs of every user the user follows.
+func (r *socialRepository) ListFolloweeIDs(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	return r.connectionIDs(ctx, bson.M{"followerId": userID}, "followeeId")
+}
+
+// incFollowCounts moves the follower's following count and the followee's
+// followers count by delta.
+func (r *socialRepository) incFollowCounts(ctx context.Context, followerID, followeeID primitive.ObjectID, delta int64) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": followerID}, bson.M{"$inc": bson.M{"followingCount": delta}})
+	if err != nil {
+		return err
+	}
+	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": followeeID}, bson.M{"$inc": bson.M{"followersCount": delta}})
+	return err
+}
+
+// ListFollowers returns a page of the user's followers, most recent first.
+// The cursor is the hex ID of the last follow edge returned.
+func (r *socialRepository) ListFollowers(ctx context.Context, userID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error) {
+	return r.listConnections(ctx, bson.M{"followeeId": userID}, "followerId", pageSize, nextCursor)
+}
+
+// ListFollowing returns a page of the users the user follows, like
+// ListFollowers.
+func (r *socialRepository) ListFollowing(ctx context.Context, userID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error) {
+	return r.listConnections(ctx, bson.M{"followerId": userID}, "followeeId", pageSize, nextCursor)
+}
+
+func (r *socialRepository) listConnections(ctx context.Context, filter bson.M, field string, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error) {
+	if nextCursor != "" {
+		lastID, err := primitive.ObjectIDFromHex(nextCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["_id"] = bson.M{"$lt": lastID}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$sort", Value: bson.M{"_id": -1}}},
+		{{Key: "$limit", Value: pageSize}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         r.collection.Name(),
+			"localField":   field,
+			"foreignField": "_id",
+			"pipeline":     bson.A{bson.M{"$project": bson.M{"username": 1}}},
+			"as":           "user",
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"edgeId":   "$_id",
+			"_id":      "$" + field,
+			"username": bson.M{"$first": "$user.username"},
+		}}},
+	}
+
+	cursor, err := r.followCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	users := []*model.UserDetail{}
+	var lastEdgeID primitive.ObjectID
+	for cursor.Next(ctx) {
+		var connection struct {
+			model.UserDetail `bson:",inline"`
+			EdgeID           primitive.ObjectID `bson:"edgeId"`
+		}
+		if err := cursor.Decode(&connection); err == nil {
+			users = append(users, &connection.UserDetail)
+			lastEdgeID = connection.EdgeID
+		}
+	}
+
+	nextCursor = ""
+	if len(users) == int(pageSize) {
+		nextCursor = lastEdgeID.Hex()
+	}
+	return users, nextCursor, cursor.Err()
+}
+
+// connectionIDs returns the IDs on the other end of every edge matching the
+// filter, e.g. the followee IDs for bson.M{"followerId": userID}.
+func (r *socialRepository) connectionIDs(ctx context.Context, filter bson.M, field string) ([]primitive.ObjectID, error) {
+	cursor, err := r.followCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{field: 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	ids := []primitive.ObjectID{}
+	for cursor.Next(ctx) {
+		var follow model.Follow
+		if err := cursor.Decode(&follow); err == nil {
+			if field == "followerId" {
+				ids = append(ids, follow.FollowerID)
+			} else {
+				ids = append(ids, follow.FolloweeID)
+			}
+		}
+	}
+	return ids, cursor.Err()
+}
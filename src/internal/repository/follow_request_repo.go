@@ -0,0 +1,126 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateFollowRequest stores a pending follow. It reports false if the
+// requester already asked, which the unique (requesterId, targetId) index
+// detects.
+func (r *socialRepository) CreateFollowRequest(ctx context.Context, request *model.FollowRequest) (bool, error) {
+	if request.ID.IsZero() {
+		request.ID = primitive.NewObjectID()
+	}
+	_, err := r.requestCollection.InsertOne(ctx, request)
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *socialRepository) GetFollowRequest(ctx context.Context, requestID primitive.ObjectID) (*model.FollowRequest, error) {
+	var request model.FollowRequest
+	err := r.requestCollection.FindOne(ctx, bson.M{"_id": requestID}).Decode(&request)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// ApproveFollowRequest replaces the request with a follow edge and bumps
+// both counts in one transaction, then backfills the requester's timeline.
+// ErrNoDocuments is returned if the request was already handled.
+func (r *socialRepository) ApproveFollowRequest(ctx context.Context, request *model.FollowRequest) error {
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		result, err := r.requestCollection.DeleteOne(ctx, bson.M{"_id": request.ID})
+		if err != nil {
+			return err
+		}
+		if result.DeletedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+
+		// A failed insert aborts the transaction, so look for the edge first
+		following, err := r.IsFollowing(ctx, request.RequesterID, request.TargetID)
+		if err != nil || following {
+			return err
+		}
+		_, err = r.followCollection.InsertOne(ctx, &model.Follow{
+			FollowerID: request.RequesterID,
+			FolloweeID: request.TargetID,
+			CreatedAt:  time.Now().UTC(),
+		})
+		if err != nil {
+			return err
+		}
+		return r.incFollowCounts(ctx, request.RequesterID, request.TargetID, 1)
+	})
+	if err != nil {
+		return err
+	}
+	r.backfillNewFollower(ctx, request.RequesterID, request.TargetID)
+	return nil
+}
+
+// RejectFollowRequest deletes the request. ErrNoDocuments is returned if it
+// was already handled.
+func (r *socialRepository) RejectFollowRequest(ctx context.Context, requestID primitive.ObjectID) error {
+	result, err := r.requestCollection.DeleteOne(ctx, bson.M{"_id": requestID})
+	if err != nil {
+		return err
+	}
+	if result.DeletedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// CancelFollowRequest withdraws the requester's pending request, if any.
+func (r *socialRepository) CancelFollowRequest(ctx context.Context, requesterID, targetID primitive.ObjectID) error {
+	_, err := r.requestCollection.DeleteOne(ctx, bson.M{"requesterId": requesterID, "targetId": targetID})
+	return err
+}
+
+// ListFollowRequests returns a page of the requests to follow the user,
+// newest first. The cursor is the hex ID of the last request returned.
+func (r *socialRepository) ListFollowRequests(ctx context.Context, targetID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.FollowRequest, string, error) {
+	filter := bson.M{"targetId": targetID}
+	if nextCursor != "" {
+		lastID, err := primitive.ObjectIDFromHex(nextCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["_id"] = bson.M{"$lt": lastID}
+	}
+
+	findOptions := options.Find().SetLimit(pageSize).SetSort(bson.M{"_id": -1})
+	cursor, err := r.requestCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	requests := []*model.FollowRequest{}
+	var lastRequestID primitive.ObjectID
+	for cursor.Next(ctx) {
+		var request model.FollowRequest
+		if err := cursor.Decode(&request); err == nil {
+			requests = append(requests, &request)
+			lastRequestID = request.ID
+		}
+	}
+
+	nextCursor = ""
+	if len(requests) == int(pageSize) {
+		nextCursor = lastRequestID.Hex()
+	}
+	return requests, nextCursor, cursor.Err()
+}
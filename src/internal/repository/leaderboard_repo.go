@@ -0,0 +1,117 @@
+package repository
+
+import (
+	"context"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// leaderboardSnapshotsKept is how many snapshots of a window are kept. The
+// previous one stays around so clients paging through it when a new one is
+// published can finish.
+const leaderboardSnapshotsKept = 2
+
+// InsertLeaderboardEntries stores entries of a snapshot that is not
+// published yet.
+func (r *socialRepository) InsertLeaderboardEntries(ctx context.Context, entries []*model.LeaderboardEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+	docs := make([]interface{}, 0, len(entries))
+	for _, entry := range entries {
+		docs = append(docs, entry)
+	}
+	_, err := r.leaderboardCollection.InsertMany(ctx, docs)
+	return err
+}
+
+// PublishLeaderboardSnapshot stores the snapshot once all its entries are
+// in, making it the latest of its window, and removes the snapshots of the
+// window that are no longer kept together with their entries.
+func (r *socialRepository) PublishLeaderboardSnapshot(ctx context.Context, snapshot *model.LeaderboardSnapshot) error {
+	if _, err := r.snapshotCollection.InsertOne(ctx, snapshot); err != nil {
+		return err
+	}
+
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "computedAt", Value: -1}, {Key: "_id", Value: -1}}).
+		SetSkip(leaderboardSnapshotsKept).
+		SetProjection(bson.M{"_id": 1})
+	cursor, err := r.snapshotCollection.Find(ctx, bson.M{"window": snapshot.Window}, findOptions)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var old model.LeaderboardSnapshot
+		if err := cursor.Decode(&old); err != nil {
+			return err
+		}
+		// Entries go first, so a failed cleanup is repeated on the next run
+		if err := r.DeleteLeaderboardEntries(ctx, old.ID); err != nil {
+			return err
+		}
+		if _, err := r.snapshotCollection.DeleteOne(ctx, bson.M{"_id": old.ID}); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// DeleteLeaderboardEntries removes the entries of a snapshot.
+func (r *socialRepository) DeleteLeaderboardEntries(ctx context.Context, snapshotID primitive.ObjectID) error {
+	_, err := r.leaderboardCollection.DeleteMany(ctx, bson.M{"snapshotId": snapshotID})
+	return err
+}
+
+// GetLatestLeaderboardSnapshot returns the most recently published snapshot
+// of the window.
+func (r *socialRepository) GetLatestLeaderboardSnapshot(ctx context.Context, window string) (*model.LeaderboardSnapshot, error) {
+	var snapshot model.LeaderboardSnapshot
+	findOptions := options.FindOne().SetSort(bson.D{{Key: "computedAt", Value: -1}, {Key: "_id", Value: -1}})
+	err := r.snapshotCollection.FindOne(ctx, bson.M{"window": window}, findOptions).Decode(&snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+func (r *socialRepository) GetLeaderboardSnapshot(ctx context.Context, snapshotID primitive.ObjectID) (*model.LeaderboardSnapshot, error) {
+	var snapshot model.LeaderboardSnapshot
+	err := r.snapshotCollection.FindOne(ctx, bson.M{"_id": snapshotID}).Decode(&snapshot)
+	if err != nil {
+		return nil, err
+	}
+	return &snapshot, nil
+}
+
+// ListLeaderboardEntries returns the entries of a snapshot matching the
+// filter, ordered by sortField from best to worst. Snapshots never change,
+// so pages are addressed by offset.
+func (r *socialRepository) ListLeaderboardEntries(ctx context.Context, snapshotID primitive.ObjectID, filter bson.M, sortField string, offset, limit int64) ([]*model.LeaderboardEntry, error) {
+	filter["snapshotId"] = snapshotID
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: sortField, Value: -1}, {Key: "_id", Value: 1}}).
+		SetSkip(offset).
+		SetLimit(limit)
+	cursor, err := r.leaderboardCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	entries := []*model.LeaderboardEntry{}
+	for cursor.Next(ctx) {
+		var entry model.LeaderboardEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return nil, err
+		}
+		entries = append(entries, &entry)
+	}
+	return entries, cursor.Err()
+}
@@ -0,0 +1,77 @@
+package repository
+
+import (
+	"context"
+
+	"src/internal/ledger"
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ledgerAppendAttempts bounds how often a write is retried when another
+// entry took its place in the tipster's ledger first.
+const ledgerAppendAttempts = 3
+
+// withLedgerEntry runs fn and appends the entry to its tipster's ledger in
+// one transaction, so a tip is never written without its entry. The unique
+// (tipsterId, sequence) index turns racing appends into duplicate keys,
+// which are retried on top of the new last entry.
+func (r *socialRepository) withLedgerEntry(ctx context.Context, entry *model.LedgerEntry, fn func(ctx mongo.SessionContext) error) error {
+	var err error
+	for attempt := 1; attempt <= ledgerAppendAttempts; attempt++ {
+		err = r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+			if err := fn(ctx); err != nil {
+				return err
+			}
+			return r.appendLedgerEntry(ctx, entry)
+		})
+		if !mongo.IsDuplicateKeyError(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (r *socialRepository) appendLedgerEntry(ctx context.Context, entry *model.LedgerEntry) error {
+	var last model.LedgerEntry
+	prev := &last
+	findOptions := options.FindOne().SetSort(bson.M{"sequence": -1})
+	err := r.ledgerCollection.FindOne(ctx, bson.M{"tipsterId": entry.TipsterID}, findOptions).Decode(&last)
+	if err == mongo.ErrNoDocuments {
+		prev = nil
+	} else if err != nil {
+		return err
+	}
+	if err := ledger.Seal(entry, prev); err != nil {
+		return err
+	}
+	entry.ID = primitive.NewObjectID()
+	_, err = r.ledgerCollection.InsertOne(ctx, entry)
+	return err
+}
+
+// ForEachLedgerEntry calls fn with every entry of the tipster's ledger in
+// sequence order.
+func (r *socialRepository) ForEachLedgerEntry(ctx context.Context, tipsterID string, fn func(entry *model.LedgerEntry) error) error {
+	findOptions := options.Find().SetSort(bson.D{{Key: "sequence", Value: 1}, {Key: "_id", Value: 1}})
+	cursor, err := r.ledgerCollection.Find(ctx, bson.M{"tipsterId": tipsterID}, findOptions)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var entry model.LedgerEntry
+		if err := cursor.Decode(&entry); err != nil {
+			return err
+		}
+		if err := fn(&entry); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"src/internal/ledger"
+	"src/internal/model"
+	commonpb "src/protos/YM.Common"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MarkUserDeleted soft-deletes the user. ErrNoDocuments is returned if the
+// user does not exist or is already deleted.
+func (r *socialRepository) MarkUserDeleted(ctx context.Context, userID primitive.ObjectID, deletion *model.UserDeletion) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": userID, "deletion": bson.M{"$exists": false}},
+		bson.M{"$set": bson.M{
+			"status":          commonpb.AccountStatus_Delete,
+			"statusReason":    "deleted",
+			"statusChangedAt": deletion.RequestedAt,
+			"deletion":        deletion,
+			"updatedAt":       deletion.RequestedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// RestoreDeletedUser undoes a soft delete that has not been purged yet.
+func (r *socialRepository) RestoreDeletedUser(ctx context.Context, userID primitive.ObjectID, status commonpb.AccountStatus, restoredAt time.Time) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": userID, "deletion": bson.M{"$exists": true}},
+		bson.M{
+			"$set": bson.M{
+				"status":          status,
+				"statusReason":    "restored",
+				"statusChangedAt": restoredAt,
+				"updatedAt":       restoredAt,
+			},
+			"$unset": bson.M{"deletion": ""},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// ListUsersDueForPurge returns deleted users whose grace period is over.
+func (r *socialRepository) ListUsersDueForPurge(ctx context.Context, now time.Time, limit int64) ([]primitive.ObjectID, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"deletion.purgeAfter": bson.M{"$lte": now}},
+		options.Find().SetLimit(limit).SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	ids := []primitive.ObjectID{}
+	for cursor.Next(ctx) {
+		var user model.UserDetail
+		if err := cursor.Decode(&user); err == nil {
+			ids = append(ids, user.ID)
+		}
+	}
+	return ids, cursor.Err()
+}
+
+// PurgeUser detaches a deleted user from the social graph, reactions and
+// feeds, anonymizes or removes their tips and comments, and finally removes
+// the account. Every step can be repeated, so a purge that fails halfway is
+// finished by the next run. Self-exclusion records are kept for compliance
+// and the tip ledger for the user's record; entries keep the user's ID,
+// which their hashes commit to.
+func (r *socialRepository) PurgeUser(ctx context.Context, userID primitive.ObjectID, removeContent bool, purgedAt time.Time) error {
+	// Social graph
+	if err := r.removeFollowEdges(ctx, userID); err != nil {
+		return err
+	}
+	_, err := r.requestCollection.DeleteMany(ctx, bson.M{"$or": bson.A{bson.M{"requesterId": userID}, bson.M{"targetId": userID}}})
+	if err != nil {
+		return err
+	}
+	_, err = r.restrictCollection.DeleteMany(ctx, bson.M{"$or": bson.A{bson.M{"ownerId": userID}, bson.M{"targetId": userID}}})
+	if err != nil {
+		return err
+	}
+
+	// Reactions
+	for _, collection := range []*mongo.Collection{r.tipCollection, r.commentCollection} {
+		_, err := collection.UpdateMany(
+			ctx,
+			bson.M{"$or": bson.A{bson.M{"likes": userID}, bson.M{"unlikes": userID}}},
+			bson.M{"$pull": bson.M{"likes": userID, "unlikes": userID}},
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Shares
+	if err := r.removeShares(ctx, bson.M{"sharerId": userID}); err != nil {
+		return err
+	}
+
+	// Feeds
+	if _, err := r.feedCollection.DeleteMany(ctx, bson.M{"authorId": userID}); err != nil {
+		return err
+	}
+	_, err = r.timelineCollection.DeleteMany(ctx, bson.M{"$or": bson.A{bson.M{"ownerId": userID}, bson.M{"authorId": userID}}})
+	if err != nil {
+		return err
+	}
+
+	// Content
+	if removeContent {
+		if err := r.deleteUserTips(ctx, userID.Hex(), purgedAt); err != nil {
+			return err
+		}
+		err = r.removeUserContent(ctx, userID.Hex())
+	} else {
+		err = r.anonymizeUserContent(ctx, userID.Hex())
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = r.collection.DeleteOne(ctx, bson.M{"_id": userID, "deletion": bson.M{"$exists": true}})
+	return err
+}
+
+// removeFollowEdges deletes every edge of the user one at a time, moving the
+// count on the other end only when the edge was actually deleted, so the
+// counts stay right when a failed purge is repeated.
+func (r *socialRepository) removeFollowEdges(ctx context.Context, userID primitive.ObjectID) error {
+	cursor, err := r.followCollection.Find(ctx, bson.M{"$or": bson.A{bson.M{"followerId": userID}, bson.M{"followeeId": userID}}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var follow model.Follow
+		if err := cursor.Decode(&follow); err != nil {
+			return err
+		}
+		result, err := r.followCollection.DeleteOne(ctx, bson.M{"_id": follow.ID})
+		if err != nil {
+			return err
+		}
+		if result.DeletedCount == 0 {
+			continue
+		}
+		otherID, count := follow.FolloweeID, "followersCount"
+		if follow.FolloweeID == userID {
+			otherID, count = follow.FollowerID, "followingCount"
+		}
+		_, err = r.collection.UpdateOne(ctx, bson.M{"_id": otherID}, bson.M{"$inc": bson.M{count: -1}})
+		if err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+// deleteUserTips deletes the user's tips that are not deleted yet the way
+// DeleteTip does, so their removal is recorded in the ledger.
+func (r *socialRepository) deleteUserTips(ctx context.Context, userID string, deletedAt time.Time) error {
+	cursor, err := r.tipCollection.Find(ctx, bson.M{"tipsterId": userID, "deletedAt": bson.M{"$exists": false}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var tip model.Tip
+		if err := cursor.Decode(&tip); err != nil {
+			return err
+		}
+		err := r.DeleteTip(ctx, tip.ID, deletedAt, ledger.Deleted(&tip, deletedAt))
+		if err != nil && err != mongo.ErrNoDocuments {
+			return err
+		}
+	}
+	return cursor.Err()
+}
+
+func (r *socialRepository) anonymizeUserContent(ctx context.Context, userID string) error {
+	_, err := r.tipCollection.UpdateMany(ctx, bson.M{"tipsterId": userID}, bson.M{"$set": bson.M{"tipsterId": model.DeletedUserID}})
+	if err != nil {
+		return err
+	}
+	_, err = r.revisionCollection.UpdateMany(ctx, bson.M{"editorId": userID}, bson.M{"$set": bson.M{"editorId": model.DeletedUserID}})
+	if err != nil {
+		return err
+	}
+	_, err = r.commentCollection.UpdateMany(ctx, bson.M{"userId": userID}, bson.M{"$set": bson.M{"userId": model.DeletedUserID}})
+	return err
+}
+
+// removeUserContent deletes the user's tips with every comment on them, and
+// the user's comments with every reply below them.
+func (r *socialRepository) removeUserContent(ctx context.Context, userID string) error {
+	tipIDs, err := r.hexIDs(ctx, r.tipCollection, bson.M{"tipsterId": userID})
+	if err != nil {
+		return err
+	}
+	commentIDs, err := r.hexIDs(ctx, r.commentCollection, bson.M{"$or": bson.A{
+		bson.M{"userId": userID},
+		bson.M{"tipId": bson.M{"$in": tipIDs}},
+	}})
+	if err != nil {
+		return err
+	}
+
+	// Replies only reference their parent, so walk down the threads
+	for parentIDs := commentIDs; len(parentIDs) > 0; {
+		replyIDs, err := r.hexIDs(ctx, r.commentCollection, bson.M{"parentId": bson.M{"$in": parentIDs}})
+		if err != nil {
+			return err
+		}
+		commentIDs = append(commentIDs, replyIDs...)
+		parentIDs = replyIDs
+	}
+
+	if err := r.deleteByHexIDs(ctx, r.commentCollection, commentIDs); err != nil {
+		return err
+	}
+	if _, err := r.feedCollection.DeleteMany(ctx, bson.M{"targetId": bson.M{"$in": tipIDs}}); err != nil {
+		return err
+	}
+	if err := r.deleteOfTips(ctx, r.shareCollection, tipIDs); err != nil {
+		return err
+	}
+	if err := r.deleteOfTips(ctx, r.revisionCollection, tipIDs); err != nil {
+		return err
+	}
+	return r.deleteByHexIDs(ctx, r.tipCollection, tipIDs)
+}
+
+// deleteOfTips deletes the documents of the collection that belong to the
+// tips.
+func (r *socialRepository) deleteOfTips(ctx context.Context, collection *mongo.Collection, tipHexIDs []string) error {
+	tipIDs := make([]primitive.ObjectID, 0, len(tipHexIDs))
+	for _, hexID := range tipHexIDs {
+		if id, err := primitive.ObjectIDFromHex(hexID); err == nil {
+			tipIDs = append(tipIDs, id)
+		}
+	}
+	_, err := collection.DeleteMany(ctx, bson.M{"tipId": bson.M{"$in": tipIDs}})
+	return err
+}
+
+func (r *socialRepository) hexIDs(ctx context.Context, collection *mongo.Collection, filter bson.M) ([]string, error) {
+	cursor, err := collection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	ids := []string{}
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID primitive.ObjectID `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err == nil {
+			ids = append(ids, doc.ID.Hex())
+		}
+	}
+	return ids, cursor.Err()
+}
+
+func (r *socialRepository) deleteByHexIDs(ctx context.Context, collection *mongo.Collection, hexIDs []string) error {
+	if len(hexIDs) == 0 {
+		return nil
+	}
+	ids := make([]primitive.ObjectID, 0, len(hexIDs))
+	for _, hexID := range hexIDs {
+		if id, err := primitive.ObjectIDFromHex(hexID); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	_, err := collection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	return err
+}
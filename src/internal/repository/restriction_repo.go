@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddRestriction stores a block or mute. Adding one that already exists is
+// a no-op thanks to the unique (ownerId, targetId, kind) index.
+func (r *socialRepository) AddRestriction(ctx context.Context, restriction *model.Restriction) error {
+	if restriction.ID.IsZero() {
+		restriction.ID = primitive.NewObjectID()
+	}
+	_, err := r.restrictCollection.InsertOne(ctx, restriction)
+	if mongo.IsDuplicateKeyError(err) {
+		return nil
+	}
+	return err
+}
+
+// BlockUser stores the block and removes the follows and follow requests
+// between the two users in both directions, in one transaction so a block
+// never leaves them behind. Each user's timeline is then cleared of the
+// other's events. Blocking again is a no-op apart from redoing the cleanup.
+func (r *socialRepository) BlockUser(ctx context.Context, restriction *model.Restriction) error {
+	if restriction.ID.IsZero() {
+		restriction.ID = primitive.NewObjectID()
+	}
+	ownerID, targetID := restriction.OwnerID, restriction.TargetID
+	pairs := [][2]primitive.ObjectID{{ownerID, targetID}, {targetID, ownerID}}
+
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		// A duplicate key would abort the transaction, so upsert instead
+		_, err := r.restrictCollection.UpdateOne(
+			ctx,
+			bson.M{"ownerId": ownerID, "targetId": targetID, "kind": restriction.Kind},
+			bson.M{"$setOnInsert": restriction},
+			options.Update().SetUpsert(true),
+		)
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range pairs {
+			result, err := r.followCollection.DeleteOne(ctx, bson.M{"followerId": pair[0], "followeeId": pair[1]})
+			if err != nil {
+				return err
+			}
+			if result.DeletedCount == 0 {
+				continue
+			}
+			if err := r.incFollowCounts(ctx, pair[0], pair[1], -1); err != nil {
+				return err
+			}
+		}
+
+		_, err = r.requestCollection.DeleteMany(ctx, bson.M{"$or": bson.A{
+			bson.M{"requesterId": ownerID, "targetId": targetID},
+			bson.M{"requesterId": targetID, "targetId": ownerID},
+		}})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range pairs {
+		if err := r.clearTimeline(ctx, pair[0], pair[1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *socialRepository) RemoveRestriction(ctx context.Context, ownerID, targetID primitive.ObjectID, kind string) error {
+	_, err := r.restrictCollection.DeleteOne(ctx, bson.M{"ownerId": ownerID, "targetId": targetID, "kind": kind})
+	return err
+}
+
+// IsBlocked reports whether the owner has blocked the target.
+func (r *socialRepository) IsBlocked(ctx context.Context, ownerID, targetID primitive.ObjectID) (bool, error) {
+	count, err := r.restrictCollection.CountDocuments(
+		ctx,
+		bson.M{"ownerId": ownerID, "targetId": targetID, "kind": model.RestrictionBlock},
+		options.Count().SetLimit(1),
+	)
+	return count > 0, err
+}
+
+// ListRestrictedUserIDs returns every user the owner has blocked or muted.
+func (r *socialRepository) ListRestrictedUserIDs(ctx context.Context, ownerID primitive.ObjectID) ([]primitive.ObjectID, error) {
+	cursor, err := r.restrictCollection.Find(ctx, bson.M{"ownerId": ownerID}, options.Find().SetProjection(bson.M{"targetId": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	ids := []primitive.ObjectID{}
+	for cursor.Next(ctx) {
+		var restriction model.Restriction
+		if err := cursor.Decode(&restriction); err == nil {
+			ids = append(ids, restriction.TargetID)
+		}
+	}
+	return ids, cursor.Err()
+}
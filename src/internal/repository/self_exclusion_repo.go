@@ -0,0 +1,171 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"src/internal/model"
+	commonpb "src/protos/YM.Common"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// StartSelfExclusion moves an active user to the SelfExclude status and
+// records the exclusion, in one transaction so neither happens without the
+// other. ErrNoDocuments is returned if the user is not active.
+func (r *socialRepository) StartSelfExclusion(ctx context.Context, exclusion *model.SelfExclusion) error {
+	if exclusion.ID.IsZero() {
+		exclusion.ID = primitive.NewObjectID()
+	}
+	return r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		result, err := r.collection.UpdateOne(
+			ctx,
+			bson.M{
+				"_id": exclusion.UserID,
+				"status": bson.M{"$in": bson.A{
+					commonpb.AccountStatus_Active,
+					commonpb.AccountStatus_AccountStatusNone,
+					nil,
+				}},
+			},
+			bson.M{"$set": bson.M{
+				"status":            commonpb.AccountStatus_SelfExclude,
+				"statusReason":      "self-exclusion " + exclusion.Period,
+				"statusChangedAt":   exclusion.StartedAt,
+				"selfExcludedUntil": exclusion.EndsAt,
+				"updatedAt":         exclusion.StartedAt,
+			}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+
+		_, err = r.exclusionCollection.InsertOne(ctx, exclusion)
+		return err
+	})
+}
+
+// ExpireSelfExclusion releases the user if their self-exclusion has run out,
+// and records the expiry in the same transaction, so a user is never
+// released without it. It reports whether the user was released.
+func (r *socialRepository) ExpireSelfExclusion(ctx context.Context, userID primitive.ObjectID, now time.Time) (bool, error) {
+	var released bool
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		released = false
+		result, err := r.collection.UpdateOne(
+			ctx,
+			bson.M{
+				"_id":               userID,
+				"status":            commonpb.AccountStatus_SelfExclude,
+				"selfExcludedUntil": bson.M{"$lte": now},
+			},
+			bson.M{
+				"$set": bson.M{
+					"status":          commonpb.AccountStatus_Active,
+					"statusReason":    "self-exclusion expired",
+					"statusChangedAt": now,
+					"updatedAt":       now,
+				},
+				"$unset": bson.M{"selfExcludedUntil": ""},
+			},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return nil
+		}
+
+		_, err = r.exclusionCollection.UpdateMany(
+			ctx,
+			bson.M{
+				"userId":    userID,
+				"expiredAt": nil,
+				"endsAt":    bson.M{"$lte": now},
+			},
+			bson.M{"$set": bson.M{"expiredAt": now}},
+		)
+		if err != nil {
+			return err
+		}
+		released = true
+		return nil
+	})
+	if err != nil {
+		return false, err
+	}
+	return released, nil
+}
+
+// ExpireSelfExclusions releases every user whose self-exclusion has run out
+// and returns how many were released.
+func (r *socialRepository) ExpireSelfExclusions(ctx context.Context, now time.Time) (int64, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{
+			"status":            commonpb.AccountStatus_SelfExclude,
+			"selfExcludedUntil": bson.M{"$lte": now},
+		},
+		options.Find().SetProjection(bson.M{"_id": 1}),
+	)
+	if err != nil {
+		return 0, err
+	}
+	defer cursor.Close(ctx)
+
+	var released int64
+	for cursor.Next(ctx) {
+		var user model.UserDetail
+		if err := cursor.Decode(&user); err != nil {
+			continue
+		}
+		ok, err := r.ExpireSelfExclusion(ctx, user.ID, now)
+		if err != nil {
+			return released, err
+		}
+		if ok {
+			released++
+		}
+	}
+	return released, cursor.Err()
+}
+
+// ListSelfExclusions returns exclusion records newest-first.
+func (r *socialRepository) ListSelfExclusions(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.SelfExclusion, string, error) {
+	if nextCursor != "" {
+		lastID, err := primitive.ObjectIDFromHex(nextCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["_id"] = bson.M{"$lt": lastID}
+	}
+
+	findOptions := options.Find().SetLimit(pageSize).SetSort(bson.M{"_id": -1})
+	cursor, err := r.exclusionCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	exclusions := []*model.SelfExclusion{}
+	var lastExclusionID primitive.ObjectID
+	for cursor.Next(ctx) {
+		var exclusion model.SelfExclusion
+		if err := cursor.Decode(&exclusion); err == nil {
+			exclusions = append(exclusions, &exclusion)
+			lastExclusionID = exclusion.ID
+		}
+	}
+
+	nextCursor = ""
+	if len(exclusions) == int(pageSize) {
+		nextCursor = lastExclusionID.Hex()
+	}
+	return exclusions, nextCursor, cursor.Err()
+}
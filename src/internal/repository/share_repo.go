@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+
+	"src/internal/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateShare stores the share and bumps the tip's share count in one
+// transaction. It reports false if the user already shared the tip, which
+// the unique (tipId, sharerId) index detects.
+func (r *socialRepository) CreateShare(ctx context.Context, share *model.Share) (bool, error) {
+	if share.ID.IsZero() {
+		share.ID = primitive.NewObjectID()
+	}
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		if _, err := r.shareCollection.InsertOne(ctx, share); err != nil {
+			return err
+		}
+		_, err := r.tipCollection.UpdateOne(ctx, bson.M{"_id": share.TipID}, bson.M{"$inc": bson.M{"shareCount": 1}})
+		return err
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// GetShare returns the user's share of the tip.
+func (r *socialRepository) GetShare(ctx context.Context, tipID, sharerID primitive.ObjectID) (*model.Share, error) {
+	var share model.Share
+	err := r.shareCollection.FindOne(ctx, bson.M{"tipId": tipID, "sharerId": sharerID}).Decode(&share)
+	if err != nil {
+		return nil, err
+	}
+	return &share, nil
+}
+
+// ListShares returns a page of the shares matching the filter, newest
+// first. The cursor is the hex ID of the last share returned.
+func (r *socialRepository) ListShares(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.Share, string, error) {
+	if nextCursor != "" {
+		lastID, err := primitive.ObjectIDFromHex(nextCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["_id"] = bson.M{"$lt": lastID}
+	}
+
+	findOptions := options.Find().SetLimit(pageSize).SetSort(bson.M{"_id": -1})
+	cursor, err := r.shareCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	shares := []*model.Share{}
+	var lastShareID primitive.ObjectID
+	for cursor.Next(ctx) {
+		var share model.Share
+		if err := cursor.Decode(&share); err == nil {
+			shares = append(shares, &share)
+			lastShareID = share.ID
+		}
+	}
+
+	nextCursor = ""
+	if len(shares) == int(pageSize) {
+		nextCursor = lastShareID.Hex()
+	}
+	return shares, nextCursor, cursor.Err()
+}
+
+// removeShares deletes the matching shares one at a time, dropping the
+// tip's share count only when the share was actually deleted, so the counts
+// stay right when a failed purge is repeated.
+func (r *socialRepository) removeShares(ctx context.Context, filter bson.M) error {
+	cursor, err := r.shareCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"tipId": 1}))
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var share model.Share
+		if err := cursor.Decode(&share); err != nil {
+			return err
+		}
+		result, err := r.shareCollection.DeleteOne(ctx, bson.M{"_id": share.ID})
+		if err != nil {
+			return err
+		}
+		if result.DeletedCount == 0 {
+			continue
+		}
+		_, err = r.tipCollection.UpdateOne(ctx, bson.M{"_id": share.TipID}, bson.M{"$inc": bson.M{"shareCount": -1}})
+		if err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
+}
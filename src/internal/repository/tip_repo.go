@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"fmt"
 	"src/internal/model"
 	"time"
 
@@ -11,45 +12,119 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
-func (r *socialRepository) CreateTip(ctx context.Context, tip *model.Tip) (primitive.ObjectID, error) {
-
-	result, err := r.tipCollection.InsertOne(ctx, tip)
+// CreateTip stores the tip together with the ledger entry recording it.
+// The entry must name the tip, so the tip's ID is set by the caller.
+func (r *socialRepository) CreateTip(ctx context.Context, tip *model.Tip, entry *model.LedgerEntry) (primitive.ObjectID, error) {
+	err := r.withLedgerEntry(ctx, entry, func(ctx mongo.SessionContext) error {
+		_, err := r.tipCollection.InsertOne(ctx, tip)
+		return err
+	})
 	if err != nil {
 		return primitive.NilObjectID, err
 	}
-	return result.InsertedID.(primitive.ObjectID), nil
+	return tip.ID, nil
 }
+
+// GetTip returns the tip unless it was deleted.
 func (r *socialRepository) GetTip(ctx context.Context, tipID primitive.ObjectID) (*model.Tip, error) {
 	var tip model.Tip
-	err := r.tipCollection.FindOne(ctx, bson.M{"_id": tipID}).Decode(&tip)
+	err := r.tipCollection.FindOne(ctx, bson.M{"_id": tipID, "deletedAt": bson.M{"$exists": false}}).Decode(&tip)
 	if err != nil {
 		return nil, err
 	}
 	return &tip, nil
 }
-func (r *socialRepository) UpdateTip(ctx context.Context, tipID primitive.ObjectID, updates bson.M) (*model.Tip, error) {
-	var updatedTip model.Tip
-	err := r.tipCollection.FindOneAndUpdate(
-		ctx,
-		bson.M{"_id": tipID},
-		updates,
-		options.FindOneAndUpdate().SetReturnDocument(options.After),
-	).Decode(&updatedTip)
 
+// UpdateTip applies the updates and records the revision in one
+// transaction. The revision must follow the last one recorded; if another
+// edit got in first or the tip was deleted, nothing is updated and
+// ErrNoDocuments is returned.
+func (r *socialRepository) UpdateTip(ctx context.Context, tipID primitive.ObjectID, updates bson.M, revision *model.TipRevision) (*model.Tip, error) {
+	if revision.ID.IsZero() {
+		revision.ID = primitive.NewObjectID()
+	}
+	// Tips created before revisions were recorded have no count
+	filter := bson.M{"_id": tipID, "revisions": revision.Number - 1, "deletedAt": bson.M{"$exists": false}}
+	if revision.Number == 1 {
+		filter["revisions"] = bson.M{"$in": bson.A{0, nil}}
+	}
+	updates["$inc"] = bson.M{"revisions": 1}
+
+	var updatedTip model.Tip
+	err := r.withTransaction(ctx, func(ctx mongo.SessionContext) error {
+		err := r.tipCollection.FindOneAndUpdate(
+			ctx,
+			filter,
+			updates,
+			options.FindOneAndUpdate().SetReturnDocument(options.After),
+		).Decode(&updatedTip)
+		if err != nil {
+			return err
+		}
+		_, err = r.revisionCollection.InsertOne(ctx, revision)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 	return &updatedTip, nil
 }
-func (r *socialRepository) DeleteTip(ctx context.Context, tipID primitive.ObjectID) error {
-	result, err := r.tipCollection.DeleteOne(ctx, bson.M{"_id": tipID})
+
+// ListTipRevisions returns a page of the tip's revisions, oldest first. The
+// cursor is the hex ID of the last revision returned.
+func (r *socialRepository) ListTipRevisions(ctx context.Context, tipID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.TipRevision, string, error) {
+	filter := bson.M{"tipId": tipID}
+	if nextCursor != "" {
+		lastID, err := primitive.ObjectIDFromHex(nextCursor)
+		if err != nil {
+			return nil, "", err
+		}
+		filter["_id"] = bson.M{"$gt": lastID}
+	}
+
+	findOptions := options.Find().SetLimit(pageSize).SetSort(bson.M{"_id": 1})
+	cursor, err := r.revisionCollection.Find(ctx, filter, findOptions)
 	if err != nil {
-		return err
+		return nil, "", err
+	}
+	defer cursor.Close(ctx)
+
+	revisions := []*model.TipRevision{}
+	for cursor.Next(ctx) {
+		var revision model.TipRevision
+		if err := cursor.Decode(&revision); err != nil {
+			return nil, "", err
+		}
+		revisions = append(revisions, &revision)
 	}
-	if result.DeletedCount == 0 {
-		return mongo.ErrNoDocuments
+
+	nextCursor = ""
+	if len(revisions) == int(pageSize) {
+		nextCursor = revisions[len(revisions)-1].ID.Hex()
 	}
-	return nil
+	return revisions, nextCursor, cursor.Err()
+}
+
+// DeleteTip marks the tip deleted, removes its shares and appends the ledger
+// entry recording the deletion in one transaction. The tip and its
+// revisions are kept for the record. ErrNoDocuments is returned if the tip
+// does not exist or is already deleted.
+func (r *socialRepository) DeleteTip(ctx context.Context, tipID primitive.ObjectID, deletedAt time.Time, entry *model.LedgerEntry) error {
+	return r.withLedgerEntry(ctx, entry, func(ctx mongo.SessionContext) error {
+		result, err := r.tipCollection.UpdateOne(
+			ctx,
+			bson.M{"_id": tipID, "deletedAt": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"deletedAt": deletedAt}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+		_, err = r.shareCollection.DeleteMany(ctx, bson.M{"tipId": tipID})
+		return err
+	})
 }
 func (r *socialRepository) ListTips(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.Tip, primitive.ObjectID, error) {
 	if nextCursor != "" {
@@ -157,16 +232,85 @@ func (r *socialRepository) UnlikeTip(ctx context.Context, tipID, userID primitiv
 	// Compute total unlikes safely
 	return int32(len(updatedTip.Unlikes)), nil
 }
-func (r *socialRepository) ShareTip(ctx context.Context, tipID primitive.ObjectID, shareType string, updatedAt time.Time) error {
-	_, err := r.tipCollection.UpdateOne(
-		ctx,
-		bson.M{"_id": tipID},
-		bson.M{
-			"$set": bson.M{
-				"shareType": shareType,
-				"updatedAt": updatedAt,
-			},
-		},
-	)
-	return err
+
+// SettleTip records the settlement. ErrNoDocuments is returned if the tip
+// does not exist, is deleted or is already settled.
+func (r *socialRepository) SettleTip(ctx context.Context, tipID primitive.ObjectID, settlement *model.Settlement, entry *model.LedgerEntry) error {
+	return r.withLedgerEntry(ctx, entry, func(ctx mongo.SessionContext) error {
+		result, err := r.tipCollection.UpdateOne(
+			ctx,
+			bson.M{"_id": tipID, "settlement": bson.M{"$exists": false}, "deletedAt": bson.M{"$exists": false}},
+			bson.M{"$set": bson.M{"settlement": settlement}},
+		)
+		if err != nil {
+			return err
+		}
+		if result.MatchedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+		return nil
+	})
+}
+
+// SettleTipLeg records the result of an accumulator leg, and the
+// accumulator's settlement with its ledger entry when the leg decides it.
+// legResults are the results of the legs as they were read; if any changed
+// since, or the tip was settled or deleted, nothing is updated and
+// ErrNoDocuments is returned.
+func (r *socialRepository) SettleTipLeg(ctx context.Context, tipID primitive.ObjectID, legResults []string, leg int, result string, settlement *model.Settlement, entry *model.LedgerEntry) error {
+	filter := bson.M{"_id": tipID, "settlement": bson.M{"$exists": false}, "deletedAt": bson.M{"$exists": false}}
+	for i, legResult := range legResults {
+		field := fmt.Sprintf("selection.legs.%d.result", i)
+		if legResult == "" {
+			filter[field] = bson.M{"$exists": false}
+		} else {
+			filter[field] = legResult
+		}
+	}
+	set := bson.M{fmt.Sprintf("selection.legs.%d.result", leg): result}
+	update := func(ctx context.Context) error {
+		updated, err := r.tipCollection.UpdateOne(ctx, filter, bson.M{"$set": set})
+		if err != nil {
+			return err
+		}
+		if updated.MatchedCount == 0 {
+			return mongo.ErrNoDocuments
+		}
+		return nil
+	}
+	if settlement == nil {
+		return update(ctx)
+	}
+
+	set["settlement"] = settlement
+	return r.withLedgerEntry(ctx, entry, func(ctx mongo.SessionContext) error {
+		return update(ctx)
+	})
+}
+
+// ForEachSettledTip calls fn with every settled tip matching the filter,
+// ordered by tipster and then by event start. Only the tipster, tags,
+// selection and settlement are loaded. Deleted tips are included, deleting a
+// settled tip does not take it off the tipster's record.
+func (r *socialRepository) ForEachSettledTip(ctx context.Context, filter bson.M, fn func(tip *model.Tip) error) error {
+	filter["settlement"] = bson.M{"$exists": true}
+	findOptions := options.Find().
+		SetSort(bson.D{{Key: "tipsterId", Value: 1}, {Key: "selection.eventStart", Value: 1}}).
+		SetProjection(bson.M{"tipsterId": 1, "tags": 1, "selection": 1, "settlement": 1})
+	cursor, err := r.tipCollection.Find(ctx, filter, findOptions)
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var tip model.Tip
+		if err := cursor.Decode(&tip); err != nil {
+			return err
+		}
+		if err := fn(&tip); err != nil {
+			return err
+		}
+	}
+	return cursor.Err()
 }
@@ -4,8 +4,10 @@ import (
 	"context"
 	"time"
 
+	"src/internal/conf"
 	"src/internal/errors"
 	"src/internal/model"
+	commonpb "src/protos/YM.Common"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson"
@@ -17,62 +19,155 @@ import (
 type SocialRepository interface {
 	CreateUser(ctx context.Context, user *model.User) (string, error)
 	GetUser(ctx context.Context, userID primitive.ObjectID) (*model.User, error)
+	GetUserByEmail(ctx context.Context, email string) (*model.User, error)
+	GetUserByIdentity(ctx context.Context, key string) (*model.User, error)
+	AddIdentity(ctx context.Context, userID primitive.ObjectID, identity model.Identity) error
+	UpdatePassword(ctx context.Context, userID primitive.ObjectID, passwordHash string, updatedAt time.Time) error
+	UpdateRole(ctx context.Context, userID primitive.ObjectID, role string, updatedAt time.Time) error
+	UpdateStatus(ctx context.Context, userID primitive.ObjectID, from, to commonpb.AccountStatus, reason string, updatedAt time.Time) error
 	GetUserDetails(ctx context.Context, userIDs []primitive.ObjectID) ([]*model.UserDetail, error)
 	UpdateUser(ctx context.Context, userID primitive.ObjectID, updates *model.UserUpdates) error
-	DeleteUser(ctx context.Context, userID primitive.ObjectID) error
+	MarkUserDeleted(ctx context.Context, userID primitive.ObjectID, deletion *model.UserDeletion) error
+	RestoreDeletedUser(ctx context.Context, userID primitive.ObjectID, status commonpb.AccountStatus, restoredAt time.Time) error
+	ListUsersDueForPurge(ctx context.Context, now time.Time, limit int64) ([]primitive.ObjectID, error)
+	PurgeUser(ctx context.Context, userID primitive.ObjectID, removeContent bool, purgedAt time.Time) error
 	ListUsers(ctx context.Context, filter bson.M, limit int64) ([]*model.User, error)
-	FollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) error
+	FollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) (bool, error)
 	UnfollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) error
-	CreateTip(ctx context.Context, tip *model.Tip) (primitive.ObjectID, error)
+	ListFollowers(ctx context.Context, userID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error)
+	ListFollowing(ctx context.Context, userID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.UserDetail, string, error)
+	IsFollowing(ctx context.Context, followerID, followeeID primitive.ObjectID) (bool, error)
+	ListFolloweeIDs(ctx context.Context, userID primitive.ObjectID) ([]primitive.ObjectID, error)
+	CreateFollowRequest(ctx context.Context, request *model.FollowRequest) (bool, error)
+	GetFollowRequest(ctx context.Context, requestID primitive.ObjectID) (*model.FollowRequest, error)
+	ApproveFollowRequest(ctx context.Context, request *model.FollowRequest) error
+	RejectFollowRequest(ctx context.Context, requestID primitive.ObjectID) error
+	CancelFollowRequest(ctx context.Context, requesterID, targetID primitive.ObjectID) error
+	ListFollowRequests(ctx context.Context, targetID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.FollowRequest, string, error)
+	AddRestriction(ctx context.Context, restriction *model.Restriction) error
+	BlockUser(ctx context.Context, restriction *model.Restriction) error
+	RemoveRestriction(ctx context.Context, ownerID, targetID primitive.ObjectID, kind string) error
+	IsBlocked(ctx context.Context, ownerID, targetID primitive.ObjectID) (bool, error)
+	ListRestrictedUserIDs(ctx context.Context, ownerID primitive.ObjectID) ([]primitive.ObjectID, error)
+	CreateTip(ctx context.Context, tip *model.Tip, entry *model.LedgerEntry) (primitive.ObjectID, error)
 	GetTip(ctx context.Context, tipID primitive.ObjectID) (*model.Tip, error)
-	UpdateTip(ctx context.Context, tipID primitive.ObjectID, updates bson.M) (*model.Tip, error)
-	DeleteTip(ctx context.Context, tipID primitive.ObjectID) error
+	UpdateTip(ctx context.Context, tipID primitive.ObjectID, updates bson.M, revision *model.TipRevision) (*model.Tip, error)
+	ListTipRevisions(ctx context.Context, tipID primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.TipRevision, string, error)
+	DeleteTip(ctx context.Context, tipID primitive.ObjectID, deletedAt time.Time, entry *model.LedgerEntry) error
 	ListTips(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.Tip, primitive.ObjectID, error)
 	LikeTip(ctx context.Context, tipID, userID primitive.ObjectID) (int32, error)
 	UnlikeTip(ctx context.Context, tipID, userID primitive.ObjectID) (int32, error)
-	ShareTip(ctx context.Context, tipID primitive.ObjectID, shareType string, updatedAt time.Time) error
+	SettleTip(ctx context.Context, tipID primitive.ObjectID, settlement *model.Settlement, entry *model.LedgerEntry) error
+	SettleTipLeg(ctx context.Context, tipID primitive.ObjectID, legResults []string, leg int, result string, settlement *model.Settlement, entry *model.LedgerEntry) error
+	ForEachLedgerEntry(ctx context.Context, tipsterID string, fn func(entry *model.LedgerEntry) error) error
+	ForEachSettledTip(ctx context.Context, filter bson.M, fn func(tip *model.Tip) error) error
+	CreateShare(ctx context.Context, share *model.Share) (bool, error)
+	GetShare(ctx context.Context, tipID, sharerID primitive.ObjectID) (*model.Share, error)
+	ListShares(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.Share, string, error)
+	InsertLeaderboardEntries(ctx context.Context, entries []*model.LeaderboardEntry) error
+	PublishLeaderboardSnapshot(ctx context.Context, snapshot *model.LeaderboardSnapshot) error
+	DeleteLeaderboardEntries(ctx context.Context, snapshotID primitive.ObjectID) error
+	GetLatestLeaderboardSnapshot(ctx context.Context, window string) (*model.LeaderboardSnapshot, error)
+	GetLeaderboardSnapshot(ctx context.Context, snapshotID primitive.ObjectID) (*model.LeaderboardSnapshot, error)
+	ListLeaderboardEntries(ctx context.Context, snapshotID primitive.ObjectID, filter bson.M, sortField string, offset, limit int64) ([]*model.LeaderboardEntry, error)
 	CreateComment(ctx context.Context, comment *model.Comment) (primitive.ObjectID, error)
 	UpdateComment(ctx context.Context, commentID primitive.ObjectID, content string, updatedAt time.Time) error
+	GetComment(ctx context.Context, commentID primitive.ObjectID) (*model.Comment, error)
 	DeleteComment(ctx context.Context, commentID primitive.ObjectID) error
-	ListTipComments(ctx context.Context, tipID string) ([]*model.Comment, error)
+	ListTipComments(ctx context.Context, tipID string, excludeUserIDs []string) ([]*model.Comment, error)
 	LikeComment(ctx context.Context, commentID, userID primitive.ObjectID) (int32, error)
 	UnlikeComment(ctx context.Context, commentID, userID primitive.ObjectID) (int32, error)
 	CreateReply(ctx context.Context, reply *model.Comment) (primitive.ObjectID, error)
-	ListReplies(ctx context.Context, parentCommentID string) ([]*model.Comment, error)
-	ListComments(ctx context.Context, pageSize int64, nextCursor string) ([]*model.Comment, string, error)
+	ListReplies(ctx context.Context, parentCommentID string, excludeUserIDs []string) ([]*model.Comment, error)
+	ListComments(ctx context.Context, excludeUserIDs []string, pageSize int64, nextCursor string) ([]*model.Comment, string, error)
+	CreateFeedEvent(ctx context.Context, event *model.FeedEvent) (primitive.ObjectID, error)
+	ListFollowingFeed(ctx context.Context, userID primitive.ObjectID, excludeAuthorIDs []primitive.ObjectID, pageSize int64, nextCursor string) ([]*model.FeedEvent, string, error)
+	StartSelfExclusion(ctx context.Context, exclusion *model.SelfExclusion) error
+	ExpireSelfExclusion(ctx context.Context, userID primitive.ObjectID, now time.Time) (bool, error)
+	ExpireSelfExclusions(ctx context.Context, now time.Time) (int64, error)
+	ListSelfExclusions(ctx context.Context, filter bson.M, pageSize int64, nextCursor string) ([]*model.SelfExclusion, string, error)
 }
 
 type socialRepository struct {
-	collection        *mongo.Collection
-	tipCollection     *mongo.Collection
-	commentCollection *mongo.Collection
-	logger            log.Logger
+	collection            *mongo.Collection
+	tipCollection         *mongo.Collection
+	commentCollection     *mongo.Collection
+	feedCollection        *mongo.Collection
+	timelineCollection    *mongo.Collection
+	exclusionCollection   *mongo.Collection
+	followCollection      *mongo.Collection
+	restrictCollection    *mongo.Collection
+	requestCollection     *mongo.Collection
+	shareCollection       *mongo.Collection
+	revisionCollection    *mongo.Collection
+	ledgerCollection      *mongo.Collection
+	snapshotCollection    *mongo.Collection
+	leaderboardCollection *mongo.Collection
+	fanoutThreshold       int64
+	backfillLimit         int64
+	logger                log.Logger
 }
 
-func NewSocialRepository(db *mongo.Database, logger log.Logger) SocialRepository {
+func NewSocialRepository(db *mongo.Database, feedConf *conf.Feed, logger log.Logger) SocialRepository {
 	collection := db.Collection("users")
 	tipCollection := db.Collection("tips")
 	commentCollection := db.Collection("comments")
+	feedCollection := db.Collection("feed_events")
+	timelineCollection := db.Collection("timelines")
+	exclusionCollection := db.Collection("self_exclusions")
+	followCollection := db.Collection("follows")
+	restrictCollection := db.Collection("restrictions")
+	requestCollection := db.Collection("follow_requests")
+	shareCollection := db.Collection("shares")
+	revisionCollection := db.Collection("tip_revisions")
+	ledgerCollection := db.Collection("tip_ledger")
+	snapshotCollection := db.Collection("leaderboard_snapshots")
+	leaderboardCollection := db.Collection("leaderboard_entries")
+
+	fanoutThreshold := feedConf.GetFanoutFollowerThreshold()
+	if fanoutThreshold <= 0 {
+		fanoutThreshold = defaultFanoutFollowerThreshold
+	}
+	backfillLimit := feedConf.GetBackfillLimit()
+	if backfillLimit <= 0 {
+		backfillLimit = defaultFeedBackfillLimit
+	}
 
 	return &socialRepository{
-		collection:        collection,
-		tipCollection:     tipCollection,
-		commentCollection: commentCollection,
-		logger:            logger,
+		collection:            collection,
+		tipCollection:         tipCollection,
+		commentCollection:     commentCollection,
+		feedCollection:        feedCollection,
+		timelineCollection:    timelineCollection,
+		exclusionCollection:   exclusionCollection,
+		followCollection:      followCollection,
+		restrictCollection:    restrictCollection,
+		requestCollection:     requestCollection,
+		shareCollection:       shareCollection,
+		revisionCollection:    revisionCollection,
+		ledgerCollection:      ledgerCollection,
+		snapshotCollection:    snapshotCollection,
+		leaderboardCollection: leaderboardCollection,
+		fanoutThreshold:       fanoutThreshold,
+		backfillLimit:         backfillLimit,
+		logger:                logger,
 	}
 }
 
 func (r *socialRepository) CreateUser(ctx context.Context, user *model.User) (string, error) {
-	filter := bson.M{"email": user.Email}
-	var result model.User
-	err := r.collection.FindOne(ctx, filter).Decode(&result)
-	if err == nil {
-		return "exist", nil
+	// Accounts created from an identity without a verified email have none
+	if user.Email != "" {
+		filter := bson.M{"email": user.Email}
+		var result model.User
+		err := r.collection.FindOne(ctx, filter).Decode(&result)
+		if err == nil {
+			return "exist", nil
+		}
 	}
 	if user.ID.IsZero() {
 		user.ID = primitive.NewObjectID()
 	}
-	_, err = r.collection.InsertOne(ctx, user)
+	_, err := r.collection.InsertOne(ctx, user)
 	if err != nil {
 		return "", err
 	}
@@ -88,6 +183,113 @@ func (r *socialRepository) GetUser(ctx context.Context, userID primitive.ObjectI
 	return &user, nil
 }
 
+func (r *socialRepository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
+	var user model.User
+	err := r.collection.FindOne(ctx, bson.M{"email": email}).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (r *socialRepository) GetUserByIdentity(ctx context.Context, key string) (*model.User, error) {
+	var user model.User
+	err := r.collection.FindOne(ctx, bson.M{"identities.key": key}).Decode(&user)
+	if err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// AddIdentity links the identity to the user. Each provider can be linked
+// once per user; ErrNoDocuments is returned if the provider is already
+// linked, and a duplicate key error if the identity belongs to another user.
+func (r *socialRepository) AddIdentity(ctx context.Context, userID primitive.ObjectID, identity model.Identity) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{
+			"_id":                 userID,
+			"identities.provider": bson.M{"$ne": identity.Provider},
+		},
+		bson.M{
+			"$push": bson.M{"identities": identity},
+			"$set":  bson.M{"updatedAt": identity.LinkedAt},
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *socialRepository) UpdatePassword(ctx context.Context, userID primitive.ObjectID, passwordHash string, updatedAt time.Time) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": userID},
+		bson.M{"$set": bson.M{
+			"password":  passwordHash,
+			"updatedAt": updatedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+func (r *socialRepository) UpdateRole(ctx context.Context, userID primitive.ObjectID, role string, updatedAt time.Time) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": userID},
+		bson.M{"$set": bson.M{
+			"role":      role,
+			"updatedAt": updatedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
+// UpdateStatus moves the user from one status to another. The update only
+// applies while the user is still in the from status, so concurrent changes
+// cannot skip the transition rules; in that case ErrNoDocuments is returned.
+func (r *socialRepository) UpdateStatus(ctx context.Context, userID primitive.ObjectID, from, to commonpb.AccountStatus, reason string, updatedAt time.Time) error {
+	filter := bson.M{"_id": userID, "status": from}
+	if from == commonpb.AccountStatus_AccountStatusNone {
+		// Users stored before statuses existed have no status field
+		filter["status"] = bson.M{"$in": bson.A{from, nil}}
+	}
+
+	result, err := r.collection.UpdateOne(
+		ctx,
+		filter,
+		bson.M{"$set": bson.M{
+			"status":          to,
+			"statusReason":    reason,
+			"statusChangedAt": updatedAt,
+			"updatedAt":       updatedAt,
+		}},
+	)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+	return nil
+}
+
 func (r *socialRepository) GetUserDetails(ctx context.Context, userIDs []primitive.ObjectID) ([]*model.UserDetail, error) {
 	if len(userIDs) == 0 {
 		return []*model.UserDetail{}, nil
@@ -126,17 +328,6 @@ func (r *socialRepository) UpdateUser(ctx context.Context, userID primitive.Obje
 	return nil
 }
 
-func (r *socialRepository) DeleteUser(ctx context.Context, userID primitive.ObjectID) error {
-	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": userID})
-	if err != nil {
-		return err
-	}
-	if result.DeletedCount == 0 {
-		return mongo.ErrNoDocuments
-	}
-	return nil
-}
-
 func (r *socialRepository) ListUsers(ctx context.Context, filter bson.M, limit int64) ([]*model.User, error) {
 	options := options.Find().SetLimit(limit).SetSort(bson.M{"_id": 1})
 	cursor, err := r.collection.Find(ctx, filter, options)
@@ -155,44 +346,17 @@ func (r *socialRepository) ListUsers(ctx context.Context, filter bson.M, limit i
 	return users, cursor.Err()
 }
 
-func (r *socialRepository) FollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) error {
-	// Update user's following list
-	userUpdate := bson.M{
-		"$addToSet": bson.M{
-			"following": tipsterID,
-		},
-	}
-	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, userUpdate)
+// withTransaction runs fn in a MongoDB transaction, retrying it on transient
+// errors. Transactions need a replica set or a sharded cluster.
+func (r *socialRepository) withTransaction(ctx context.Context, fn func(ctx mongo.SessionContext) error) error {
+	session, err := r.collection.Database().Client().StartSession()
 	if err != nil {
 		return err
 	}
+	defer session.EndSession(ctx)
 
-	// Update tipster's followers list
-	tipsterUpdate := bson.M{
-		"$addToSet": bson.M{
-			"followers": userID,
-		},
-	}
-	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": tipsterID}, tipsterUpdate)
-	return err
-}
-
-func (r *socialRepository) UnfollowTipster(ctx context.Context, userID, tipsterID primitive.ObjectID) error {
-
-	userUpdate := bson.M{
-		"$pull": bson.M{
-			"following": tipsterID,
-		},
-	}
-	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": userID}, userUpdate)
-	if err != nil {
-		return err
-	}
-	tipsterUpdate := bson.M{
-		"$pull": bson.M{
-			"followers": userID,
-		},
-	}
-	_, err = r.collection.UpdateOne(ctx, bson.M{"_id": tipsterID}, tipsterUpdate)
+	_, err = session.WithTransaction(ctx, func(ctx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(ctx)
+	})
 	return err
 }
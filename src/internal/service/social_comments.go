@@ -3,11 +3,11 @@ package service
 import (
 	"context"
 
+	"src/internal/errors"
 	pb "src/protos/Tipster"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func (s *SocialServiceService) CommentOnTip(ctx context.Context, req *pb.CommentOnTipRequest) (*pb.CommentOnTipResponse, error) {
@@ -21,6 +21,15 @@ func (s *SocialServiceService) CommentOnTip(ctx context.Context, req *pb.Comment
 
 	data, err := s.biz.CreateComment(ctx, req)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.CommentOnTipResponse{
+				Code: CodeNotFound,
+				Msg:  "Tip not found",
+			}, nil
+		}
 		s.logger.Log(log.LevelError, "failed to create comment", "error", err)
 		return &pb.CommentOnTipResponse{
 			Code: CodeError,
@@ -46,7 +55,10 @@ func (s *SocialServiceService) UpdateComment(ctx context.Context, req *pb.Update
 	// Attempt to update the comment
 	err = s.biz.UpdateComment(ctx, commentID, req)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.UpdateCommentResponse{
 				Code: CodeNotFound,
 				Msg:  "Comment not found",
@@ -77,7 +89,10 @@ func (s *SocialServiceService) DeleteComment(ctx context.Context, req *pb.Delete
 	// Attempt to delete the comment
 	err = s.biz.DeleteComment(ctx, commentID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.DeleteCommentResponse{
 				Code: CodeNotFound,
 				Msg:  "Comment not found",
@@ -106,6 +121,15 @@ func (s *SocialServiceService) ListTipComments(ctx context.Context, req *pb.List
 	// Fetch comments
 	comments, err := s.biz.ListTipComments(ctx, req.TipId)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ListTipCommentsResponse{
+				Code: CodeNotFound,
+				Msg:  "Tip not found",
+			}, nil
+		}
 		s.logger.Log(log.LevelError, "failed to fetch comments", "error", err)
 		return &pb.ListTipCommentsResponse{
 			Code: CodeError,
@@ -127,10 +151,10 @@ func (s *SocialServiceService) ListTipComments(ctx context.Context, req *pb.List
 	}, nil
 }
 func (s *SocialServiceService) LikeComment(ctx context.Context, req *pb.LikeCommentRequest) (*pb.LikeCommentResponse, error) {
-	if req.CommentId == "" || req.UserId == "" {
+	if req.CommentId == "" {
 		return &pb.LikeCommentResponse{
 			Code: CodeInvalid,
-			Msg:  "Comment ID and User ID are required",
+			Msg:  "Comment ID is required",
 		}, nil
 	}
 	commentID, err := primitive.ObjectIDFromHex(req.CommentId)
@@ -141,18 +165,13 @@ func (s *SocialServiceService) LikeComment(ctx context.Context, req *pb.LikeComm
 		}, nil
 	}
 
-	userID, err := primitive.ObjectIDFromHex(req.UserId)
-	if err != nil {
-		return &pb.LikeCommentResponse{
-			Code: CodeInvalid,
-			Msg:  "Invalid user ID format",
-		}, nil
-	}
-
 	// Attempt to like the comment
-	totalLikes, err := s.biz.LikeComment(ctx, commentID, userID)
+	totalLikes, err := s.biz.LikeComment(ctx, commentID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.LikeCommentResponse{
 				Code: CodeNotFound,
 				Msg:  "Comment not found",
@@ -175,10 +194,10 @@ func (s *SocialServiceService) LikeComment(ctx context.Context, req *pb.LikeComm
 	}, nil
 }
 func (s *SocialServiceService) UnlikeComment(ctx context.Context, req *pb.UnlikeCommentRequest) (*pb.UnlikeCommentResponse, error) {
-	if req.CommentId == "" || req.UserId == "" {
+	if req.CommentId == "" {
 		return &pb.UnlikeCommentResponse{
 			Code: CodeInvalid,
-			Msg:  "Comment ID and User ID are required",
+			Msg:  "Comment ID is required",
 		}, nil
 	}
 
@@ -190,18 +209,13 @@ func (s *SocialServiceService) UnlikeComment(ctx context.Context, req *pb.Unlike
 		}, nil
 	}
 
-	userID, err := primitive.ObjectIDFromHex(req.UserId)
-	if err != nil {
-		return &pb.UnlikeCommentResponse{
-			Code: CodeInvalid,
-			Msg:  "Invalid user ID format",
-		}, nil
-	}
-
 	// Attempt to unlike the comment
-	totalUnlikes, err := s.biz.UnlikeComment(ctx, commentID, userID)
+	totalUnlikes, err := s.biz.UnlikeComment(ctx, commentID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.UnlikeCommentResponse{
 				Code: CodeNotFound,
 				Msg:  "Comment not found",
@@ -232,16 +246,18 @@ func (s *SocialServiceService) ReplyComment(ctx context.Context, req *pb.ReplyCo
 			Msg:  "Invalid parent comment ID format",
 		}, nil
 	}
-	_, err = primitive.ObjectIDFromHex(req.UserId)
-	if err != nil {
-		return &pb.ReplyCommentResponse{
-			Code: CodeInvalid,
-			Msg:  "Invalid user ID format",
-		}, nil
-	}
 
 	data, err := s.biz.CreateReply(ctx, req)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ReplyCommentResponse{
+				Code: CodeNotFound,
+				Msg:  "Parent comment not found",
+			}, nil
+		}
 		s.logger.Log(log.LevelError, "failed to create reply", "error", err)
 		return &pb.ReplyCommentResponse{
 			Code: CodeError,
@@ -265,6 +281,15 @@ func (s *SocialServiceService) ListCommentReplies(ctx context.Context, req *pb.L
 
 	replies, err := s.biz.ListReplies(ctx, req.ParentCommentId)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ListCommentRepliesResponse{
+				Code: CodeNotFound,
+				Msg:  "Comment not found",
+			}, nil
+		}
 		s.logger.Log(log.LevelError, "failed to fetch replies", "error", err)
 		return &pb.ListCommentRepliesResponse{
 			Code: CodeError,
@@ -295,10 +320,14 @@ func (s *SocialServiceService) ListComments(ctx context.Context, req *pb.ListCom
 
 	comments, nextCursor, err := s.biz.ListComments(ctx, pageSize, req.NextCursor)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to fetch comments", "error", err)
 		return &pb.ListCommentsResponse{
 			Code: CodeError,
 			Msg:  "Database error",
-		}, err
+		}, nil
 	}
 
 	pbComments, err := s.likeTransformer(ctx, comments)
@@ -318,6 +347,30 @@ func (s *SocialServiceService) ListComments(ctx context.Context, req *pb.ListCom
 	}, nil
 }
 
-// func (s *SocialServiceService) ListFollowingFeed(ctx context.Context, req *pb.ListFollowingFeedRequest) (*pb.ListFollowingFeedResponse, error) {
-// 	return &pb.ListFollowingFeedResponse{}, nil
-// }
+func (s *SocialServiceService) ListFollowingFeed(ctx context.Context, req *pb.ListFollowingFeedRequest) (*pb.ListFollowingFeedResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	events, nextCursor, err := s.biz.ListFollowingFeed(ctx, pageSize, req.NextCursor)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to fetch following feed", "error", err)
+		return &pb.ListFollowingFeedResponse{
+			Code: CodeError,
+			Msg:  "Failed to fetch following feed",
+		}, nil
+	}
+
+	return &pb.ListFollowingFeedResponse{
+		Code: CodeOk,
+		Msg:  "Feed retrieved successfully",
+		Data: &pb.ListFollowingFeedResponse_ListFollowingFeedData{
+			Items:      s.feedTransformer(events),
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
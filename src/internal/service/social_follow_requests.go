@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+
+	"src/internal/errors"
+	pb "src/protos/Tipster"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *SocialServiceService) ListFollowRequests(ctx context.Context, req *pb.ListFollowRequestsRequest) (*pb.ListFollowRequestsResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	requests, nextCursor, err := s.biz.ListFollowRequests(ctx, pageSize, req.NextCursor)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to list follow requests", "error", err)
+		return &pb.ListFollowRequestsResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list follow requests",
+		}, nil
+	}
+
+	data, err := s.followRequestsTransformer(ctx, requests, nextCursor)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListFollowRequestsResponse{
+		Code: CodeOk,
+		Msg:  "Follow requests retrieved successfully",
+		Data: data,
+	}, nil
+}
+
+func (s *SocialServiceService) ApproveFollowRequest(ctx context.Context, req *pb.ApproveFollowRequestRequest) (*pb.ApproveFollowRequestResponse, error) {
+	requestID, err := primitive.ObjectIDFromHex(req.RequestId)
+	if err != nil {
+		return &pb.ApproveFollowRequestResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid request ID format",
+		}, nil
+	}
+
+	err = s.biz.ApproveFollowRequest(ctx, requestID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.ApproveFollowRequestResponse{
+				Code: CodeNotFound,
+				Msg:  "Follow request not found",
+			}, nil
+		}
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to approve follow request", "error", err)
+		return &pb.ApproveFollowRequestResponse{
+			Code: CodeError,
+			Msg:  "Failed to approve follow request",
+		}, nil
+	}
+	return &pb.ApproveFollowRequestResponse{
+		Code: CodeOk,
+		Msg:  "Follow request approved successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) RejectFollowRequest(ctx context.Context, req *pb.RejectFollowRequestRequest) (*pb.RejectFollowRequestResponse, error) {
+	requestID, err := primitive.ObjectIDFromHex(req.RequestId)
+	if err != nil {
+		return &pb.RejectFollowRequestResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid request ID format",
+		}, nil
+	}
+
+	err = s.biz.RejectFollowRequest(ctx, requestID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.RejectFollowRequestResponse{
+				Code: CodeNotFound,
+				Msg:  "Follow request not found",
+			}, nil
+		}
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to reject follow request", "error", err)
+		return &pb.RejectFollowRequestResponse{
+			Code: CodeError,
+			Msg:  "Failed to reject follow request",
+		}, nil
+	}
+	return &pb.RejectFollowRequestResponse{
+		Code: CodeOk,
+		Msg:  "Follow request rejected successfully",
+	}, nil
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+
+	"src/internal/errors"
+	pb "src/protos/Tipster"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *SocialServiceService) BlockUser(ctx context.Context, req *pb.BlockUserRequest) (*pb.BlockUserResponse, error) {
+	targetID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.BlockUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.BlockUser(ctx, targetID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.BlockUserResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		if errors.IsAccessDenied(err) || err == errors.ErrCannotRestrictSelf {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to block user", "error", err)
+		return &pb.BlockUserResponse{
+			Code: CodeError,
+			Msg:  "Failed to block user",
+		}, nil
+	}
+	return &pb.BlockUserResponse{
+		Code: CodeOk,
+		Msg:  "User blocked successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) UnblockUser(ctx context.Context, req *pb.UnblockUserRequest) (*pb.UnblockUserResponse, error) {
+	targetID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.UnblockUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.UnblockUser(ctx, targetID)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to unblock user", "error", err)
+		return &pb.UnblockUserResponse{
+			Code: CodeError,
+			Msg:  "Failed to unblock user",
+		}, nil
+	}
+	return &pb.UnblockUserResponse{
+		Code: CodeOk,
+		Msg:  "User unblocked successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) MuteUser(ctx context.Context, req *pb.MuteUserRequest) (*pb.MuteUserResponse, error) {
+	targetID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.MuteUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.MuteUser(ctx, targetID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.MuteUserResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		if errors.IsAccessDenied(err) || err == errors.ErrCannotRestrictSelf {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to mute user", "error", err)
+		return &pb.MuteUserResponse{
+			Code: CodeError,
+			Msg:  "Failed to mute user",
+		}, nil
+	}
+	return &pb.MuteUserResponse{
+		Code: CodeOk,
+		Msg:  "User muted successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) UnmuteUser(ctx context.Context, req *pb.UnmuteUserRequest) (*pb.UnmuteUserResponse, error) {
+	targetID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.UnmuteUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.UnmuteUser(ctx, targetID)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to unmute user", "error", err)
+		return &pb.UnmuteUserResponse{
+			Code: CodeError,
+			Msg:  "Failed to unmute user",
+		}, nil
+	}
+	return &pb.UnmuteUserResponse{
+		Code: CodeOk,
+		Msg:  "User unmuted successfully",
+	}, nil
+}
@@ -0,0 +1,65 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"src/internal/errors"
+	pb "src/protos/Tipster"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+func (s *SocialServiceService) SelfExclude(ctx context.Context, req *pb.SelfExcludeRequest) (*pb.SelfExcludeResponse, error) {
+	if req.Period == pb.SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_UNSPECIFIED {
+		return &pb.SelfExcludeResponse{
+			Code: CodeInvalid,
+			Msg:  "Self-exclusion period is required",
+		}, nil
+	}
+
+	period := strings.TrimPrefix(req.Period.String(), "SELF_EXCLUSION_PERIOD_")
+	exclusion, err := s.biz.SelfExclude(ctx, period)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+
+	s.logger.Log(log.LevelInfo, "user self-excluded", "user_id", exclusion.UserID.Hex(), "period", exclusion.Period)
+	return &pb.SelfExcludeResponse{
+		Code: CodeOk,
+		Msg:  "Self-exclusion started successfully",
+		Data: selfExclusionTransformer(exclusion),
+	}, nil
+}
+
+func (s *SocialServiceService) ListSelfExclusions(ctx context.Context, req *pb.ListSelfExclusionsRequest) (*pb.ListSelfExclusionsResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	exclusions, nextCursor, err := s.biz.ListSelfExclusions(ctx, req.UserId, pageSize, req.NextCursor)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to list self-exclusions", "error", err)
+		return &pb.ListSelfExclusionsResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list self-exclusions",
+		}, nil
+	}
+
+	data := make([]*pb.SelfExclusionData, 0, len(exclusions))
+	for _, exclusion := range exclusions {
+		data = append(data, selfExclusionTransformer(exclusion))
+	}
+	return &pb.ListSelfExclusionsResponse{
+		Code: CodeOk,
+		Msg:  "Self-exclusions retrieved successfully",
+		Data: &pb.ListSelfExclusionsResponse_ListSelfExclusionsData{
+			Exclusions: data,
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
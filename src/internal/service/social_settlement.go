@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	"src/internal/errors"
+	pb "src/protos/Tipster"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func (s *SocialServiceService) SettleTip(ctx context.Context, req *pb.SettleTipRequest) (*pb.SettleTipResponse, error) {
+	tipID, err := primitive.ObjectIDFromHex(req.TipId)
+	if err != nil {
+		return &pb.SettleTipResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid tip ID format",
+		}, nil
+	}
+	if _, ok := pb.TipResult_name[int32(req.Result)]; !ok || req.Result == pb.TipResult_TIP_RESULT_UNSPECIFIED {
+		return &pb.SettleTipResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid result",
+		}, nil
+	}
+
+	tip, err := s.biz.SettleTip(ctx, tipID, req.Result, int(req.Leg))
+	if err != nil {
+		if errors.IsAccessDenied(err) || err == errors.ErrNoSelection || err == errors.ErrTipSettled || err == errors.ErrLegSettled ||
+			err == errors.ErrEventNotStarted || err == errors.ErrSettlementConflict {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsInvalid(err) {
+			return &pb.SettleTipResponse{
+				Code: CodeInvalid,
+				Msg:  errors.Message(err),
+			}, nil
+		}
+		if errors.IsNotFound(err) {
+			return &pb.SettleTipResponse{
+				Code: CodeNotFound,
+				Msg:  "Tip not found",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to settle tip", "error", err)
+		return &pb.SettleTipResponse{
+			Code: CodeError,
+			Msg:  "Failed to settle tip",
+		}, nil
+	}
+
+	legResults := make([]pb.TipResult, 0, len(tip.Selection.Legs))
+	for _, leg := range tip.Selection.Legs {
+		legResults = append(legResults, pb.TipResult(pb.TipResult_value["TIP_RESULT_"+leg.Result]))
+	}
+	msg := "Tip settled successfully"
+	if tip.Settlement == nil {
+		msg = "Leg settled successfully"
+	}
+	return &pb.SettleTipResponse{
+		Code:       CodeOk,
+		Msg:        msg,
+		Data:       settlementTransformer(tip.Settlement),
+		LegResults: legResults,
+	}, nil
+}
+
+func (s *SocialServiceService) ListUnsettledTips(ctx context.Context, req *pb.ListUnsettledTipsRequest) (*pb.ListUnsettledTipsResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	if !validOddsFormat(req.OddsFormat) {
+		return &pb.ListUnsettledTipsResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid odds format",
+		}, nil
+	}
+
+	tips, lastTipID, err := s.biz.ListUnsettledTips(ctx, req, pageSize)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		s.logger.Log(log.LevelError, "failed to list unsettled tips", "error", err)
+		return &pb.ListUnsettledTipsResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list unsettled tips",
+		}, nil
+	}
+
+	data, err := s.tipsTransformer(ctx, tips, pageSize, lastTipID, req.OddsFormat)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ListUnsettledTipsResponse{
+		Code: CodeOk,
+		Msg:  "Unsettled tips retrieved successfully",
+		Data: data,
+	}, nil
+}
@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+
+	"src/internal/errors"
+	pb "src/protos/Tipster"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *SocialServiceService) GetTipsterStats(ctx context.Context, req *pb.GetTipsterStatsRequest) (*pb.GetTipsterStatsResponse, error) {
+	tipsterID, err := primitive.ObjectIDFromHex(req.TipsterId)
+	if err != nil {
+		return &pb.GetTipsterStatsResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid tipster ID format",
+		}, nil
+	}
+	start, end := req.DateRange.GetDateStart(), req.DateRange.GetDateEnd()
+	if start.IsValid() && end.IsValid() && !start.AsTime().Before(end.AsTime()) {
+		return &pb.GetTipsterStatsResponse{
+			Code: CodeInvalid,
+			Msg:  "Date range must start before it ends",
+		}, nil
+	}
+
+	stats, err := s.biz.GetTipsterStats(ctx, tipsterID, req)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.GetTipsterStatsResponse{
+				Code: CodeNotFound,
+				Msg:  "Tipster not found",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to compute tipster stats", "error", err)
+		return &pb.GetTipsterStatsResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to compute tipster stats",
+		}, nil
+	}
+
+	return &pb.GetTipsterStatsResponse{
+		Code: CodeOk,
+		Msg:  "Tipster stats retrieved successfully",
+		Data: tipsterStatsTransformer(stats),
+	}, nil
+}
+
+func (s *SocialServiceService) ListLeaderboard(ctx context.Context, req *pb.ListLeaderboardRequest) (*pb.ListLeaderboardResponse, error) {
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	snapshot, entries, nextCursor, err := s.biz.ListLeaderboard(ctx, req, pageSize)
+	if err != nil {
+		if errors.IsAccessDenied(err) || err == errors.ErrInvalidCursor {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsInvalid(err) {
+			return &pb.ListLeaderboardResponse{
+				Code: CodeInvalid,
+				Msg:  errors.Message(err),
+			}, nil
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ListLeaderboardResponse{
+				Code: CodeNotFound,
+				Msg:  "Leaderboard has expired, list it again from the start",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to list leaderboard", "error", err)
+		return &pb.ListLeaderboardResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list leaderboard",
+		}, nil
+	}
+
+	pbEntries, err := s.leaderboardTransformer(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+	data := &pb.ListLeaderboardResponse_ListLeaderboardData{
+		Entries:    pbEntries,
+		NextCursor: nextCursor,
+	}
+	if snapshot != nil {
+		data.ComputedAt = timestamppb.New(snapshot.ComputedAt)
+	}
+	return &pb.ListLeaderboardResponse{
+		Code: CodeOk,
+		Msg:  "Leaderboard retrieved successfully",
+		Data: data,
+	}, nil
+}
+
+func (s *SocialServiceService) VerifyTipsterLedger(ctx context.Context, req *pb.VerifyTipsterLedgerRequest) (*pb.VerifyTipsterLedgerResponse, error) {
+	tipsterID, err := primitive.ObjectIDFromHex(req.TipsterId)
+	if err != nil {
+		return &pb.VerifyTipsterLedgerResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid tipster ID format",
+		}, nil
+	}
+
+	verifier, err := s.biz.VerifyTipsterLedger(ctx, tipsterID)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.VerifyTipsterLedgerResponse{
+				Code: CodeNotFound,
+				Msg:  "Tipster not found",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to verify tipster ledger", "error", err)
+		return &pb.VerifyTipsterLedgerResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to verify tipster ledger",
+		}, nil
+	}
+
+	return &pb.VerifyTipsterLedgerResponse{
+		Code: CodeOk,
+		Msg:  "Tipster ledger verified successfully",
+		Data: ledgerVerificationTransformer(verifier),
+	}, nil
+}
@@ -4,16 +4,38 @@ import (
 	"context"
 
 	"src/internal/errors"
+	"src/internal/model"
 	pb "src/protos/Tipster"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
 )
 
 func (s *SocialServiceService) CreateTip(ctx context.Context, req *pb.CreateTipRequest) (*pb.CreateTipResponse, error) {
+	if !validShareType(req.ShareType) {
+		return &pb.CreateTipResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid share type",
+		}, nil
+	}
+	if !validOddsFormat(req.OddsFormat) {
+		return &pb.CreateTipResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid odds format",
+		}, nil
+	}
+
 	data, err := s.biz.CreateTip(ctx, req)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsInvalid(err) {
+			return &pb.CreateTipResponse{
+				Code: CodeInvalid,
+				Msg:  errors.Message(err),
+			}, nil
+		}
 		s.logger.Log(log.LevelError, "failed to create tip", "error", err)
 		return &pb.CreateTipResponse{
 			Code: CodeError,
@@ -42,17 +64,23 @@ func (s *SocialServiceService) GetTip(ctx context.Context, req *pb.GetTipRequest
 			Msg:  "Invalid tip ID format",
 		}, nil
 	}
+	if !validOddsFormat(req.OddsFormat) {
+		return &pb.GetTipResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid odds format",
+		}, nil
+	}
 
 	tip, err := s.biz.GetTip(ctx, objID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsNotFound(err) {
 			return nil, errors.ToRpcError(err)
 		}
 		s.logger.Log(log.LevelError, "failed to fetch tip", "error", err)
 		return nil, errors.ToRpcError(err)
 	}
 
-	data, err := s.tipTransformer(ctx, tip)
+	data, err := s.tipTransformer(ctx, tip, req.OddsFormat)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
@@ -73,9 +101,24 @@ func (s *SocialServiceService) UpdateTip(ctx context.Context, req *pb.UpdateTipR
 			Msg:  "Invalid tip ID format",
 		}, nil
 	}
+	if !validShareType(req.ShareType) {
+		return &pb.UpdateTipResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid share type",
+		}, nil
+	}
 	_, err = s.biz.UpdateTip(ctx, tipID, req)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) || err == errors.ErrTipSettled || err == errors.ErrTipLocked || err == errors.ErrEditConflict {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsInvalid(err) {
+			return &pb.UpdateTipResponse{
+				Code: CodeInvalid,
+				Msg:  errors.Message(err),
+			}, nil
+		}
+		if errors.IsNotFound(err) {
 			return &pb.UpdateTipResponse{
 				Code: CodeNotFound,
 				Msg:  "Tip not found",
@@ -94,6 +137,53 @@ func (s *SocialServiceService) UpdateTip(ctx context.Context, req *pb.UpdateTipR
 	}, nil
 }
 
+func (s *SocialServiceService) ListTipRevisions(ctx context.Context, req *pb.ListTipRevisionsRequest) (*pb.ListTipRevisionsResponse, error) {
+	tipID, err := primitive.ObjectIDFromHex(req.TipId)
+	if err != nil {
+		return &pb.ListTipRevisionsResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid tip ID format",
+		}, nil
+	}
+	if !validOddsFormat(req.OddsFormat) {
+		return &pb.ListTipRevisionsResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid odds format",
+		}, nil
+	}
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	revisions, nextCursor, err := s.biz.ListTipRevisions(ctx, tipID, pageSize, req.NextCursor)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ListTipRevisionsResponse{
+				Code: CodeNotFound,
+				Msg:  "Tip not found",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to list tip revisions", "error", err)
+		return &pb.ListTipRevisionsResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list tip revisions",
+		}, nil
+	}
+
+	return &pb.ListTipRevisionsResponse{
+		Code: CodeOk,
+		Msg:  "Tip revisions retrieved successfully",
+		Data: &pb.ListTipRevisionsResponse_ListTipRevisionsData{
+			Revisions:  tipRevisionsTransformer(revisions, req.OddsFormat),
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
+
 func (s *SocialServiceService) DeleteTip(ctx context.Context, req *pb.DeleteTipRequest) (*pb.DeleteTipResponse, error) {
 	tipID, err := primitive.ObjectIDFromHex(req.TipId)
 	if err != nil {
@@ -105,7 +195,10 @@ func (s *SocialServiceService) DeleteTip(ctx context.Context, req *pb.DeleteTipR
 
 	err = s.biz.DeleteTip(ctx, tipID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) || err == errors.ErrTipLocked {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.DeleteTipResponse{
 				Code: CodeNotFound,
 				Msg:  "Tip not found",
@@ -130,16 +223,25 @@ func (s *SocialServiceService) ListTips(ctx context.Context, req *pb.ListTipsReq
 	if pageSize <= 0 {
 		pageSize = 10 // Default to 10 items per page
 	}
+	if !validOddsFormat(req.OddsFormat) {
+		return &pb.ListTipsResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid odds format",
+		}, nil
+	}
 	// Fetch tips
 	tips, lastTipID, err := s.biz.ListTips(ctx, req)
 	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
 		s.logger.Log(log.LevelError, "failed to fetch tips", "error", err)
 		return &pb.ListTipsResponse{
 			Code: CodeError,
 			Msg:  "Database error",
 		}, nil
 	}
-	data, err := s.tipsTransformer(ctx, tips, pageSize, lastTipID)
+	data, err := s.tipsTransformer(ctx, tips, pageSize, lastTipID, req.OddsFormat)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
 	}
@@ -160,46 +262,114 @@ func (s *SocialServiceService) ShareTip(ctx context.Context, req *pb.ShareTipReq
 			Msg:  "Invalid tip ID format",
 		}, nil
 	}
-	err = s.biz.ShareTip(ctx, tipID, req.ShareType)
+	if req.ShareType == pb.ShareType_SHARE_TYPE_UNSPECIFIED || !validShareType(req.ShareType) {
+		return &pb.ShareTipResponse{
+			Code: CodeInvalid,
+			Msg:  "Invalid share type",
+		}, nil
+	}
+	share, alreadyShared, err := s.biz.ShareTip(ctx, tipID, req.ShareType, req.Comment)
 	if err != nil {
-		s.logger.Log(log.LevelError, "failed to update tip share type", "error", err)
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ShareTipResponse{
+				Code: CodeNotFound,
+				Msg:  "Tip not found",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to share tip", "error", err)
 		return &pb.ShareTipResponse{
 			Code: CodeError,
-			Msg:  "Failed to update tip share type",
+			Msg:  "Failed to share tip",
 		}, nil
 	}
 
+	shares, err := s.sharesTransformer(ctx, []*model.Share{share})
+	if err != nil {
+		return nil, err
+	}
+	msg := "Tip shared successfully"
+	if alreadyShared {
+		msg = "Tip already shared"
+	}
 	return &pb.ShareTipResponse{
 		Code: CodeOk,
-		Msg:  "Tip shared successfully",
+		Msg:  msg,
+		Data: &pb.ShareTipResponse_ShareTipData{
+			Share:         shares[0],
+			AlreadyShared: alreadyShared,
+		},
 	}, nil
 }
 
-func (s *SocialServiceService) LikeTip(ctx context.Context, req *pb.LikeTipRequest) (*pb.LikeTipResponse, error) {
-	if req.TipId == "" || req.UserId == "" {
-		return &pb.LikeTipResponse{
+func (s *SocialServiceService) ListTipShares(ctx context.Context, req *pb.ListTipSharesRequest) (*pb.ListTipSharesResponse, error) {
+	tipID, err := primitive.ObjectIDFromHex(req.TipId)
+	if err != nil {
+		return &pb.ListTipSharesResponse{
 			Code: CodeInvalidID,
-			Msg:  "Tip ID and User ID are required",
+			Msg:  "Invalid tip ID format",
 		}, nil
 	}
-	tipID, err := primitive.ObjectIDFromHex(req.TipId)
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	shares, nextCursor, err := s.biz.ListTipShares(ctx, tipID, pageSize, req.NextCursor)
+	if err != nil {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
+			return &pb.ListTipSharesResponse{
+				Code: CodeNotFound,
+				Msg:  "Tip not found",
+			}, nil
+		}
+		s.logger.Log(log.LevelError, "failed to list tip shares", "error", err)
+		return &pb.ListTipSharesResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list tip shares",
+		}, nil
+	}
+
+	pbShares, err := s.sharesTransformer(ctx, shares)
 	if err != nil {
+		return nil, err
+	}
+	return &pb.ListTipSharesResponse{
+		Code: CodeOk,
+		Msg:  "Tip shares retrieved successfully",
+		Data: &pb.ListTipSharesResponse_ListTipSharesData{
+			Shares:     pbShares,
+			NextCursor: nextCursor,
+		},
+	}, nil
+}
+
+func (s *SocialServiceService) LikeTip(ctx context.Context, req *pb.LikeTipRequest) (*pb.LikeTipResponse, error) {
+	if req.TipId == "" {
 		return &pb.LikeTipResponse{
 			Code: CodeInvalidID,
-			Msg:  "Invalid tip ID format",
+			Msg:  "Tip ID is required",
 		}, nil
 	}
-	userID, err := primitive.ObjectIDFromHex(req.UserId)
+	tipID, err := primitive.ObjectIDFromHex(req.TipId)
 	if err != nil {
 		return &pb.LikeTipResponse{
 			Code: CodeInvalidID,
-			Msg:  "Invalid user ID format",
+			Msg:  "Invalid tip ID format",
 		}, nil
 	}
 
-	totalLikes, err := s.biz.LikeTip(ctx, tipID, userID)
+	totalLikes, err := s.biz.LikeTip(ctx, tipID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.LikeTipResponse{
 				Code: CodeNotFound,
 				Msg:  "Tip not found",
@@ -223,10 +393,10 @@ func (s *SocialServiceService) LikeTip(ctx context.Context, req *pb.LikeTipReque
 }
 
 func (s *SocialServiceService) UnlikeTip(ctx context.Context, req *pb.UnlikeTipRequest) (*pb.UnlikeTipResponse, error) {
-	if req.TipId == "" || req.UserId == "" {
+	if req.TipId == "" {
 		return &pb.UnlikeTipResponse{
 			Code: CodeInvalid,
-			Msg:  "Tip ID and User ID are required",
+			Msg:  "Tip ID is required",
 		}, nil
 	}
 	tipID, err := primitive.ObjectIDFromHex(req.TipId)
@@ -237,17 +407,12 @@ func (s *SocialServiceService) UnlikeTip(ctx context.Context, req *pb.UnlikeTipR
 		}, nil
 	}
 
-	userID, err := primitive.ObjectIDFromHex(req.UserId)
+	totalUnlikes, err := s.biz.UnlikeTip(ctx, tipID)
 	if err != nil {
-		return &pb.UnlikeTipResponse{
-			Code: CodeInvalidID,
-			Msg:  "Invalid user ID format",
-		}, nil
-	}
-
-	totalUnlikes, err := s.biz.UnlikeTip(ctx, tipID, userID)
-	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.UnlikeTipResponse{
 				Code: CodeNotFound,
 				Msg:  "Tip not found",
@@ -269,3 +434,14 @@ func (s *SocialServiceService) UnlikeTip(ctx context.Context, req *pb.UnlikeTipR
 		},
 	}, nil
 }
+
+// validShareType accepts the defined share types and leaving it unset.
+func validShareType(shareType pb.ShareType) bool {
+	_, ok := pb.ShareType_name[int32(shareType)]
+	return ok
+}
+
+func validOddsFormat(format pb.OddsFormat) bool {
+	_, ok := pb.OddsFormat_name[int32(format)]
+	return ok
+}
@@ -2,15 +2,20 @@ package service
 
 import (
 	"context"
+	"strings"
+	"time"
 
+	"src/internal/auth"
 	"src/internal/biz"
+	"src/internal/conf"
 	"src/internal/errors"
 	"src/internal/repository"
 	pb "src/protos/Tipster"
+	commonpb "src/protos/YM.Common"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -31,16 +36,55 @@ type SocialServiceService struct {
 	biz    *biz.SocialService
 }
 
-func NewSocialServiceService(repo repository.SocialRepository, logger log.Logger) *SocialServiceService {
+const (
+	defaultDeletionGracePeriod = 30 * 24 * time.Hour
+	defaultTipEditGracePeriod  = 15 * time.Minute
+)
+
+func NewSocialServiceService(repo repository.SocialRepository, passwords *auth.PasswordHasher, tokens *auth.TokenIssuer, identities auth.IdentityVerifiers, deletionConf *conf.Deletion, tipsConf *conf.Tips, logger log.Logger) *SocialServiceService {
+	gracePeriod := deletionConf.GetGracePeriod().AsDuration()
+	if gracePeriod <= 0 {
+		gracePeriod = defaultDeletionGracePeriod
+	}
+	tipEditGracePeriod := tipsConf.GetEditGracePeriod().AsDuration()
+	if tipEditGracePeriod <= 0 {
+		tipEditGracePeriod = defaultTipEditGracePeriod
+	}
+
 	return &SocialServiceService{
 		repo:   repo,
 		logger: logger,
-		biz:    &biz.SocialService{Repo: repo},
+		biz: &biz.SocialService{
+			Repo:                repo,
+			Passwords:           passwords,
+			Tokens:              tokens,
+			Identities:          identities,
+			DeletionGracePeriod: gracePeriod,
+			TipEditGracePeriod:  tipEditGracePeriod,
+			Logger:              logger,
+		},
 	}
 }
 
+// publicOperations can be called without an access token.
+var publicOperations = map[string]bool{
+	pb.SocialService_CreateUser_FullMethodName:        true,
+	pb.SocialService_Login_FullMethodName:             true,
+	pb.SocialService_RefreshToken_FullMethodName:      true,
+	pb.SocialService_LoginWithIdentity_FullMethodName: true,
+}
+
+// RequiresAuth is the selector match for the auth middleware.
+func RequiresAuth(ctx context.Context, operation string) bool {
+	return !publicOperations[operation]
+}
+
 func (s *SocialServiceService) CreateUser(ctx context.Context, req *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
-	data := s.biz.CreateUser(ctx, req)
+	data, err := s.biz.CreateUser(ctx, req)
+	if err != nil {
+		s.logger.Log(log.LevelError, "failed to create user", "error", err)
+		return nil, err
+	}
 	if data.UserId == "" {
 		return nil, errors.ErrEmailAlreadyExists
 	}
@@ -52,6 +96,100 @@ func (s *SocialServiceService) CreateUser(ctx context.Context, req *pb.CreateUse
 	}, nil
 }
 
+func (s *SocialServiceService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.LoginResponse, error) {
+	if req.Email == "" || req.Password == "" {
+		return &pb.LoginResponse{
+			Code: CodeInvalid,
+			Msg:  "Email and password are required",
+		}, nil
+	}
+
+	user, tokens, err := s.biz.Login(ctx, req.Email, req.Password)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+
+	return &pb.LoginResponse{
+		Code: CodeOk,
+		Msg:  "Login successful",
+		Data: &pb.LoginResponse_LoginData{
+			UserId:   user.ID.Hex(),
+			UserName: user.Username,
+			Email:    user.Email,
+			Token:    authTokenTransformer(tokens),
+		},
+	}, nil
+}
+
+func (s *SocialServiceService) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	if req.RefreshToken == "" {
+		return &pb.RefreshTokenResponse{
+			Code: CodeInvalid,
+			Msg:  "Refresh token is required",
+		}, nil
+	}
+
+	tokens, err := s.biz.RefreshTokens(ctx, req.RefreshToken)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+
+	return &pb.RefreshTokenResponse{
+		Code: CodeOk,
+		Msg:  "Token refreshed successfully",
+		Data: authTokenTransformer(tokens),
+	}, nil
+}
+
+func (s *SocialServiceService) LoginWithIdentity(ctx context.Context, req *pb.LoginWithIdentityRequest) (*pb.LoginWithIdentityResponse, error) {
+	if req.Provider == commonpb.IdendityProvider_IdendityProviderNone || req.Credential == "" {
+		return &pb.LoginWithIdentityResponse{
+			Code: CodeInvalid,
+			Msg:  "Provider and credential are required",
+		}, nil
+	}
+
+	user, tokens, created, err := s.biz.LoginWithIdentity(ctx, req.Provider, req.Credential)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+
+	if created {
+		s.logger.Log(log.LevelInfo, "user created from identity", "user_id", user.ID.Hex(), "provider", req.Provider.String())
+	}
+	return &pb.LoginWithIdentityResponse{
+		Code: CodeOk,
+		Msg:  "Login successful",
+		Data: &pb.LoginResponse_LoginData{
+			UserId:   user.ID.Hex(),
+			UserName: user.Username,
+			Email:    user.Email,
+			Token:    authTokenTransformer(tokens),
+		},
+		AccountCreated: created,
+	}, nil
+}
+
+func (s *SocialServiceService) LinkIdentity(ctx context.Context, req *pb.LinkIdentityRequest) (*pb.LinkIdentityResponse, error) {
+	if req.Provider == commonpb.IdendityProvider_IdendityProviderNone || req.Credential == "" {
+		return &pb.LinkIdentityResponse{
+			Code: CodeInvalid,
+			Msg:  "Provider and credential are required",
+		}, nil
+	}
+
+	user, err := s.biz.LinkIdentity(ctx, req.Provider, req.Credential)
+	if err != nil {
+		return nil, errors.ToRpcError(err)
+	}
+
+	return &pb.LinkIdentityResponse{
+		Code: CodeOk,
+		Msg:  "Identity linked successfully",
+		Data: identitiesTransformer(user.Identities),
+	}, nil
+}
+
 func (s *SocialServiceService) GetUser(ctx context.Context, req *pb.GetUserRequest) (*pb.GetUserResponse, error) {
 	objID, err := primitive.ObjectIDFromHex(req.UserId)
 	if err != nil {
@@ -89,7 +227,10 @@ func (s *SocialServiceService) UpdateUser(ctx context.Context, req *pb.UpdateUse
 	// Attempt to update the user
 	err = s.biz.UpdateUser(ctx, objID, req)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.UpdateUserResponse{
 				Code: CodeInvalidData,
 				Msg:  "User not found",
@@ -118,10 +259,13 @@ func (s *SocialServiceService) DeleteUser(ctx context.Context, req *pb.DeleteUse
 		}, nil
 	}
 
-	// Attempt to delete the user
-	err = s.biz.DeleteUser(ctx, objID)
+	// Soft-delete the user; the purge job removes it after the grace period
+	deletion, err := s.biz.DeleteUser(ctx, objID)
 	if err != nil {
-		if err == mongo.ErrNoDocuments {
+		if errors.IsAccessDenied(err) || err == errors.ErrInvalidStatusTransition {
+			return nil, errors.ToRpcError(err)
+		}
+		if errors.IsNotFound(err) {
 			return &pb.DeleteUserResponse{
 				Code: CodeInvalidData,
 				Msg:  "User not found",
@@ -134,10 +278,152 @@ func (s *SocialServiceService) DeleteUser(ctx context.Context, req *pb.DeleteUse
 		}, nil
 	}
 
-	s.logger.Log(log.LevelInfo, "user deleted successfully", "user_id", req.UserId)
+	s.logger.Log(log.LevelInfo, "user deleted successfully", "user_id", req.UserId, "purge_after", deletion.PurgeAfter)
 	return &pb.DeleteUserResponse{
+		Code:       CodeOk,
+		Msg:        "User deleted successfully",
+		PurgeAfter: timestamppb.New(deletion.PurgeAfter),
+	}, nil
+}
+
+func (s *SocialServiceService) RestoreUser(ctx context.Context, req *pb.RestoreUserRequest) (*pb.RestoreUserResponse, error) {
+	objID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.RestoreUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.RestoreUser(ctx, objID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.RestoreUserResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		return nil, errors.ToRpcError(err)
+	}
+
+	s.logger.Log(log.LevelInfo, "deleted user restored", "user_id", req.UserId)
+	return &pb.RestoreUserResponse{
+		Code: CodeOk,
+		Msg:  "User restored successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) SetUserRole(ctx context.Context, req *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	objID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.SetUserRoleResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+	if req.Role == pb.UserRole_USER_ROLE_UNSPECIFIED {
+		return &pb.SetUserRoleResponse{
+			Code: CodeInvalid,
+			Msg:  "Role is required",
+		}, nil
+	}
+
+	err = s.biz.SetUserRole(ctx, objID, strings.TrimPrefix(req.Role.String(), "USER_ROLE_"))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.SetUserRoleResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		return nil, errors.ToRpcError(err)
+	}
+
+	s.logger.Log(log.LevelInfo, "user role changed", "user_id", req.UserId, "role", req.Role.String())
+	return &pb.SetUserRoleResponse{
+		Code: CodeOk,
+		Msg:  "User role updated successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) SuspendUser(ctx context.Context, req *pb.SuspendUserRequest) (*pb.SuspendUserResponse, error) {
+	objID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.SuspendUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.SuspendUser(ctx, objID, req.Reason)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.SuspendUserResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		return nil, errors.ToRpcError(err)
+	}
+
+	s.logger.Log(log.LevelInfo, "user suspended", "user_id", req.UserId)
+	return &pb.SuspendUserResponse{
 		Code: CodeOk,
-		Msg:  "User deleted successfully",
+		Msg:  "User suspended successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) ReactivateUser(ctx context.Context, req *pb.ReactivateUserRequest) (*pb.ReactivateUserResponse, error) {
+	objID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.ReactivateUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.ReactivateUser(ctx, objID)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.ReactivateUserResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		return nil, errors.ToRpcError(err)
+	}
+
+	s.logger.Log(log.LevelInfo, "user reactivated", "user_id", req.UserId)
+	return &pb.ReactivateUserResponse{
+		Code: CodeOk,
+		Msg:  "User reactivated successfully",
+	}, nil
+}
+
+func (s *SocialServiceService) CloseUser(ctx context.Context, req *pb.CloseUserRequest) (*pb.CloseUserResponse, error) {
+	objID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.CloseUserResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	err = s.biz.CloseUser(ctx, objID, req.Reason)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &pb.CloseUserResponse{
+				Code: CodeNotFound,
+				Msg:  "User not found",
+			}, nil
+		}
+		return nil, errors.ToRpcError(err)
+	}
+
+	s.logger.Log(log.LevelInfo, "user account closed", "user_id", req.UserId)
+	return &pb.CloseUserResponse{
+		Code: CodeOk,
+		Msg:  "User account closed successfully",
 	}, nil
 }
 
@@ -173,26 +459,28 @@ func (s *SocialServiceService) FollowTipster(ctx context.Context, req *pb.Follow
 			Msg:  "Invalid tipster ID format",
 		}, nil
 	}
-	userID, err := primitive.ObjectIDFromHex(req.UserId)
-	if err != nil {
-		return &pb.FollowTipsterResponse{
-			Code: CodeInvalidID,
-			Msg:  "Invalid user ID format",
-		}, nil
-	}
 
 	// Attempt to follow the tipster
-	data, err := s.biz.FollowTipster(ctx, userID, tipsterID)
+	data, err := s.biz.FollowTipster(ctx, tipsterID)
 	if err != nil {
+		if errors.IsAccessDenied(err) || errors.IsNotFound(err) || err == errors.ErrCannotFollowSelf || err == errors.ErrCannotFollowBlocked {
+			return nil, errors.ToRpcError(err)
+		}
 		s.logger.Log(log.LevelError, "failed to update follow relationship", "error", err)
 		return &pb.FollowTipsterResponse{
 			Code: CodeError,
 			Msg:  "Failed to update follow relationship",
 		}, nil
 	}
+	msg := "Successfully followed tipster"
+	if data.AlreadyFollowing {
+		msg = "Already following tipster"
+	} else if data.Pending {
+		msg = "Follow request sent"
+	}
 	return &pb.FollowTipsterResponse{
 		Code: CodeOk,
-		Msg:  "Successfully followed tipster",
+		Msg:  msg,
 		Data: data,
 	}, nil
 }
@@ -206,16 +494,9 @@ func (s *SocialServiceService) UnfollowTipster(ctx context.Context, req *pb.UnFo
 			Msg:  "Invalid tipster ID format",
 		}, nil
 	}
-	userID, err := primitive.ObjectIDFromHex(req.UserId)
-	if err != nil {
-		return &pb.UnfollowTipsterResponse{
-			Code: CodeInvalidID,
-			Msg:  "Invalid user ID format",
-		}, nil
-	}
 
 	// Attempt to unfollow the tipster
-	data, err := s.biz.UnfollowTipster(ctx, userID, tipsterID)
+	data, err := s.biz.UnfollowTipster(ctx, tipsterID)
 	if err != nil {
 		s.logger.Log(log.LevelError, "failed to update unfollow relationship", "error", err)
 		return &pb.UnfollowTipsterResponse{
@@ -230,3 +511,63 @@ func (s *SocialServiceService) UnfollowTipster(ctx context.Context, req *pb.UnFo
 		Data: data,
 	}, nil
 }
+
+func (s *SocialServiceService) ListFollowers(ctx context.Context, req *pb.ListFollowersRequest) (*pb.ListFollowersResponse, error) {
+	userID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.ListFollowersResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	users, nextCursor, err := s.biz.ListFollowers(ctx, userID, pageSize, req.NextCursor)
+	if err != nil {
+		s.logger.Log(log.LevelError, "failed to list followers", "error", err)
+		return &pb.ListFollowersResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list followers",
+		}, nil
+	}
+
+	return &pb.ListFollowersResponse{
+		Code: CodeOk,
+		Msg:  "Followers retrieved successfully",
+		Data: userDetailPageTransformer(users, nextCursor),
+	}, nil
+}
+
+func (s *SocialServiceService) ListFollowing(ctx context.Context, req *pb.ListFollowingRequest) (*pb.ListFollowingResponse, error) {
+	userID, err := primitive.ObjectIDFromHex(req.UserId)
+	if err != nil {
+		return &pb.ListFollowingResponse{
+			Code: CodeInvalidID,
+			Msg:  "Invalid user ID format",
+		}, nil
+	}
+
+	pageSize := int64(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	users, nextCursor, err := s.biz.ListFollowing(ctx, userID, pageSize, req.NextCursor)
+	if err != nil {
+		s.logger.Log(log.LevelError, "failed to list followed users", "error", err)
+		return &pb.ListFollowingResponse{
+			Code: CodeFetchError,
+			Msg:  "Failed to list followed users",
+		}, nil
+	}
+
+	return &pb.ListFollowingResponse{
+		Code: CodeOk,
+		Msg:  "Followed users retrieved successfully",
+		Data: userDetailPageTransformer(users, nextCursor),
+	}, nil
+}
@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"src/internal/auth"
+	"src/internal/auth/authtest"
+	"src/internal/conf"
+	"src/internal/errors"
+	pb "src/protos/Tipster"
+
+	"github.com/go-kratos/kratos/v2/middleware/selector"
+)
+
+func TestRequiresAuth(t *testing.T) {
+	public := map[string]bool{
+		"CreateUser":        true,
+		"Login":             true,
+		"RefreshToken":      true,
+		"LoginWithIdentity": true,
+	}
+	for _, method := range pb.SocialService_ServiceDesc.Methods {
+		operation := "/" + pb.SocialService_ServiceDesc.ServiceName + "/" + method.MethodName
+		if got := RequiresAuth(context.Background(), operation); got == public[method.MethodName] {
+			t.Errorf("RequiresAuth(%s) = %v, want %v", operation, got, !public[method.MethodName])
+		}
+	}
+	if !RequiresAuth(context.Background(), "/protos.Tipster.SocialService/Unknown") {
+		t.Error("RequiresAuth of an unknown operation = false, want true")
+	}
+}
+
+func TestAuthMiddlewareSelector(t *testing.T) {
+	tokens, err := auth.NewTokenIssuer(&conf.Auth_Token{Secret: "test-secret-of-at-least-32-bytes"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	handler := selector.Server(auth.Server(tokens)).Match(RequiresAuth).Build()(
+		func(ctx context.Context, req interface{}) (interface{}, error) {
+			return "ok", nil
+		},
+	)
+
+	tests := []struct {
+		operation string
+		wantErr   error
+	}{
+		{pb.SocialService_Login_FullMethodName, nil},
+		{pb.SocialService_CreateUser_FullMethodName, nil},
+		{pb.SocialService_GetUser_FullMethodName, errors.ErrUnauthenticated},
+		{pb.SocialService_SetUserRole_FullMethodName, errors.ErrUnauthenticated},
+		{pb.SocialService_VerifyTipsterLedger_FullMethodName, errors.ErrUnauthenticated},
+	}
+	for _, tt := range tests {
+		if _, err := handler(authtest.ServerContext(tt.operation, ""), nil); err != tt.wantErr {
+			t.Errorf("%s without a token: err = %v, want %v", tt.operation, err, tt.wantErr)
+		}
+	}
+}
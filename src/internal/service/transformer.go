@@ -2,15 +2,17 @@ package service
 
 import (
 	"context"
+	"time"
+
+	"src/internal/auth"
+	"src/internal/biz"
 	"src/internal/errors"
+	"src/internal/ledger"
 	"src/internal/model"
 	pb "src/protos/Tipster"
-	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"go.mongodb.org/mongo-driver/bson/primitive"
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -49,44 +51,25 @@ func (s *SocialServiceService) likeUsers(ctx context.Context, likeIDs, unlikeIDs
 }
 
 func (s *SocialServiceService) userTransformer(ctx context.Context, user *model.User) (*pb.CreateUserResponse_UserData, error) {
-	// Get follower details
-	followerDetails, err := s.repo.GetUserDetails(ctx, user.Followers)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Error fetching followers: %v", err)
-	}
-
-	// Get following details
-	followingDetails, err := s.repo.GetUserDetails(ctx, user.Following)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Error fetching followings: %v", err)
-	}
-
-	// Convert model.UserDetail to pb.UserDetail
-	pbFollowers := make([]*pb.UserDetail, len(followerDetails))
-	for i, f := range followerDetails {
-		pbFollowers[i] = &pb.UserDetail{
-			Id:       strings.TrimSpace(f.ID.Hex()),
-			UserName: strings.TrimSpace(f.Username),
-		}
-	}
-
-	pbFollowings := make([]*pb.UserDetail, len(followingDetails))
-	for i, f := range followingDetails {
-		pbFollowings[i] = &pb.UserDetail{
-			Id:       strings.TrimSpace(f.ID.Hex()),
-			UserName: strings.TrimSpace(f.Username),
-		}
+	var selfExcludedUntil *timestamppb.Timestamp
+	if user.SelfExcludedUntil != nil {
+		selfExcludedUntil = timestamppb.New(*user.SelfExcludedUntil)
 	}
 
 	return &pb.CreateUserResponse_UserData{
-		UserId:     user.ID.Hex(),
-		UserName:   user.Username,
-		Email:      user.Email,
-		Tags:       user.Tags,
-		CreatedAt:  timestamppb.New(user.CreatedAt),
-		UpdatedAt:  timestamppb.New(user.UpdatedAt),
-		Followers:  pbFollowers,
-		Followings: pbFollowings,
+		UserId:            user.ID.Hex(),
+		UserName:          user.Username,
+		Email:             user.Email,
+		Tags:              user.Tags,
+		CreatedAt:         timestamppb.New(user.CreatedAt),
+		UpdatedAt:         timestamppb.New(user.UpdatedAt),
+		FollowersCount:    user.FollowersCount,
+		FollowingCount:    user.FollowingCount,
+		Role:              pb.UserRole(pb.UserRole_value["USER_ROLE_"+user.Role]),
+		Status:            user.Status,
+		SelfExcludedUntil: selfExcludedUntil,
+		Identities:        identitiesTransformer(user.Identities),
+		Private:           user.Private,
 	}, nil
 }
 func (s *SocialServiceService) usersTransformer(ctx context.Context, users []*model.User, pageSize int64) (*pb.ListUserResponse_ListUsersData, error) {
@@ -201,7 +184,7 @@ func (s *SocialServiceService) replyTransformer(ctx context.Context, replies []*
 	return pbReplies, nil
 }
 
-func (s *SocialServiceService) tipTransformer(ctx context.Context, tip *model.Tip) (*pb.TipData, error) {
+func (s *SocialServiceService) tipTransformer(ctx context.Context, tip *model.Tip, oddsFormat pb.OddsFormat) (*pb.TipData, error) {
 	likeUserMap, unlikeUserMap, err := s.likeUsers(ctx, tip.Likes, tip.Unlikes)
 	if err != nil {
 		return nil, errors.ToRpcError(err)
@@ -221,25 +204,85 @@ func (s *SocialServiceService) tipTransformer(ctx context.Context, tip *model.Ti
 		}
 	}
 
+	var expiresAt *timestamppb.Timestamp
+	if tip.ExpiresAt != nil {
+		expiresAt = timestamppb.New(*tip.ExpiresAt)
+	}
+
 	return &pb.TipData{
-		TipId:     tip.ID.Hex(),
-		TipsterId: tip.TipsterID,
-		Title:     tip.Title,
-		Content:   tip.Content,
-		Tags:      tip.Tags,
-		Likes:     likes,
-		Unlikes:   unlikes,
-		CreatedAt: timestamppb.New(tip.CreatedAt),
-		UpdatedAt: timestamppb.New(tip.UpdatedAt),
-		ShareType: tip.ShareType,
+		TipId:         tip.ID.Hex(),
+		TipsterId:     tip.TipsterID,
+		Title:         tip.Title,
+		Content:       tip.Content,
+		Tags:          tip.Tags,
+		Likes:         likes,
+		Unlikes:       unlikes,
+		CreatedAt:     timestamppb.New(tip.CreatedAt),
+		UpdatedAt:     timestamppb.New(tip.UpdatedAt),
+		ShareType:     shareTypeTransformer(tip.ShareType),
+		ExpiresAt:     expiresAt,
+		ShareCount:    int32(tip.ShareCount),
+		Selection:     biz.SelectionData(tip.Selection, oddsFormat),
+		Settlement:    settlementTransformer(tip.Settlement),
+		Locked:        biz.TipLocked(tip, time.Now().UTC()),
+		Edited:        tip.Revisions > 0,
+		RevisionCount: tip.Revisions,
 	}, nil
 }
 
-func (s *SocialServiceService) tipsTransformer(ctx context.Context, tips []*model.Tip, pageSize int64, lastTipID primitive.ObjectID) (*pb.ListTipsResponse_ListTipsData, error) {
+func settlementTransformer(settlement *model.Settlement) *pb.TipSettlement {
+	if settlement == nil {
+		return nil
+	}
+	return &pb.TipSettlement{
+		Result:    pb.TipResult(pb.TipResult_value["TIP_RESULT_"+settlement.Result]),
+		Profit:    settlement.Profit,
+		SettledAt: timestamppb.New(settlement.SettledAt),
+		SettledBy: settlement.SettledBy.Hex(),
+	}
+}
+
+func tipRevisionsTransformer(revisions []*model.TipRevision, oddsFormat pb.OddsFormat) []*pb.TipRevisionData {
+	pbRevisions := make([]*pb.TipRevisionData, 0, len(revisions))
+	for _, revision := range revisions {
+		pbRevisions = append(pbRevisions, &pb.TipRevisionData{
+			RevisionId:    revision.ID.Hex(),
+			Number:        revision.Number,
+			EditorId:      revision.EditorID,
+			EditedAt:      timestamppb.New(revision.EditedAt),
+			ChangedFields: revision.Changes,
+			Before:        tipVersionTransformer(revision.Before, oddsFormat),
+			After:         tipVersionTransformer(revision.After, oddsFormat),
+		})
+	}
+	return pbRevisions
+}
+
+func tipVersionTransformer(version model.TipVersion, oddsFormat pb.OddsFormat) *pb.TipVersionData {
+	return &pb.TipVersionData{
+		Title:     version.Title,
+		Content:   version.Content,
+		Tags:      version.Tags,
+		ShareType: shareTypeTransformer(version.ShareType),
+		Selection: biz.SelectionData(version.Selection, oddsFormat),
+	}
+}
+
+// shareTypeTransformer reports share types stored before they were
+// validated as public, which is how the visibility policy treats them.
+func shareTypeTransformer(shareType string) pb.ShareType {
+	value, ok := pb.ShareType_value["SHARE_TYPE_"+shareType]
+	if !ok {
+		return pb.ShareType_SHARE_TYPE_PUBLIC
+	}
+	return pb.ShareType(value)
+}
+
+func (s *SocialServiceService) tipsTransformer(ctx context.Context, tips []*model.Tip, pageSize int64, lastTipID primitive.ObjectID, oddsFormat pb.OddsFormat) (*pb.ListTipsResponse_ListTipsData, error) {
 	// Convert raw tip records into TipData response
 	var pbTips []*pb.TipData
 	for _, tip := range tips {
-		pbTip, err := s.tipTransformer(ctx, tip)
+		pbTip, err := s.tipTransformer(ctx, tip, oddsFormat)
 		if err != nil {
 			return nil, errors.ToRpcError(err)
 		}
@@ -256,3 +299,208 @@ func (s *SocialServiceService) tipsTransformer(ctx context.Context, tips []*mode
 		NextCursor: nextCursor,
 	}, nil
 }
+
+func (s *SocialServiceService) feedTransformer(events []*model.FeedEvent) []*pb.FeedItem {
+	items := []*pb.FeedItem{}
+	for _, event := range events {
+		items = append(items, &pb.FeedItem{
+			FeedId:      event.ID.Hex(),
+			AuthorId:    event.AuthorID.Hex(),
+			Action:      pb.FeedActionType(pb.FeedActionType_value["FEED_ACTION_"+event.Action]),
+			TargetId:    event.TargetID,
+			DateCreated: timestamppb.New(event.CreatedAt),
+			ExtraInfo:   event.ExtraInfo,
+		})
+	}
+	return items
+}
+
+func authTokenTransformer(tokens *auth.TokenPair) *pb.AuthToken {
+	return &pb.AuthToken{
+		AccessToken:           tokens.AccessToken,
+		AccessTokenExpiresAt:  timestamppb.New(tokens.AccessTokenExpiresAt),
+		RefreshToken:          tokens.RefreshToken,
+		RefreshTokenExpiresAt: timestamppb.New(tokens.RefreshTokenExpiresAt),
+	}
+}
+
+func selfExclusionTransformer(exclusion *model.SelfExclusion) *pb.SelfExclusionData {
+	data := &pb.SelfExclusionData{
+		ExclusionId: exclusion.ID.Hex(),
+		UserId:      exclusion.UserID.Hex(),
+		Period:      pb.SelfExclusionPeriod(pb.SelfExclusionPeriod_value["SELF_EXCLUSION_PERIOD_"+exclusion.Period]),
+		StartedAt:   timestamppb.New(exclusion.StartedAt),
+	}
+	if exclusion.EndsAt != nil {
+		data.EndsAt = timestamppb.New(*exclusion.EndsAt)
+	}
+	if exclusion.ExpiredAt != nil {
+		data.ExpiredAt = timestamppb.New(*exclusion.ExpiredAt)
+	}
+	return data
+}
+
+func identitiesTransformer(identities []model.Identity) []*pb.IdentityData {
+	data := make([]*pb.IdentityData, 0, len(identities))
+	for _, identity := range identities {
+		data = append(data, &pb.IdentityData{
+			Provider: identity.Provider,
+			Email:    identity.Email,
+			LinkedAt: timestamppb.New(identity.LinkedAt),
+		})
+	}
+	return data
+}
+
+func userDetailPageTransformer(users []*model.UserDetail, nextCursor string) *pb.UserDetailPage {
+	pbUsers := make([]*pb.UserDetail, 0, len(users))
+	for _, user := range users {
+		pbUsers = append(pbUsers, &pb.UserDetail{
+			Id:       user.ID.Hex(),
+			UserName: user.Username,
+		})
+	}
+	return &pb.UserDetailPage{
+		Users:      pbUsers,
+		NextCursor: nextCursor,
+	}
+}
+
+func (s *SocialServiceService) followRequestsTransformer(ctx context.Context, requests []*model.FollowRequest, nextCursor string) (*pb.ListFollowRequestsResponse_ListFollowRequestsData, error) {
+	requesterIDs := make([]primitive.ObjectID, 0, len(requests))
+	for _, request := range requests {
+		requesterIDs = append(requesterIDs, request.RequesterID)
+	}
+	requesters, err := s.repo.GetUserDetails(ctx, requesterIDs)
+	if err != nil {
+		s.logger.Log(log.LevelError, "failed to fetch requester details", "error", err)
+		return nil, errors.ToRpcError(err)
+	}
+	usernames := map[primitive.ObjectID]string{}
+	for _, requester := range requesters {
+		usernames[requester.ID] = requester.Username
+	}
+
+	pbRequests := make([]*pb.FollowRequestData, 0, len(requests))
+	for _, request := range requests {
+		pbRequests = append(pbRequests, &pb.FollowRequestData{
+			RequestId: request.ID.Hex(),
+			Requester: &pb.UserDetail{
+				Id:       request.RequesterID.Hex(),
+				UserName: usernames[request.RequesterID],
+			},
+			CreatedAt: timestamppb.New(request.CreatedAt),
+		})
+	}
+	return &pb.ListFollowRequestsResponse_ListFollowRequestsData{
+		Requests:   pbRequests,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (s *SocialServiceService) sharesTransformer(ctx context.Context, shares []*model.Share) ([]*pb.TipShareData, error) {
+	sharerIDs := make([]primitive.ObjectID, 0, len(shares))
+	for _, share := range shares {
+		sharerIDs = append(sharerIDs, share.SharerID)
+	}
+	sharers, err := s.repo.GetUserDetails(ctx, sharerIDs)
+	if err != nil {
+		s.logger.Log(log.LevelError, "failed to fetch sharer details", "error", err)
+		return nil, errors.ToRpcError(err)
+	}
+	usernames := map[primitive.ObjectID]string{}
+	for _, sharer := range sharers {
+		usernames[sharer.ID] = sharer.Username
+	}
+
+	pbShares := make([]*pb.TipShareData, 0, len(shares))
+	for _, share := range shares {
+		data := &pb.TipShareData{
+			ShareId: share.ID.Hex(),
+			TipId:   share.TipID.Hex(),
+			Sharer: &pb.UserDetail{
+				Id:       share.SharerID.Hex(),
+				UserName: usernames[share.SharerID],
+			},
+			Comment:   share.Comment,
+			ShareType: shareTypeTransformer(share.ShareType),
+			CreatedAt: timestamppb.New(share.CreatedAt),
+		}
+		if share.ExpiresAt != nil {
+			data.ExpiresAt = timestamppb.New(*share.ExpiresAt)
+		}
+		pbShares = append(pbShares, data)
+	}
+	return pbShares, nil
+}
+
+func tipsterStatsTransformer(stats *model.TipsterStats) *pb.TipsterStats {
+	return &pb.TipsterStats{
+		TipsterId:            stats.TipsterID,
+		Tips:                 stats.Tips,
+		DecidedTips:          stats.DecidedTips,
+		Won:                  stats.Won,
+		Lost:                 stats.Lost,
+		Void:                 stats.Void,
+		Push:                 stats.Push,
+		HalfWon:              stats.HalfWon,
+		HalfLost:             stats.HalfLost,
+		StrikeRate:           stats.StrikeRate,
+		UnitsStaked:          stats.UnitsStaked,
+		Profit:               stats.Profit,
+		Roi:                  stats.ROI,
+		AverageOdds:          stats.AverageOdds,
+		LongestWinningStreak: stats.LongestWinningStreak,
+		LongestLosingStreak:  stats.LongestLosingStreak,
+	}
+}
+
+func (s *SocialServiceService) leaderboardTransformer(ctx context.Context, entries []*model.LeaderboardEntry) ([]*pb.LeaderboardEntryData, error) {
+	tipsterIDs := make([]primitive.ObjectID, 0, len(entries))
+	for _, entry := range entries {
+		if tipsterID, err := primitive.ObjectIDFromHex(entry.Stats.TipsterID); err == nil {
+			tipsterIDs = append(tipsterIDs, tipsterID)
+		}
+	}
+	tipsters, err := s.repo.GetUserDetails(ctx, tipsterIDs)
+	if err != nil {
+		s.logger.Log(log.LevelError, "failed to fetch tipster details", "error", err)
+		return nil, errors.ToRpcError(err)
+	}
+	usernames := map[string]string{}
+	for _, tipster := range tipsters {
+		usernames[tipster.ID.Hex()] = tipster.Username
+	}
+
+	pbEntries := make([]*pb.LeaderboardEntryData, 0, len(entries))
+	for _, entry := range entries {
+		pbEntries = append(pbEntries, &pb.LeaderboardEntryData{
+			Rank: int32(entry.Rank),
+			Tipster: &pb.UserDetail{
+				Id:       entry.Stats.TipsterID,
+				UserName: usernames[entry.Stats.TipsterID],
+			},
+			Stats: tipsterStatsTransformer(&entry.Stats),
+		})
+	}
+	return pbEntries, nil
+}
+
+func ledgerVerificationTransformer(verifier *ledger.Verifier) *pb.VerifyTipsterLedgerResponse_VerifyTipsterLedgerData {
+	issues := verifier.Issues()
+	pbIssues := make([]*pb.LedgerIssue, 0, len(issues))
+	for _, issue := range issues {
+		pbIssues = append(pbIssues, &pb.LedgerIssue{
+			Kind:     pb.LedgerIssueKind(pb.LedgerIssueKind_value["LEDGER_ISSUE_KIND_"+string(issue.Kind)]),
+			Sequence: issue.Sequence,
+			TipId:    issue.TipID.Hex(),
+			Detail:   issue.Detail,
+		})
+	}
+	return &pb.VerifyTipsterLedgerResponse_VerifyTipsterLedgerData{
+		Valid:    len(issues) == 0,
+		Entries:  verifier.Entries(),
+		HeadHash: verifier.Head(),
+		Issues:   pbIssues,
+	}
+}
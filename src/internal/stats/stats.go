@@ -0,0 +1,81 @@
+// Package stats computes tipster records from settled tips. It is shared by
+// the stats API and the leaderboard job.
+package stats
+
+import "src/internal/model"
+
+// Accumulator builds a tipster's record from their settled tips, fed in
+// event order. Void and pushed tips return the stake, so they only count
+// towards the number of tips and not towards the decided tips.
+type Accumulator struct {
+	stats      model.TipsterStats
+	oddsSum    float64
+	winStreak  int64
+	loseStreak int64
+}
+
+func NewAccumulator(tipsterID string) *Accumulator {
+	return &Accumulator{stats: model.TipsterStats{TipsterID: tipsterID}}
+}
+
+// Add counts a settled tip. Tips without a selection or settlement are
+// ignored.
+func (a *Accumulator) Add(tip *model.Tip) {
+	if tip.Selection == nil || tip.Settlement == nil {
+		return
+	}
+	stats := &a.stats
+	stats.Tips++
+
+	won := false
+	switch tip.Settlement.Result {
+	case model.ResultVoid:
+		stats.Void++
+		return
+	case model.ResultPush:
+		stats.Push++
+		return
+	case model.ResultWon:
+		stats.Won++
+		won = true
+	case model.ResultHalfWon:
+		stats.HalfWon++
+		won = true
+	case model.ResultLost:
+		stats.Lost++
+	case model.ResultHalfLost:
+		stats.HalfLost++
+	default:
+		return
+	}
+
+	stats.DecidedTips++
+	stats.UnitsStaked += tip.Selection.Stake
+	stats.Profit += tip.Settlement.Profit
+	if tip.Selection.Odds.Den > 0 {
+		a.oddsSum += float64(tip.Selection.Odds.Num) / float64(tip.Selection.Odds.Den)
+	}
+
+	if won {
+		a.winStreak++
+		a.loseStreak = 0
+		stats.LongestWinningStreak = max(stats.LongestWinningStreak, a.winStreak)
+	} else {
+		a.loseStreak++
+		a.winStreak = 0
+		stats.LongestLosingStreak = max(stats.LongestLosingStreak, a.loseStreak)
+	}
+}
+
+// Result returns the record with the ratios filled in.
+func (a *Accumulator) Result() *model.TipsterStats {
+	stats := a.stats
+	if stats.DecidedTips > 0 {
+		stats.StrikeRate = float64(stats.Won+stats.HalfWon) / float64(stats.DecidedTips) * 100
+		stats.AverageOdds = a.oddsSum / float64(stats.DecidedTips)
+	}
+	if stats.UnitsStaked > 0 {
+		stats.ROI = stats.Profit / stats.UnitsStaked * 100
+	}
+	return &stats
+}
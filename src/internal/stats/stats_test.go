@@ -0,0 +1,93 @@
+package stats
+
+import (
+	"math"
+	"testing"
+
+	"src/internal/model"
+)
+
+// settled returns a tip at decimal odds num/den with a stake of one unit,
+// settled with result and profit.
+func settled(num, den int64, result string, profit float64) *model.Tip {
+	return &model.Tip{
+		Selection:  &model.Selection{Odds: model.Odds{Num: num, Den: den}, Stake: 1},
+		Settlement: &model.Settlement{Result: result, Profit: profit},
+	}
+}
+
+func TestAccumulator(t *testing.T) {
+	won := settled(5, 2, model.ResultWon, 1.5)
+	halfWon := settled(3, 1, model.ResultHalfWon, 1)
+	lost := settled(2, 1, model.ResultLost, -1)
+	halfLost := settled(2, 1, model.ResultHalfLost, -0.5)
+	void := settled(4, 1, model.ResultVoid, 0)
+	push := settled(2, 1, model.ResultPush, 0)
+
+	tests := []struct {
+		name string
+		tips []*model.Tip
+		want model.TipsterStats
+	}{
+		{"none", nil, model.TipsterStats{}},
+		{"void and push only", []*model.Tip{void, push, void},
+			model.TipsterStats{Tips: 3, Void: 2, Push: 1}},
+		{"void and push are not priced", []*model.Tip{won, void, lost, push},
+			model.TipsterStats{
+				Tips: 4, DecidedTips: 2, Won: 1, Lost: 1, Void: 1, Push: 1,
+				StrikeRate: 50, UnitsStaked: 2, Profit: 0.5, ROI: 25, AverageOdds: 2.25,
+				LongestWinningStreak: 1, LongestLosingStreak: 1,
+			}},
+		{"halves count as decided", []*model.Tip{halfWon, halfLost},
+			model.TipsterStats{
+				Tips: 2, DecidedTips: 2, HalfWon: 1, HalfLost: 1,
+				StrikeRate: 50, UnitsStaked: 2, Profit: 0.5, ROI: 25, AverageOdds: 2.5,
+				LongestWinningStreak: 1, LongestLosingStreak: 1,
+			}},
+		{"void does not break a streak", []*model.Tip{won, void, halfWon, push, won, lost, lost, void, halfLost, won},
+			model.TipsterStats{
+				Tips: 10, DecidedTips: 7, Won: 3, HalfWon: 1, Lost: 2, HalfLost: 1, Void: 2, Push: 1,
+				StrikeRate: 4.0 / 7 * 100, UnitsStaked: 7, Profit: 3, ROI: 3.0 / 7 * 100, AverageOdds: 16.5 / 7,
+				LongestWinningStreak: 3, LongestLosingStreak: 3,
+			}},
+		{"unsettled and unstructured tips are ignored", []*model.Tip{
+			{Selection: &model.Selection{Odds: model.Odds{Num: 2, Den: 1}, Stake: 1}},
+			{Settlement: &model.Settlement{Result: model.ResultWon}},
+			won,
+		}, model.TipsterStats{
+			Tips: 1, DecidedTips: 1, Won: 1, StrikeRate: 100, UnitsStaked: 1, Profit: 1.5, ROI: 150, AverageOdds: 2.5,
+			LongestWinningStreak: 1,
+		}},
+	}
+	for _, tt := range tests {
+		record := NewAccumulator("tipster")
+		for _, tip := range tt.tips {
+			record.Add(tip)
+		}
+		got := record.Result()
+		tt.want.TipsterID = "tipster"
+		if !sameStats(*got, tt.want) {
+			t.Errorf("%s:\n got %+v\nwant %+v", tt.name, *got, tt.want)
+		}
+	}
+}
+
+func TestAccumulatorResultDoesNotChangeRecord(t *testing.T) {
+	record := NewAccumulator("tipster")
+	record.Add(settled(2, 1, model.ResultWon, 1))
+	first := record.Result()
+	first.Tips = 99
+	if record.Result().Tips != 1 {
+		t.Error("changing a result changed the record")
+	}
+}
+
+// sameStats compares the ratios with a tolerance.
+func sameStats(a, b model.TipsterStats) bool {
+	near := func(x, y float64) bool { return math.Abs(x-y) < 1e-9 }
+	ratios := near(a.StrikeRate, b.StrikeRate) && near(a.UnitsStaked, b.UnitsStaked) && near(a.Profit, b.Profit) &&
+		near(a.ROI, b.ROI) && near(a.AverageOdds, b.AverageOdds)
+	a.StrikeRate, a.UnitsStaked, a.Profit, a.ROI, a.AverageOdds = 0, 0, 0, 0, 0
+	b.StrikeRate, b.UnitsStaked, b.Profit, b.ROI, b.AverageOdds = 0, 0, 0, 0, 0
+	return ratios && a == b
+}
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
+	YM_Common "src/protos/YM.Common"
 	sync "sync"
 	unsafe "unsafe"
 )
@@ -22,6 +23,488 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// -------------------
+// Tip Settlement
+// -------------------
+type TipResult int32
+
+const (
+	TipResult_TIP_RESULT_UNSPECIFIED TipResult = 0
+	TipResult_TIP_RESULT_WON         TipResult = 1
+	TipResult_TIP_RESULT_LOST        TipResult = 2
+	// The stake is returned, e.g. a cancelled event or a non-runner
+	TipResult_TIP_RESULT_VOID TipResult = 3
+	// The stake is returned because a whole handicap or total line landed
+	TipResult_TIP_RESULT_PUSH TipResult = 4
+	// Quarter lines: half the stake wins, the other half is returned
+	TipResult_TIP_RESULT_HALF_WON TipResult = 5
+	// Quarter lines: half the stake loses, the other half is returned
+	TipResult_TIP_RESULT_HALF_LOST TipResult = 6
+)
+
+// Enum value maps for TipResult.
+var (
+	TipResult_name = map[int32]string{
+		0: "TIP_RESULT_UNSPECIFIED",
+		1: "TIP_RESULT_WON",
+		2: "TIP_RESULT_LOST",
+		3: "TIP_RESULT_VOID",
+		4: "TIP_RESULT_PUSH",
+		5: "TIP_RESULT_HALF_WON",
+		6: "TIP_RESULT_HALF_LOST",
+	}
+	TipResult_value = map[string]int32{
+		"TIP_RESULT_UNSPECIFIED": 0,
+		"TIP_RESULT_WON":         1,
+		"TIP_RESULT_LOST":        2,
+		"TIP_RESULT_VOID":        3,
+		"TIP_RESULT_PUSH":        4,
+		"TIP_RESULT_HALF_WON":    5,
+		"TIP_RESULT_HALF_LOST":   6,
+	}
+)
+
+func (x TipResult) Enum() *TipResult {
+	p := new(TipResult)
+	*p = x
+	return p
+}
+
+func (x TipResult) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TipResult) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[0].Descriptor()
+}
+
+func (TipResult) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[0]
+}
+
+func (x TipResult) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TipResult.Descriptor instead.
+func (TipResult) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{0}
+}
+
+// -------------------
+// Leaderboards
+// -------------------
+// Leaderboards only count tips whose event started in the window
+type LeaderboardWindow int32
+
+const (
+	LeaderboardWindow_LEADERBOARD_WINDOW_UNSPECIFIED  LeaderboardWindow = 0
+	LeaderboardWindow_LEADERBOARD_WINDOW_LAST_7_DAYS  LeaderboardWindow = 1
+	LeaderboardWindow_LEADERBOARD_WINDOW_LAST_30_DAYS LeaderboardWindow = 2
+	LeaderboardWindow_LEADERBOARD_WINDOW_LAST_90_DAYS LeaderboardWindow = 3
+	LeaderboardWindow_LEADERBOARD_WINDOW_ALL_TIME     LeaderboardWindow = 4
+)
+
+// Enum value maps for LeaderboardWindow.
+var (
+	LeaderboardWindow_name = map[int32]string{
+		0: "LEADERBOARD_WINDOW_UNSPECIFIED",
+		1: "LEADERBOARD_WINDOW_LAST_7_DAYS",
+		2: "LEADERBOARD_WINDOW_LAST_30_DAYS",
+		3: "LEADERBOARD_WINDOW_LAST_90_DAYS",
+		4: "LEADERBOARD_WINDOW_ALL_TIME",
+	}
+	LeaderboardWindow_value = map[string]int32{
+		"LEADERBOARD_WINDOW_UNSPECIFIED":  0,
+		"LEADERBOARD_WINDOW_LAST_7_DAYS":  1,
+		"LEADERBOARD_WINDOW_LAST_30_DAYS": 2,
+		"LEADERBOARD_WINDOW_LAST_90_DAYS": 3,
+		"LEADERBOARD_WINDOW_ALL_TIME":     4,
+	}
+)
+
+func (x LeaderboardWindow) Enum() *LeaderboardWindow {
+	p := new(LeaderboardWindow)
+	*p = x
+	return p
+}
+
+func (x LeaderboardWindow) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardWindow) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[1].Descriptor()
+}
+
+func (LeaderboardWindow) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[1]
+}
+
+func (x LeaderboardWindow) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardWindow.Descriptor instead.
+func (LeaderboardWindow) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{1}
+}
+
+type LeaderboardMetric int32
+
+const (
+	LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED LeaderboardMetric = 0
+	LeaderboardMetric_LEADERBOARD_METRIC_PROFIT      LeaderboardMetric = 1
+	LeaderboardMetric_LEADERBOARD_METRIC_ROI         LeaderboardMetric = 2
+	LeaderboardMetric_LEADERBOARD_METRIC_STRIKE_RATE LeaderboardMetric = 3
+)
+
+// Enum value maps for LeaderboardMetric.
+var (
+	LeaderboardMetric_name = map[int32]string{
+		0: "LEADERBOARD_METRIC_UNSPECIFIED",
+		1: "LEADERBOARD_METRIC_PROFIT",
+		2: "LEADERBOARD_METRIC_ROI",
+		3: "LEADERBOARD_METRIC_STRIKE_RATE",
+	}
+	LeaderboardMetric_value = map[string]int32{
+		"LEADERBOARD_METRIC_UNSPECIFIED": 0,
+		"LEADERBOARD_METRIC_PROFIT":      1,
+		"LEADERBOARD_METRIC_ROI":         2,
+		"LEADERBOARD_METRIC_STRIKE_RATE": 3,
+	}
+)
+
+func (x LeaderboardMetric) Enum() *LeaderboardMetric {
+	p := new(LeaderboardMetric)
+	*p = x
+	return p
+}
+
+func (x LeaderboardMetric) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardMetric) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[2].Descriptor()
+}
+
+func (LeaderboardMetric) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[2]
+}
+
+func (x LeaderboardMetric) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardMetric.Descriptor instead.
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{2}
+}
+
+// -------------------
+// Tip ledger
+// -------------------
+// Every tip a tipster posts, gets settled or deletes is appended to their
+// ledger.
+// Each entry commits to the hash of the entry before it, the tip and the
+// server time, so a record cannot be rewritten without it showing. Tips
+// posted before the ledger existed are not covered
+type LedgerIssueKind int32
+
+const (
+	LedgerIssueKind_LEDGER_ISSUE_KIND_UNSPECIFIED LedgerIssueKind = 0
+	// Entries are missing before the entry
+	LedgerIssueKind_LEDGER_ISSUE_KIND_GAP LedgerIssueKind = 1
+	// The entry does not commit to the entry before it
+	LedgerIssueKind_LEDGER_ISSUE_KIND_BROKEN_LINK LedgerIssueKind = 2
+	// The entry was changed after it was appended
+	LedgerIssueKind_LEDGER_ISSUE_KIND_ENTRY_MUTATED LedgerIssueKind = 3
+	// The tip of the entry was deleted without an entry recording it, e.g.
+	// after it lost
+	LedgerIssueKind_LEDGER_ISSUE_KIND_TIP_DELETED LedgerIssueKind = 4
+	// The tip no longer matches what the entry recorded
+	LedgerIssueKind_LEDGER_ISSUE_KIND_TIP_MUTATED LedgerIssueKind = 5
+)
+
+// Enum value maps for LedgerIssueKind.
+var (
+	LedgerIssueKind_name = map[int32]string{
+		0: "LEDGER_ISSUE_KIND_UNSPECIFIED",
+		1: "LEDGER_ISSUE_KIND_GAP",
+		2: "LEDGER_ISSUE_KIND_BROKEN_LINK",
+		3: "LEDGER_ISSUE_KIND_ENTRY_MUTATED",
+		4: "LEDGER_ISSUE_KIND_TIP_DELETED",
+		5: "LEDGER_ISSUE_KIND_TIP_MUTATED",
+	}
+	LedgerIssueKind_value = map[string]int32{
+		"LEDGER_ISSUE_KIND_UNSPECIFIED":   0,
+		"LEDGER_ISSUE_KIND_GAP":           1,
+		"LEDGER_ISSUE_KIND_BROKEN_LINK":   2,
+		"LEDGER_ISSUE_KIND_ENTRY_MUTATED": 3,
+		"LEDGER_ISSUE_KIND_TIP_DELETED":   4,
+		"LEDGER_ISSUE_KIND_TIP_MUTATED":   5,
+	}
+)
+
+func (x LedgerIssueKind) Enum() *LedgerIssueKind {
+	p := new(LedgerIssueKind)
+	*p = x
+	return p
+}
+
+func (x LedgerIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[3].Descriptor()
+}
+
+func (LedgerIssueKind) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[3]
+}
+
+func (x LedgerIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerIssueKind.Descriptor instead.
+func (LedgerIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{3}
+}
+
+type OddsFormat int32
+
+const (
+	OddsFormat_ODDS_FORMAT_UNSPECIFIED OddsFormat = 0
+	// E.g. "2.50"
+	OddsFormat_ODDS_FORMAT_DECIMAL OddsFormat = 1
+	// E.g. "6/4" or "evens"
+	OddsFormat_ODDS_FORMAT_FRACTIONAL OddsFormat = 2
+	// Moneyline, e.g. "+150" or "-200"
+	OddsFormat_ODDS_FORMAT_AMERICAN OddsFormat = 3
+)
+
+// Enum value maps for OddsFormat.
+var (
+	OddsFormat_name = map[int32]string{
+		0: "ODDS_FORMAT_UNSPECIFIED",
+		1: "ODDS_FORMAT_DECIMAL",
+		2: "ODDS_FORMAT_FRACTIONAL",
+		3: "ODDS_FORMAT_AMERICAN",
+	}
+	OddsFormat_value = map[string]int32{
+		"ODDS_FORMAT_UNSPECIFIED": 0,
+		"ODDS_FORMAT_DECIMAL":     1,
+		"ODDS_FORMAT_FRACTIONAL":  2,
+		"ODDS_FORMAT_AMERICAN":    3,
+	}
+)
+
+func (x OddsFormat) Enum() *OddsFormat {
+	p := new(OddsFormat)
+	*p = x
+	return p
+}
+
+func (x OddsFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OddsFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[4].Descriptor()
+}
+
+func (OddsFormat) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[4]
+}
+
+func (x OddsFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OddsFormat.Descriptor instead.
+func (OddsFormat) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{4}
+}
+
+type SelfExclusionPeriod int32
+
+const (
+	SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_UNSPECIFIED SelfExclusionPeriod = 0
+	SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_24_HOURS    SelfExclusionPeriod = 1
+	SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_7_DAYS      SelfExclusionPeriod = 2
+	SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_6_MONTHS    SelfExclusionPeriod = 3
+	SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_PERMANENT   SelfExclusionPeriod = 4
+)
+
+// Enum value maps for SelfExclusionPeriod.
+var (
+	SelfExclusionPeriod_name = map[int32]string{
+		0: "SELF_EXCLUSION_PERIOD_UNSPECIFIED",
+		1: "SELF_EXCLUSION_PERIOD_24_HOURS",
+		2: "SELF_EXCLUSION_PERIOD_7_DAYS",
+		3: "SELF_EXCLUSION_PERIOD_6_MONTHS",
+		4: "SELF_EXCLUSION_PERIOD_PERMANENT",
+	}
+	SelfExclusionPeriod_value = map[string]int32{
+		"SELF_EXCLUSION_PERIOD_UNSPECIFIED": 0,
+		"SELF_EXCLUSION_PERIOD_24_HOURS":    1,
+		"SELF_EXCLUSION_PERIOD_7_DAYS":      2,
+		"SELF_EXCLUSION_PERIOD_6_MONTHS":    3,
+		"SELF_EXCLUSION_PERIOD_PERMANENT":   4,
+	}
+)
+
+func (x SelfExclusionPeriod) Enum() *SelfExclusionPeriod {
+	p := new(SelfExclusionPeriod)
+	*p = x
+	return p
+}
+
+func (x SelfExclusionPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SelfExclusionPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[5].Descriptor()
+}
+
+func (SelfExclusionPeriod) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[5]
+}
+
+func (x SelfExclusionPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SelfExclusionPeriod.Descriptor instead.
+func (SelfExclusionPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{5}
+}
+
+// *
+// Who can see a tip. The policy is applied to every read of tips and
+// their comments.
+type ShareType int32
+
+const (
+	ShareType_SHARE_TYPE_UNSPECIFIED ShareType = 0
+	// Everyone
+	ShareType_SHARE_TYPE_PUBLIC ShareType = 1
+	// The tipster and their approved followers
+	ShareType_SHARE_TYPE_FRIENDS_ONLY ShareType = 2
+	// Everyone, until 24 hours after it was shared
+	ShareType_SHARE_TYPE_STORY ShareType = 3
+)
+
+// Enum value maps for ShareType.
+var (
+	ShareType_name = map[int32]string{
+		0: "SHARE_TYPE_UNSPECIFIED",
+		1: "SHARE_TYPE_PUBLIC",
+		2: "SHARE_TYPE_FRIENDS_ONLY",
+		3: "SHARE_TYPE_STORY",
+	}
+	ShareType_value = map[string]int32{
+		"SHARE_TYPE_UNSPECIFIED":  0,
+		"SHARE_TYPE_PUBLIC":       1,
+		"SHARE_TYPE_FRIENDS_ONLY": 2,
+		"SHARE_TYPE_STORY":        3,
+	}
+)
+
+func (x ShareType) Enum() *ShareType {
+	p := new(ShareType)
+	*p = x
+	return p
+}
+
+func (x ShareType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareType) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[6].Descriptor()
+}
+
+func (ShareType) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[6]
+}
+
+func (x ShareType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareType.Descriptor instead.
+func (ShareType) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{6}
+}
+
+// *
+// Roles decide what a user may do beyond managing their own account
+// and content.
+type UserRole int32
+
+const (
+	// Treated as USER_ROLE_PUNTER
+	UserRole_USER_ROLE_UNSPECIFIED UserRole = 0
+	// Follows tipsters and interacts with tips
+	UserRole_USER_ROLE_PUNTER UserRole = 1
+	// Publishes tips
+	UserRole_USER_ROLE_TIPSTER UserRole = 2
+	// May remove any tip or comment
+	UserRole_USER_ROLE_MODERATOR UserRole = 3
+	// May remove any content, manage accounts and assign roles
+	UserRole_USER_ROLE_ADMIN UserRole = 4
+)
+
+// Enum value maps for UserRole.
+var (
+	UserRole_name = map[int32]string{
+		0: "USER_ROLE_UNSPECIFIED",
+		1: "USER_ROLE_PUNTER",
+		2: "USER_ROLE_TIPSTER",
+		3: "USER_ROLE_MODERATOR",
+		4: "USER_ROLE_ADMIN",
+	}
+	UserRole_value = map[string]int32{
+		"USER_ROLE_UNSPECIFIED": 0,
+		"USER_ROLE_PUNTER":      1,
+		"USER_ROLE_TIPSTER":     2,
+		"USER_ROLE_MODERATOR":   3,
+		"USER_ROLE_ADMIN":       4,
+	}
+)
+
+func (x UserRole) Enum() *UserRole {
+	p := new(UserRole)
+	*p = x
+	return p
+}
+
+func (x UserRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UserRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[7].Descriptor()
+}
+
+func (UserRole) Type() protoreflect.EnumType {
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[7]
+}
+
+func (x UserRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UserRole.Descriptor instead.
+func (UserRole) EnumDescriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{7}
+}
+
 // *
 // Defines different possible feed actions.
 type FeedActionType int32
@@ -68,11 +551,11 @@ func (x FeedActionType) String() string {
 }
 
 func (FeedActionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[0].Descriptor()
+	return file_src_protos_Tipster_SocialMessage_proto_enumTypes[8].Descriptor()
 }
 
 func (FeedActionType) Type() protoreflect.EnumType {
-	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[0]
+	return &file_src_protos_Tipster_SocialMessage_proto_enumTypes[8]
 }
 
 func (x FeedActionType) Number() protoreflect.EnumNumber {
@@ -81,7 +564,7 @@ func (x FeedActionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FeedActionType.Descriptor instead.
 func (FeedActionType) EnumDescriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{8}
 }
 
 // -------------------
@@ -295,12 +778,20 @@ func (x *DeleteCommentResponse) GetMsg() string {
 // Create Tip
 // -------------------
 type CreateTipRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TipsterId     string                 `protobuf:"bytes,1,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	ShareType     string                 `protobuf:"bytes,5,opt,name=ShareType,proto3" json:"ShareType,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	TipsterId string   `protobuf:"bytes,1,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+	Title     string   `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content   string   `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+	Tags      []string `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// Defaults to SHARE_TYPE_PUBLIC
+	ShareType ShareType `protobuf:"varint,5,opt,name=ShareType,proto3,enum=protos.Tipster.ShareType" json:"ShareType,omitempty"`
+	// Optional: the bet the tip recommends
+	Selection *TipSelection `protobuf:"bytes,6,opt,name=Selection,proto3" json:"Selection,omitempty"`
+	// The odds format of the returned selection, defaults to decimal
+	OddsFormat    OddsFormat `protobuf:"varint,7,opt,name=OddsFormat,proto3,enum=protos.Tipster.OddsFormat" json:"OddsFormat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -335,6 +826,7 @@ func (*CreateTipRequest) Descriptor() ([]byte, []int) {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{4}
 }
 
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
 func (x *CreateTipRequest) GetTipsterId() string {
 	if x != nil {
 		return x.TipsterId
@@ -363,11 +855,25 @@ func (x *CreateTipRequest) GetTags() []string {
 	return nil
 }
 
-func (x *CreateTipRequest) GetShareType() string {
+func (x *CreateTipRequest) GetShareType() ShareType {
 	if x != nil {
 		return x.ShareType
 	}
-	return ""
+	return ShareType_SHARE_TYPE_UNSPECIFIED
+}
+
+func (x *CreateTipRequest) GetSelection() *TipSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *CreateTipRequest) GetOddsFormat() OddsFormat {
+	if x != nil {
+		return x.OddsFormat
+	}
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
 }
 
 type CreateTipResponse struct {
@@ -434,8 +940,10 @@ func (x *CreateTipResponse) GetData() *TipData {
 // Get Tip
 // -------------------
 type GetTipRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TipId         string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	TipId string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	// The odds format of the returned selection, defaults to decimal
+	OddsFormat    OddsFormat `protobuf:"varint,2,opt,name=OddsFormat,proto3,enum=protos.Tipster.OddsFormat" json:"OddsFormat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -477,6 +985,13 @@ func (x *GetTipRequest) GetTipId() string {
 	return ""
 }
 
+func (x *GetTipRequest) GetOddsFormat() OddsFormat {
+	if x != nil {
+		return x.OddsFormat
+	}
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
+}
+
 type GetTipResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
@@ -540,13 +1055,20 @@ func (x *GetTipResponse) GetData() *TipData {
 // -------------------
 // Update Tip
 // -------------------
+// Replaces the tip. Once the event of the selection starts, or the first
+// leg of an accumulator, the tip is locked: only small fixes to the
+// content are accepted, for a grace period after kick-off, and only
+// moderators can delete it
 type UpdateTipRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	TipId         string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	Title         string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
-	Content       string                 `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	ShareType     string                 `protobuf:"bytes,5,opt,name=ShareType,proto3" json:"ShareType,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	TipId   string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	Title   string                 `protobuf:"bytes,2,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content string                 `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+	Tags    []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// Optional: keeps the current share type when unset
+	ShareType ShareType `protobuf:"varint,5,opt,name=ShareType,proto3,enum=protos.Tipster.ShareType" json:"ShareType,omitempty"`
+	// Optional: replaces the whole selection, keeps the current one when unset
+	Selection     *TipSelection `protobuf:"bytes,6,opt,name=Selection,proto3" json:"Selection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -609,11 +1131,18 @@ func (x *UpdateTipRequest) GetTags() []string {
 	return nil
 }
 
-func (x *UpdateTipRequest) GetShareType() string {
+func (x *UpdateTipRequest) GetShareType() ShareType {
 	if x != nil {
 		return x.ShareType
 	}
-	return ""
+	return ShareType_SHARE_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateTipRequest) GetSelection() *TipSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
 }
 
 type UpdateTipResponse struct {
@@ -669,29 +1198,34 @@ func (x *UpdateTipResponse) GetMsg() string {
 }
 
 // -------------------
-// Delete Tip
+// Tip Revisions
 // -------------------
-type DeleteTipRequest struct {
+// The editable content of a tip as of one revision
+type TipVersionData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TipId         string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	Title         string                 `protobuf:"bytes,1,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=Content,proto3" json:"Content,omitempty"`
+	Tags          []string               `protobuf:"bytes,3,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	ShareType     ShareType              `protobuf:"varint,4,opt,name=ShareType,proto3,enum=protos.Tipster.ShareType" json:"ShareType,omitempty"`
+	Selection     *TipSelection          `protobuf:"bytes,5,opt,name=Selection,proto3" json:"Selection,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTipRequest) Reset() {
-	*x = DeleteTipRequest{}
+func (x *TipVersionData) Reset() {
+	*x = TipVersionData{}
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTipRequest) String() string {
+func (x *TipVersionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTipRequest) ProtoMessage() {}
+func (*TipVersionData) ProtoMessage() {}
 
-func (x *DeleteTipRequest) ProtoReflect() protoreflect.Message {
+func (x *TipVersionData) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -703,40 +1237,77 @@ func (x *DeleteTipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTipRequest.ProtoReflect.Descriptor instead.
-func (*DeleteTipRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TipVersionData.ProtoReflect.Descriptor instead.
+func (*TipVersionData) Descriptor() ([]byte, []int) {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *DeleteTipRequest) GetTipId() string {
+func (x *TipVersionData) GetTitle() string {
 	if x != nil {
-		return x.TipId
+		return x.Title
 	}
 	return ""
 }
 
-type DeleteTipResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+func (x *TipVersionData) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TipVersionData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TipVersionData) GetShareType() ShareType {
+	if x != nil {
+		return x.ShareType
+	}
+	return ShareType_SHARE_TYPE_UNSPECIFIED
+}
+
+func (x *TipVersionData) GetSelection() *TipSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+// One edit of a tip
+type TipRevisionData struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	RevisionId string                 `protobuf:"bytes,1,opt,name=RevisionId,proto3" json:"RevisionId,omitempty"`
+	// Revisions are numbered from 1 in the order they were made
+	Number   int64                  `protobuf:"varint,2,opt,name=Number,proto3" json:"Number,omitempty"`
+	EditorId string                 `protobuf:"bytes,3,opt,name=EditorId,proto3" json:"EditorId,omitempty"`
+	EditedAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=EditedAt,proto3" json:"EditedAt,omitempty"`
+	// The fields the edit changed: title, content, tags, shareType or
+	// selection
+	ChangedFields []string        `protobuf:"bytes,5,rep,name=ChangedFields,proto3" json:"ChangedFields,omitempty"`
+	Before        *TipVersionData `protobuf:"bytes,6,opt,name=Before,proto3" json:"Before,omitempty"`
+	After         *TipVersionData `protobuf:"bytes,7,opt,name=After,proto3" json:"After,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteTipResponse) Reset() {
-	*x = DeleteTipResponse{}
+func (x *TipRevisionData) Reset() {
+	*x = TipRevisionData{}
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteTipResponse) String() string {
+func (x *TipRevisionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteTipResponse) ProtoMessage() {}
+func (*TipRevisionData) ProtoMessage() {}
 
-func (x *DeleteTipResponse) ProtoReflect() protoreflect.Message {
+func (x *TipRevisionData) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -748,51 +1319,87 @@ func (x *DeleteTipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteTipResponse.ProtoReflect.Descriptor instead.
-func (*DeleteTipResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TipRevisionData.ProtoReflect.Descriptor instead.
+func (*TipRevisionData) Descriptor() ([]byte, []int) {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *DeleteTipResponse) GetCode() string {
+func (x *TipRevisionData) GetRevisionId() string {
 	if x != nil {
-		return x.Code
+		return x.RevisionId
 	}
 	return ""
 }
 
-func (x *DeleteTipResponse) GetMsg() string {
+func (x *TipRevisionData) GetNumber() int64 {
 	if x != nil {
-		return x.Msg
+		return x.Number
+	}
+	return 0
+}
+
+func (x *TipRevisionData) GetEditorId() string {
+	if x != nil {
+		return x.EditorId
 	}
 	return ""
 }
 
-// -------------------
-// List Tips
-// -------------------
-type ListTipsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
-	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
-	TipsterId     string                 `protobuf:"bytes,3,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"` // Optional: to filter tips by tipster
+func (x *TipRevisionData) GetEditedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedAt
+	}
+	return nil
+}
+
+func (x *TipRevisionData) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *TipRevisionData) GetBefore() *TipVersionData {
+	if x != nil {
+		return x.Before
+	}
+	return nil
+}
+
+func (x *TipRevisionData) GetAfter() *TipVersionData {
+	if x != nil {
+		return x.After
+	}
+	return nil
+}
+
+// Lists the edits of a tip, oldest first. Revisions are never changed, so
+// anyone who can see the tip can check how it read at any time
+type ListTipRevisionsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	TipId      string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	PageSize   int32                  `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor string                 `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	// The odds format of the returned selections, defaults to decimal
+	OddsFormat    OddsFormat `protobuf:"varint,4,opt,name=OddsFormat,proto3,enum=protos.Tipster.OddsFormat" json:"OddsFormat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTipsRequest) Reset() {
-	*x = ListTipsRequest{}
+func (x *ListTipRevisionsRequest) Reset() {
+	*x = ListTipRevisionsRequest{}
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTipsRequest) String() string {
+func (x *ListTipRevisionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTipsRequest) ProtoMessage() {}
+func (*ListTipRevisionsRequest) ProtoMessage() {}
 
-func (x *ListTipsRequest) ProtoReflect() protoreflect.Message {
+func (x *ListTipRevisionsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -804,55 +1411,62 @@ func (x *ListTipsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTipsRequest.ProtoReflect.Descriptor instead.
-func (*ListTipsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTipRevisionsRequest.ProtoReflect.Descriptor instead.
+func (*ListTipRevisionsRequest) Descriptor() ([]byte, []int) {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ListTipsRequest) GetPageSize() int32 {
+func (x *ListTipRevisionsRequest) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+func (x *ListTipRevisionsRequest) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-func (x *ListTipsRequest) GetNextCursor() string {
+func (x *ListTipRevisionsRequest) GetNextCursor() string {
 	if x != nil {
 		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *ListTipsRequest) GetTipsterId() string {
+func (x *ListTipRevisionsRequest) GetOddsFormat() OddsFormat {
 	if x != nil {
-		return x.TipsterId
+		return x.OddsFormat
 	}
-	return ""
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
 }
 
-type ListTipsResponse struct {
-	state         protoimpl.MessageState         `protogen:"open.v1"`
-	Code          string                         `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                         `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *ListTipsResponse_ListTipsData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type ListTipRevisionsResponse struct {
+	state         protoimpl.MessageState                         `protogen:"open.v1"`
+	Code          string                                         `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                         `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListTipRevisionsResponse_ListTipRevisionsData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTipsResponse) Reset() {
-	*x = ListTipsResponse{}
+func (x *ListTipRevisionsResponse) Reset() {
+	*x = ListTipRevisionsResponse{}
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTipsResponse) String() string {
+func (x *ListTipRevisionsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTipsResponse) ProtoMessage() {}
+func (*ListTipRevisionsResponse) ProtoMessage() {}
 
-func (x *ListTipsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListTipRevisionsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -864,26 +1478,26 @@ func (x *ListTipsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTipsResponse.ProtoReflect.Descriptor instead.
-func (*ListTipsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListTipRevisionsResponse.ProtoReflect.Descriptor instead.
+func (*ListTipRevisionsResponse) Descriptor() ([]byte, []int) {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ListTipsResponse) GetCode() string {
+func (x *ListTipRevisionsResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ListTipsResponse) GetMsg() string {
+func (x *ListTipRevisionsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListTipsResponse) GetData() *ListTipsResponse_ListTipsData {
+func (x *ListTipRevisionsResponse) GetData() *ListTipRevisionsResponse_ListTipRevisionsData {
 	if x != nil {
 		return x.Data
 	}
@@ -891,38 +1505,29 @@ func (x *ListTipsResponse) GetData() *ListTipsResponse_ListTipsData {
 }
 
 // -------------------
-// Common Tip Data Structure
+// Delete Tip
 // -------------------
-type TipData struct {
+type DeleteTipRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	TipId         string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	TipsterId     string                 `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
-	Content       string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
-	Tags          []string               `protobuf:"bytes,5,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	Likes         []*UserDetail          `protobuf:"bytes,6,rep,name=Likes,proto3" json:"Likes,omitempty"`
-	Unlikes       []*UserDetail          `protobuf:"bytes,7,rep,name=Unlikes,proto3" json:"Unlikes,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
-	ShareType     string                 `protobuf:"bytes,10,opt,name=ShareType,proto3" json:"ShareType,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TipData) Reset() {
-	*x = TipData{}
+func (x *DeleteTipRequest) Reset() {
+	*x = DeleteTipRequest{}
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TipData) String() string {
+func (x *DeleteTipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TipData) ProtoMessage() {}
+func (*DeleteTipRequest) ProtoMessage() {}
 
-func (x *TipData) ProtoReflect() protoreflect.Message {
+func (x *DeleteTipRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -934,109 +1539,99 @@ func (x *TipData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TipData.ProtoReflect.Descriptor instead.
-func (*TipData) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteTipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTipRequest) Descriptor() ([]byte, []int) {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *TipData) GetTipId() string {
+func (x *DeleteTipRequest) GetTipId() string {
 	if x != nil {
 		return x.TipId
 	}
 	return ""
 }
 
-func (x *TipData) GetTipsterId() string {
-	if x != nil {
-		return x.TipsterId
-	}
-	return ""
-}
-
-func (x *TipData) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+type DeleteTipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TipData) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *DeleteTipResponse) Reset() {
+	*x = DeleteTipResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *TipData) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
+func (x *DeleteTipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *TipData) GetLikes() []*UserDetail {
-	if x != nil {
-		return x.Likes
-	}
-	return nil
-}
+func (*DeleteTipResponse) ProtoMessage() {}
 
-func (x *TipData) GetUnlikes() []*UserDetail {
+func (x *DeleteTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[15]
 	if x != nil {
-		return x.Unlikes
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *TipData) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
+// Deprecated: Use DeleteTipResponse.ProtoReflect.Descriptor instead.
+func (*DeleteTipResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *TipData) GetUpdatedAt() *timestamppb.Timestamp {
+func (x *DeleteTipResponse) GetCode() string {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *TipData) GetShareType() string {
+func (x *DeleteTipResponse) GetMsg() string {
 	if x != nil {
-		return x.ShareType
+		return x.Msg
 	}
 	return ""
 }
 
 // -------------------
-// Create User
+// List Tips
 // -------------------
-type CreateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserName      string                 `protobuf:"bytes,1,opt,name=UserName,proto3" json:"UserName,omitempty"`
-	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+type ListTipsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PageSize   int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	TipsterId  string                 `protobuf:"bytes,3,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"` // Optional: to filter tips by tipster
+	// The odds format of the returned selections, defaults to decimal
+	OddsFormat    OddsFormat `protobuf:"varint,4,opt,name=OddsFormat,proto3,enum=protos.Tipster.OddsFormat" json:"OddsFormat,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserRequest) Reset() {
-	*x = CreateUserRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[15]
+func (x *ListTipsRequest) Reset() {
+	*x = ListTipsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserRequest) String() string {
+func (x *ListTipsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserRequest) ProtoMessage() {}
+func (*ListTipsRequest) ProtoMessage() {}
 
-func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[15]
+func (x *ListTipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1047,64 +1642,4406 @@ func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ListTipsRequest.ProtoReflect.Descriptor instead.
+func (*ListTipsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *CreateUserRequest) GetUserName() string {
+func (x *ListTipsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.UserName
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateUserRequest) GetEmail() string {
+func (x *ListTipsRequest) GetNextCursor() string {
 	if x != nil {
-		return x.Email
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetPassword() string {
+func (x *ListTipsRequest) GetTipsterId() string {
 	if x != nil {
-		return x.Password
+		return x.TipsterId
 	}
 	return ""
 }
 
-func (x *CreateUserRequest) GetTags() []string {
+func (x *ListTipsRequest) GetOddsFormat() OddsFormat {
 	if x != nil {
-		return x.Tags
+		return x.OddsFormat
 	}
-	return nil
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
 }
 
-// User registration response message
-type CreateUserResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Code          string                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *CreateUserResponse_UserData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type ListTipsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Code          string                         `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                         `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListTipsResponse_ListTipsData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateUserResponse) Reset() {
-	*x = CreateUserResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[16]
+func (x *ListTipsResponse) Reset() {
+	*x = ListTipsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTipsResponse) ProtoMessage() {}
+
+func (x *ListTipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTipsResponse.ProtoReflect.Descriptor instead.
+func (*ListTipsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListTipsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListTipsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListTipsResponse) GetData() *ListTipsResponse_ListTipsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+// Common Tip Data Structure
+// -------------------
+type TipData struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TipId     string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	TipsterId string                 `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+	Title     string                 `protobuf:"bytes,3,opt,name=Title,proto3" json:"Title,omitempty"`
+	Content   string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	Tags      []string               `protobuf:"bytes,5,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	Likes     []*UserDetail          `protobuf:"bytes,6,rep,name=Likes,proto3" json:"Likes,omitempty"`
+	Unlikes   []*UserDetail          `protobuf:"bytes,7,rep,name=Unlikes,proto3" json:"Unlikes,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	ShareType ShareType              `protobuf:"varint,10,opt,name=ShareType,proto3,enum=protos.Tipster.ShareType" json:"ShareType,omitempty"`
+	// Set for stories, which disappear at this time
+	ExpiresAt *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	// How many times the tip was reshared
+	ShareCount int32 `protobuf:"varint,12,opt,name=ShareCount,proto3" json:"ShareCount,omitempty"`
+	// Unset for tips without a structured selection
+	Selection *TipSelection `protobuf:"bytes,13,opt,name=Selection,proto3" json:"Selection,omitempty"`
+	// Unset until the tip is settled
+	Settlement *TipSettlement `protobuf:"bytes,14,opt,name=Settlement,proto3" json:"Settlement,omitempty"`
+	// Set once the event of the selection started, see UpdateTipRequest
+	Locked bool `protobuf:"varint,15,opt,name=Locked,proto3" json:"Locked,omitempty"`
+	// Set once the tip was changed after it was posted
+	Edited bool `protobuf:"varint,16,opt,name=Edited,proto3" json:"Edited,omitempty"`
+	// How many edits ListTipRevisions lists
+	RevisionCount int64 `protobuf:"varint,17,opt,name=RevisionCount,proto3" json:"RevisionCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TipData) Reset() {
+	*x = TipData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TipData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipData) ProtoMessage() {}
+
+func (x *TipData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipData.ProtoReflect.Descriptor instead.
+func (*TipData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TipData) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+func (x *TipData) GetTipsterId() string {
+	if x != nil {
+		return x.TipsterId
+	}
+	return ""
+}
+
+func (x *TipData) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TipData) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *TipData) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *TipData) GetLikes() []*UserDetail {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *TipData) GetUnlikes() []*UserDetail {
+	if x != nil {
+		return x.Unlikes
+	}
+	return nil
+}
+
+func (x *TipData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TipData) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *TipData) GetShareType() ShareType {
+	if x != nil {
+		return x.ShareType
+	}
+	return ShareType_SHARE_TYPE_UNSPECIFIED
+}
+
+func (x *TipData) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *TipData) GetShareCount() int32 {
+	if x != nil {
+		return x.ShareCount
+	}
+	return 0
+}
+
+func (x *TipData) GetSelection() *TipSelection {
+	if x != nil {
+		return x.Selection
+	}
+	return nil
+}
+
+func (x *TipData) GetSettlement() *TipSettlement {
+	if x != nil {
+		return x.Settlement
+	}
+	return nil
+}
+
+func (x *TipData) GetLocked() bool {
+	if x != nil {
+		return x.Locked
+	}
+	return false
+}
+
+func (x *TipData) GetEdited() bool {
+	if x != nil {
+		return x.Edited
+	}
+	return false
+}
+
+func (x *TipData) GetRevisionCount() int64 {
+	if x != nil {
+		return x.RevisionCount
+	}
+	return 0
+}
+
+// -------------------
+// Tip Selection
+// -------------------
+// The bet a tip recommends. Accumulators have two or more Legs; their
+// Sport, Competition, EventName, EventStartsAt, Market, Selection and Price
+// are then derived from the legs and ignored on input
+type TipSelection struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sport string                 `protobuf:"bytes,1,opt,name=Sport,proto3" json:"Sport,omitempty"`
+	// Optional, e.g. "Premier League"
+	Competition string `protobuf:"bytes,2,opt,name=Competition,proto3" json:"Competition,omitempty"`
+	// E.g. "Arsenal v Chelsea"
+	EventName string `protobuf:"bytes,3,opt,name=EventName,proto3" json:"EventName,omitempty"`
+	// Kick-off, must be in the future when the selection is set
+	EventStartsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=EventStartsAt,proto3" json:"EventStartsAt,omitempty"`
+	// E.g. "Match Result" or "Total Goals"
+	Market string `protobuf:"bytes,5,opt,name=Market,proto3" json:"Market,omitempty"`
+	// The outcome picked in the market, e.g. "Arsenal" or "Over 2.5"
+	Selection string `protobuf:"bytes,6,opt,name=Selection,proto3" json:"Selection,omitempty"`
+	// Deprecated: use Price. Decimal odds, read only when Price is empty
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	Odds float64 `protobuf:"fixed64,7,opt,name=Odds,proto3" json:"Odds,omitempty"`
+	// Stake in units, greater than 0 and at most 100
+	Stake float64 `protobuf:"fixed64,8,opt,name=Stake,proto3" json:"Stake,omitempty"`
+	// The odds written in PriceFormat, e.g. "2.50", "6/4", "evens" or "+150".
+	// Odds are stored exactly, so any format can be read back without loss
+	Price string `protobuf:"bytes,9,opt,name=Price,proto3" json:"Price,omitempty"`
+	// Defaults to decimal
+	PriceFormat OddsFormat `protobuf:"varint,10,opt,name=PriceFormat,proto3,enum=protos.Tipster.OddsFormat" json:"PriceFormat,omitempty"`
+	// Output only: 1 / decimal odds
+	ImpliedProbability float64 `protobuf:"fixed64,11,opt,name=ImpliedProbability,proto3" json:"ImpliedProbability,omitempty"`
+	// Accumulators only, at most 20
+	Legs          []*TipLeg `protobuf:"bytes,12,rep,name=Legs,proto3" json:"Legs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TipSelection) Reset() {
+	*x = TipSelection{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TipSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipSelection) ProtoMessage() {}
+
+func (x *TipSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipSelection.ProtoReflect.Descriptor instead.
+func (*TipSelection) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TipSelection) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+func (x *TipSelection) GetCompetition() string {
+	if x != nil {
+		return x.Competition
+	}
+	return ""
+}
+
+func (x *TipSelection) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *TipSelection) GetEventStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventStartsAt
+	}
+	return nil
+}
+
+func (x *TipSelection) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *TipSelection) GetSelection() string {
+	if x != nil {
+		return x.Selection
+	}
+	return ""
+}
+
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *TipSelection) GetOdds() float64 {
+	if x != nil {
+		return x.Odds
+	}
+	return 0
+}
+
+func (x *TipSelection) GetStake() float64 {
+	if x != nil {
+		return x.Stake
+	}
+	return 0
+}
+
+func (x *TipSelection) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *TipSelection) GetPriceFormat() OddsFormat {
+	if x != nil {
+		return x.PriceFormat
+	}
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
+}
+
+func (x *TipSelection) GetImpliedProbability() float64 {
+	if x != nil {
+		return x.ImpliedProbability
+	}
+	return 0
+}
+
+func (x *TipSelection) GetLegs() []*TipLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+// One selection of an accumulator
+type TipLeg struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Sport string                 `protobuf:"bytes,1,opt,name=Sport,proto3" json:"Sport,omitempty"`
+	// Optional
+	Competition string `protobuf:"bytes,2,opt,name=Competition,proto3" json:"Competition,omitempty"`
+	EventName   string `protobuf:"bytes,3,opt,name=EventName,proto3" json:"EventName,omitempty"`
+	// Kick-off, must be in the future when the selection is set
+	EventStartsAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=EventStartsAt,proto3" json:"EventStartsAt,omitempty"`
+	Market        string                 `protobuf:"bytes,5,opt,name=Market,proto3" json:"Market,omitempty"`
+	Selection     string                 `protobuf:"bytes,6,opt,name=Selection,proto3" json:"Selection,omitempty"`
+	// The odds written in PriceFormat
+	Price string `protobuf:"bytes,7,opt,name=Price,proto3" json:"Price,omitempty"`
+	// Defaults to decimal
+	PriceFormat OddsFormat `protobuf:"varint,8,opt,name=PriceFormat,proto3,enum=protos.Tipster.OddsFormat" json:"PriceFormat,omitempty"`
+	// Output only: 1 / decimal odds
+	ImpliedProbability float64 `protobuf:"fixed64,9,opt,name=ImpliedProbability,proto3" json:"ImpliedProbability,omitempty"`
+	// Output only: unspecified until the leg is settled
+	Result        TipResult `protobuf:"varint,10,opt,name=Result,proto3,enum=protos.Tipster.TipResult" json:"Result,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TipLeg) Reset() {
+	*x = TipLeg{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TipLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipLeg) ProtoMessage() {}
+
+func (x *TipLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipLeg.ProtoReflect.Descriptor instead.
+func (*TipLeg) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *TipLeg) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+func (x *TipLeg) GetCompetition() string {
+	if x != nil {
+		return x.Competition
+	}
+	return ""
+}
+
+func (x *TipLeg) GetEventName() string {
+	if x != nil {
+		return x.EventName
+	}
+	return ""
+}
+
+func (x *TipLeg) GetEventStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EventStartsAt
+	}
+	return nil
+}
+
+func (x *TipLeg) GetMarket() string {
+	if x != nil {
+		return x.Market
+	}
+	return ""
+}
+
+func (x *TipLeg) GetSelection() string {
+	if x != nil {
+		return x.Selection
+	}
+	return ""
+}
+
+func (x *TipLeg) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *TipLeg) GetPriceFormat() OddsFormat {
+	if x != nil {
+		return x.PriceFormat
+	}
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
+}
+
+func (x *TipLeg) GetImpliedProbability() float64 {
+	if x != nil {
+		return x.ImpliedProbability
+	}
+	return 0
+}
+
+func (x *TipLeg) GetResult() TipResult {
+	if x != nil {
+		return x.Result
+	}
+	return TipResult_TIP_RESULT_UNSPECIFIED
+}
+
+type TipSettlement struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Result TipResult              `protobuf:"varint,1,opt,name=Result,proto3,enum=protos.Tipster.TipResult" json:"Result,omitempty"`
+	// Profit in units from the selection's odds and stake, negative for a loss
+	Profit    float64                `protobuf:"fixed64,2,opt,name=Profit,proto3" json:"Profit,omitempty"`
+	SettledAt *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=SettledAt,proto3" json:"SettledAt,omitempty"`
+	// The tipster or moderator who settled the tip
+	SettledBy     string `protobuf:"bytes,4,opt,name=SettledBy,proto3" json:"SettledBy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TipSettlement) Reset() {
+	*x = TipSettlement{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TipSettlement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipSettlement) ProtoMessage() {}
+
+func (x *TipSettlement) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipSettlement.ProtoReflect.Descriptor instead.
+func (*TipSettlement) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *TipSettlement) GetResult() TipResult {
+	if x != nil {
+		return x.Result
+	}
+	return TipResult_TIP_RESULT_UNSPECIFIED
+}
+
+func (x *TipSettlement) GetProfit() float64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+func (x *TipSettlement) GetSettledAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledAt
+	}
+	return nil
+}
+
+func (x *TipSettlement) GetSettledBy() string {
+	if x != nil {
+		return x.SettledBy
+	}
+	return ""
+}
+
+// Records the result of a tip with a selection. Only the tipster,
+// moderators and admins can settle a tip, only once, and only after the
+// event started unless the result is void.
+//
+// Accumulators are settled leg by leg. A void or pushed leg drops out of
+// the combined odds, half results count half the stake on the leg, and a
+// lost leg loses the accumulator. The accumulator is settled as soon as a
+// leg is lost or every leg is settled
+type SettleTipRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	TipId  string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	Result TipResult              `protobuf:"varint,2,opt,name=Result,proto3,enum=protos.Tipster.TipResult" json:"Result,omitempty"`
+	// Accumulators only: the leg to settle, starting at 1
+	Leg           int32 `protobuf:"varint,3,opt,name=Leg,proto3" json:"Leg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleTipRequest) Reset() {
+	*x = SettleTipRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTipRequest) ProtoMessage() {}
+
+func (x *SettleTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTipRequest.ProtoReflect.Descriptor instead.
+func (*SettleTipRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *SettleTipRequest) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+func (x *SettleTipRequest) GetResult() TipResult {
+	if x != nil {
+		return x.Result
+	}
+	return TipResult_TIP_RESULT_UNSPECIFIED
+}
+
+func (x *SettleTipRequest) GetLeg() int32 {
+	if x != nil {
+		return x.Leg
+	}
+	return 0
+}
+
+type SettleTipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Code  string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg   string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	// Unset while an accumulator still has legs to settle
+	Data *TipSettlement `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	// Accumulators only: the result of every leg so far
+	LegResults    []TipResult `protobuf:"varint,4,rep,packed,name=LegResults,proto3,enum=protos.Tipster.TipResult" json:"LegResults,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SettleTipResponse) Reset() {
+	*x = SettleTipResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SettleTipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SettleTipResponse) ProtoMessage() {}
+
+func (x *SettleTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SettleTipResponse.ProtoReflect.Descriptor instead.
+func (*SettleTipResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SettleTipResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SettleTipResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SettleTipResponse) GetData() *TipSettlement {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *SettleTipResponse) GetLegResults() []TipResult {
+	if x != nil {
+		return x.LegResults
+	}
+	return nil
+}
+
+// Lists tips with a selection whose event started but that are not
+// settled, in the order they were posted. Tipsters see their own tips,
+// moderators and admins everyone's
+type ListUnsettledTipsRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	PageSize   int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	// Optional for moderators and admins
+	TipsterId string `protobuf:"bytes,3,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+	// The odds format of the returned selections, defaults to decimal
+	OddsFormat    OddsFormat `protobuf:"varint,4,opt,name=OddsFormat,proto3,enum=protos.Tipster.OddsFormat" json:"OddsFormat,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnsettledTipsRequest) Reset() {
+	*x = ListUnsettledTipsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnsettledTipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnsettledTipsRequest) ProtoMessage() {}
+
+func (x *ListUnsettledTipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnsettledTipsRequest.ProtoReflect.Descriptor instead.
+func (*ListUnsettledTipsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListUnsettledTipsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUnsettledTipsRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListUnsettledTipsRequest) GetTipsterId() string {
+	if x != nil {
+		return x.TipsterId
+	}
+	return ""
+}
+
+func (x *ListUnsettledTipsRequest) GetOddsFormat() OddsFormat {
+	if x != nil {
+		return x.OddsFormat
+	}
+	return OddsFormat_ODDS_FORMAT_UNSPECIFIED
+}
+
+type ListUnsettledTipsResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Code          string                         `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                         `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListTipsResponse_ListTipsData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUnsettledTipsResponse) Reset() {
+	*x = ListUnsettledTipsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUnsettledTipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUnsettledTipsResponse) ProtoMessage() {}
+
+func (x *ListUnsettledTipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUnsettledTipsResponse.ProtoReflect.Descriptor instead.
+func (*ListUnsettledTipsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ListUnsettledTipsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListUnsettledTipsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUnsettledTipsResponse) GetData() *ListTipsResponse_ListTipsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+// Tipster Stats
+// -------------------
+// The record of a tipster's settled tips. Void and pushed tips return the
+// stake, so they count towards Tips but not towards the stake, odds,
+// strike rate or streaks
+type TipsterStats struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TipsterId string                 `protobuf:"bytes,1,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+	Tips      int64                  `protobuf:"varint,2,opt,name=Tips,proto3" json:"Tips,omitempty"`
+	Won       int64                  `protobuf:"varint,3,opt,name=Won,proto3" json:"Won,omitempty"`
+	Lost      int64                  `protobuf:"varint,4,opt,name=Lost,proto3" json:"Lost,omitempty"`
+	Void      int64                  `protobuf:"varint,5,opt,name=Void,proto3" json:"Void,omitempty"`
+	Push      int64                  `protobuf:"varint,6,opt,name=Push,proto3" json:"Push,omitempty"`
+	HalfWon   int64                  `protobuf:"varint,7,opt,name=HalfWon,proto3" json:"HalfWon,omitempty"`
+	HalfLost  int64                  `protobuf:"varint,8,opt,name=HalfLost,proto3" json:"HalfLost,omitempty"`
+	// Percentage of tips that won or half-won
+	StrikeRate  float64 `protobuf:"fixed64,9,opt,name=StrikeRate,proto3" json:"StrikeRate,omitempty"`
+	UnitsStaked float64 `protobuf:"fixed64,10,opt,name=UnitsStaked,proto3" json:"UnitsStaked,omitempty"`
+	// Profit in units, negative for a loss
+	Profit float64 `protobuf:"fixed64,11,opt,name=Profit,proto3" json:"Profit,omitempty"`
+	// Profit per unit staked in percent, also called yield
+	Roi float64 `protobuf:"fixed64,12,opt,name=Roi,proto3" json:"Roi,omitempty"`
+	// Mean decimal odds
+	AverageOdds float64 `protobuf:"fixed64,13,opt,name=AverageOdds,proto3" json:"AverageOdds,omitempty"`
+	// Half-won tips extend winning streaks, half-lost tips losing streaks
+	LongestWinningStreak int64 `protobuf:"varint,14,opt,name=LongestWinningStreak,proto3" json:"LongestWinningStreak,omitempty"`
+	LongestLosingStreak  int64 `protobuf:"varint,15,opt,name=LongestLosingStreak,proto3" json:"LongestLosingStreak,omitempty"`
+	// Tips that won or lost, fully or half. Void and pushed tips return the
+	// stake and are left out, as they are of every ratio
+	DecidedTips   int64 `protobuf:"varint,16,opt,name=DecidedTips,proto3" json:"DecidedTips,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TipsterStats) Reset() {
+	*x = TipsterStats{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TipsterStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TipsterStats) ProtoMessage() {}
+
+func (x *TipsterStats) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TipsterStats.ProtoReflect.Descriptor instead.
+func (*TipsterStats) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TipsterStats) GetTipsterId() string {
+	if x != nil {
+		return x.TipsterId
+	}
+	return ""
+}
+
+func (x *TipsterStats) GetTips() int64 {
+	if x != nil {
+		return x.Tips
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetWon() int64 {
+	if x != nil {
+		return x.Won
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetLost() int64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetVoid() int64 {
+	if x != nil {
+		return x.Void
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetPush() int64 {
+	if x != nil {
+		return x.Push
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetHalfWon() int64 {
+	if x != nil {
+		return x.HalfWon
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetHalfLost() int64 {
+	if x != nil {
+		return x.HalfLost
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetStrikeRate() float64 {
+	if x != nil {
+		return x.StrikeRate
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetUnitsStaked() float64 {
+	if x != nil {
+		return x.UnitsStaked
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetProfit() float64 {
+	if x != nil {
+		return x.Profit
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetRoi() float64 {
+	if x != nil {
+		return x.Roi
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetAverageOdds() float64 {
+	if x != nil {
+		return x.AverageOdds
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetLongestWinningStreak() int64 {
+	if x != nil {
+		return x.LongestWinningStreak
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetLongestLosingStreak() int64 {
+	if x != nil {
+		return x.LongestLosingStreak
+	}
+	return 0
+}
+
+func (x *TipsterStats) GetDecidedTips() int64 {
+	if x != nil {
+		return x.DecidedTips
+	}
+	return 0
+}
+
+type GetTipsterStatsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	TipsterId string                 `protobuf:"bytes,1,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+	// Optional: only tips on this sport
+	Sport string `protobuf:"bytes,2,opt,name=Sport,proto3" json:"Sport,omitempty"`
+	// Optional: only tips with this tag
+	Tag string `protobuf:"bytes,3,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	// Optional: only tips whose event started in the range. Either end can
+	// be left open; DateEnd is exclusive
+	DateRange     *YM_Common.DateRange `protobuf:"bytes,4,opt,name=DateRange,proto3" json:"DateRange,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTipsterStatsRequest) Reset() {
+	*x = GetTipsterStatsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTipsterStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTipsterStatsRequest) ProtoMessage() {}
+
+func (x *GetTipsterStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTipsterStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTipsterStatsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetTipsterStatsRequest) GetTipsterId() string {
+	if x != nil {
+		return x.TipsterId
+	}
+	return ""
+}
+
+func (x *GetTipsterStatsRequest) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+func (x *GetTipsterStatsRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *GetTipsterStatsRequest) GetDateRange() *YM_Common.DateRange {
+	if x != nil {
+		return x.DateRange
+	}
+	return nil
+}
+
+type GetTipsterStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *TipsterStats          `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTipsterStatsResponse) Reset() {
+	*x = GetTipsterStatsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTipsterStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTipsterStatsResponse) ProtoMessage() {}
+
+func (x *GetTipsterStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTipsterStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTipsterStatsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetTipsterStatsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetTipsterStatsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetTipsterStatsResponse) GetData() *TipsterStats {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Lists tipsters ranked by the metric, best first. Leaderboards are
+// recomputed periodically, so they lag settlements by up to the job
+// interval
+type ListLeaderboardRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Window LeaderboardWindow      `protobuf:"varint,1,opt,name=Window,proto3,enum=protos.Tipster.LeaderboardWindow" json:"Window,omitempty"`
+	Metric LeaderboardMetric      `protobuf:"varint,2,opt,name=Metric,proto3,enum=protos.Tipster.LeaderboardMetric" json:"Metric,omitempty"`
+	// Optional: only tips on this sport. Cannot be combined with Tag
+	Sport string `protobuf:"bytes,3,opt,name=Sport,proto3" json:"Sport,omitempty"`
+	// Optional: only tips with this tag. Cannot be combined with Sport
+	Tag string `protobuf:"bytes,4,opt,name=Tag,proto3" json:"Tag,omitempty"`
+	// Tipsters with fewer decided tips are left out, defaults to 10. Void
+	// and pushed tips do not count
+	MinTips       int32  `protobuf:"varint,5,opt,name=MinTips,proto3" json:"MinTips,omitempty"`
+	PageSize      int32  `protobuf:"varint,6,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string `protobuf:"bytes,7,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaderboardRequest) Reset() {
+	*x = ListLeaderboardRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaderboardRequest) ProtoMessage() {}
+
+func (x *ListLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListLeaderboardRequest) GetWindow() LeaderboardWindow {
+	if x != nil {
+		return x.Window
+	}
+	return LeaderboardWindow_LEADERBOARD_WINDOW_UNSPECIFIED
+}
+
+func (x *ListLeaderboardRequest) GetMetric() LeaderboardMetric {
+	if x != nil {
+		return x.Metric
+	}
+	return LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED
+}
+
+func (x *ListLeaderboardRequest) GetSport() string {
+	if x != nil {
+		return x.Sport
+	}
+	return ""
+}
+
+func (x *ListLeaderboardRequest) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *ListLeaderboardRequest) GetMinTips() int32 {
+	if x != nil {
+		return x.MinTips
+	}
+	return 0
+}
+
+func (x *ListLeaderboardRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListLeaderboardRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type LeaderboardEntryData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=Rank,proto3" json:"Rank,omitempty"`
+	Tipster       *UserDetail            `protobuf:"bytes,2,opt,name=Tipster,proto3" json:"Tipster,omitempty"`
+	Stats         *TipsterStats          `protobuf:"bytes,3,opt,name=Stats,proto3" json:"Stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntryData) Reset() {
+	*x = LeaderboardEntryData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntryData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntryData) ProtoMessage() {}
+
+func (x *LeaderboardEntryData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntryData.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntryData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LeaderboardEntryData) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntryData) GetTipster() *UserDetail {
+	if x != nil {
+		return x.Tipster
+	}
+	return nil
+}
+
+func (x *LeaderboardEntryData) GetStats() *TipsterStats {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+type ListLeaderboardResponse struct {
+	state         protoimpl.MessageState                       `protogen:"open.v1"`
+	Code          string                                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListLeaderboardResponse_ListLeaderboardData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaderboardResponse) Reset() {
+	*x = ListLeaderboardResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaderboardResponse) ProtoMessage() {}
+
+func (x *ListLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListLeaderboardResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListLeaderboardResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListLeaderboardResponse) GetData() *ListLeaderboardResponse_ListLeaderboardData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type LedgerIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kind  LedgerIssueKind        `protobuf:"varint,1,opt,name=Kind,proto3,enum=protos.Tipster.LedgerIssueKind" json:"Kind,omitempty"`
+	// Sequence number of the entry, starting at 1
+	Sequence      int64  `protobuf:"varint,2,opt,name=Sequence,proto3" json:"Sequence,omitempty"`
+	TipId         string `protobuf:"bytes,3,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	Detail        string `protobuf:"bytes,4,opt,name=Detail,proto3" json:"Detail,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LedgerIssue) Reset() {
+	*x = LedgerIssue{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LedgerIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LedgerIssue) ProtoMessage() {}
+
+func (x *LedgerIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LedgerIssue.ProtoReflect.Descriptor instead.
+func (*LedgerIssue) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *LedgerIssue) GetKind() LedgerIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return LedgerIssueKind_LEDGER_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *LedgerIssue) GetSequence() int64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *LedgerIssue) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+func (x *LedgerIssue) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+type VerifyTipsterLedgerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TipsterId     string                 `protobuf:"bytes,1,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTipsterLedgerRequest) Reset() {
+	*x = VerifyTipsterLedgerRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTipsterLedgerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTipsterLedgerRequest) ProtoMessage() {}
+
+func (x *VerifyTipsterLedgerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTipsterLedgerRequest.ProtoReflect.Descriptor instead.
+func (*VerifyTipsterLedgerRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VerifyTipsterLedgerRequest) GetTipsterId() string {
+	if x != nil {
+		return x.TipsterId
+	}
+	return ""
+}
+
+type VerifyTipsterLedgerResponse struct {
+	state         protoimpl.MessageState                               `protogen:"open.v1"`
+	Code          string                                               `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                               `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyTipsterLedgerResponse) Reset() {
+	*x = VerifyTipsterLedgerResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyTipsterLedgerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyTipsterLedgerResponse) ProtoMessage() {}
+
+func (x *VerifyTipsterLedgerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyTipsterLedgerResponse.ProtoReflect.Descriptor instead.
+func (*VerifyTipsterLedgerResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *VerifyTipsterLedgerResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VerifyTipsterLedgerResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *VerifyTipsterLedgerResponse) GetData() *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+// Create User
+// -------------------
+type CreateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserName      string                 `protobuf:"bytes,1,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	Password      string                 `protobuf:"bytes,3,opt,name=Password,proto3" json:"Password,omitempty"`
+	Tags          []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserRequest) Reset() {
+	*x = CreateUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserRequest) ProtoMessage() {}
+
+func (x *CreateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserRequest.ProtoReflect.Descriptor instead.
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateUserRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+// User registration response message
+type CreateUserResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Code          string                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *CreateUserResponse_UserData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateUserResponse) Reset() {
+	*x = CreateUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateUserResponse) ProtoMessage() {}
+
+func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CreateUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *CreateUserResponse) GetData() *CreateUserResponse_UserData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDetail) Reset() {
+	*x = UserDetail{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetail) ProtoMessage() {}
+
+func (x *UserDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetail.ProtoReflect.Descriptor instead.
+func (*UserDetail) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UserDetail) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UserDetail) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+// -------------------
+// Get User
+// -------------------
+type GetUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRequest) Reset() {
+	*x = GetUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRequest) ProtoMessage() {}
+
+func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *GetUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetUserResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Code          string                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *CreateUserResponse_UserData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserResponse) Reset() {
+	*x = GetUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserResponse) ProtoMessage() {}
+
+func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
+func (*GetUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *GetUserResponse) GetData() *CreateUserResponse_UserData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+// Update User
+// -------------------
+type UpdateUserRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	UserName string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	Email    string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Tags     []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	// Optional: makes the account private or public
+	Private       *bool `protobuf:"varint,5,opt,name=Private,proto3,oneof" json:"Private,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserRequest) Reset() {
+	*x = UpdateUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserRequest) ProtoMessage() {}
+
+func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *UpdateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateUserRequest) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *UpdateUserRequest) GetPrivate() bool {
+	if x != nil && x.Private != nil {
+		return *x.Private
+	}
+	return false
+}
+
+type UpdateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUserResponse) Reset() {
+	*x = UpdateUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUserResponse) ProtoMessage() {}
+
+func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UpdateUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// -------------------
+// Delete User
+// -------------------
+type DeleteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserRequest) Reset() {
+	*x = DeleteUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserRequest) ProtoMessage() {}
+
+func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+// Deleted users can be restored until PurgeAfter, then they are purged
+type DeleteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	PurgeAfter    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=PurgeAfter,proto3" json:"PurgeAfter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUserResponse) Reset() {
+	*x = DeleteUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUserResponse) ProtoMessage() {}
+
+func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *DeleteUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *DeleteUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *DeleteUserResponse) GetPurgeAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PurgeAfter
+	}
+	return nil
+}
+
+// -------------------
+// Restore User (admin only)
+// -------------------
+type RestoreUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserRequest) Reset() {
+	*x = RestoreUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserRequest) ProtoMessage() {}
+
+func (x *RestoreUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserRequest.ProtoReflect.Descriptor instead.
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *RestoreUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type RestoreUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreUserResponse) Reset() {
+	*x = RestoreUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreUserResponse) ProtoMessage() {}
+
+func (x *RestoreUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreUserResponse.ProtoReflect.Descriptor instead.
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RestoreUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RestoreUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// -------------------
+// List Followers / Following
+// -------------------
+type ListFollowersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersRequest) Reset() {
+	*x = ListFollowersRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersRequest) ProtoMessage() {}
+
+func (x *ListFollowersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowersRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListFollowersRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFollowersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFollowersRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListFollowersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *UserDetailPage        `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowersResponse) Reset() {
+	*x = ListFollowersResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowersResponse) ProtoMessage() {}
+
+func (x *ListFollowersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowersResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowersResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ListFollowersResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListFollowersResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListFollowersResponse) GetData() *UserDetailPage {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type ListFollowingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingRequest) Reset() {
+	*x = ListFollowingRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingRequest) ProtoMessage() {}
+
+func (x *ListFollowingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowingRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListFollowingRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListFollowingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListFollowingRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListFollowingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *UserDetailPage        `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowingResponse) Reset() {
+	*x = ListFollowingResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowingResponse) ProtoMessage() {}
+
+func (x *ListFollowingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowingResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowingResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *ListFollowingResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListFollowingResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListFollowingResponse) GetData() *UserDetailPage {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UserDetailPage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserDetail          `protobuf:"bytes,1,rep,name=Users,proto3" json:"Users,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserDetailPage) Reset() {
+	*x = UserDetailPage{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDetailPage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDetailPage) ProtoMessage() {}
+
+func (x *UserDetailPage) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDetailPage.ProtoReflect.Descriptor instead.
+func (*UserDetailPage) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *UserDetailPage) GetUsers() []*UserDetail {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *UserDetailPage) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+// -------------------
+// List User
+// -------------------
+type ListUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserRequest) Reset() {
+	*x = ListUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserRequest) ProtoMessage() {}
+
+func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
+func (*ListUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ListUserRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListUserRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListUserResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Code          string                          `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                          `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListUserResponse_ListUsersData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListUserResponse) Reset() {
+	*x = ListUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListUserResponse) ProtoMessage() {}
+
+func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
+func (*ListUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *ListUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListUserResponse) GetData() *ListUserResponse_ListUsersData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+// Login
+// -------------------
+type LoginRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Email         string                 `protobuf:"bytes,1,opt,name=Email,proto3" json:"Email,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=Password,proto3" json:"Password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRequest) Reset() {
+	*x = LoginRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRequest) ProtoMessage() {}
+
+func (x *LoginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRequest.ProtoReflect.Descriptor instead.
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LoginRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type LoginResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Code          string                   `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                   `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *LoginResponse_LoginData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse) Reset() {
+	*x = LoginResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse) ProtoMessage() {}
+
+func (x *LoginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse.ProtoReflect.Descriptor instead.
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *LoginResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LoginResponse) GetData() *LoginResponse_LoginData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+// Refresh Token
+// -------------------
+type RefreshTokenRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenRequest) Reset() {
+	*x = RefreshTokenRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenRequest) ProtoMessage() {}
+
+func (x *RefreshTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenRequest.ProtoReflect.Descriptor instead.
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *RefreshTokenRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *AuthToken             `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshTokenResponse) Reset() {
+	*x = RefreshTokenResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshTokenResponse) ProtoMessage() {}
+
+func (x *RefreshTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshTokenResponse.ProtoReflect.Descriptor instead.
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *RefreshTokenResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *RefreshTokenResponse) GetData() *AuthToken {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Access tokens are sent as "Authorization: Bearer <AccessToken>"
+type AuthToken struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	AccessToken           string                 `protobuf:"bytes,1,opt,name=AccessToken,proto3" json:"AccessToken,omitempty"`
+	AccessTokenExpiresAt  *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=AccessTokenExpiresAt,proto3" json:"AccessTokenExpiresAt,omitempty"`
+	RefreshToken          string                 `protobuf:"bytes,3,opt,name=RefreshToken,proto3" json:"RefreshToken,omitempty"`
+	RefreshTokenExpiresAt *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=RefreshTokenExpiresAt,proto3" json:"RefreshTokenExpiresAt,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *AuthToken) Reset() {
+	*x = AuthToken{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthToken) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthToken) ProtoMessage() {}
+
+func (x *AuthToken) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthToken.ProtoReflect.Descriptor instead.
+func (*AuthToken) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *AuthToken) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *AuthToken) GetAccessTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AccessTokenExpiresAt
+	}
+	return nil
+}
+
+func (x *AuthToken) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *AuthToken) GetRefreshTokenExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.RefreshTokenExpiresAt
+	}
+	return nil
+}
+
+// -------------------
+// External Identity
+// -------------------
+// Credential is a Google ID token, or the Telegram Login Widget data as a
+// URL query string. An account is created on first sign-in.
+type LoginWithIdentityRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Provider      YM_Common.IdendityProvider `protobuf:"varint,1,opt,name=Provider,proto3,enum=YM.Common.IdendityProvider" json:"Provider,omitempty"`
+	Credential    string                     `protobuf:"bytes,2,opt,name=Credential,proto3" json:"Credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginWithIdentityRequest) Reset() {
+	*x = LoginWithIdentityRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithIdentityRequest) ProtoMessage() {}
+
+func (x *LoginWithIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LoginWithIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *LoginWithIdentityRequest) GetProvider() YM_Common.IdendityProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return YM_Common.IdendityProvider(0)
+}
+
+func (x *LoginWithIdentityRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type LoginWithIdentityResponse struct {
+	state protoimpl.MessageState   `protogen:"open.v1"`
+	Code  string                   `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg   string                   `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data  *LoginResponse_LoginData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	// True when the account was created by this sign-in
+	AccountCreated bool `protobuf:"varint,4,opt,name=AccountCreated,proto3" json:"AccountCreated,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LoginWithIdentityResponse) Reset() {
+	*x = LoginWithIdentityResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginWithIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginWithIdentityResponse) ProtoMessage() {}
+
+func (x *LoginWithIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginWithIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LoginWithIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *LoginWithIdentityResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LoginWithIdentityResponse) GetData() *LoginResponse_LoginData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *LoginWithIdentityResponse) GetAccountCreated() bool {
+	if x != nil {
+		return x.AccountCreated
+	}
+	return false
+}
+
+// Links another provider to the caller's account
+type LinkIdentityRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Provider      YM_Common.IdendityProvider `protobuf:"varint,1,opt,name=Provider,proto3,enum=YM.Common.IdendityProvider" json:"Provider,omitempty"`
+	Credential    string                     `protobuf:"bytes,2,opt,name=Credential,proto3" json:"Credential,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityRequest) Reset() {
+	*x = LinkIdentityRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityRequest) ProtoMessage() {}
+
+func (x *LinkIdentityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityRequest.ProtoReflect.Descriptor instead.
+func (*LinkIdentityRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *LinkIdentityRequest) GetProvider() YM_Common.IdendityProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return YM_Common.IdendityProvider(0)
+}
+
+func (x *LinkIdentityRequest) GetCredential() string {
+	if x != nil {
+		return x.Credential
+	}
+	return ""
+}
+
+type LinkIdentityResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          []*IdentityData        `protobuf:"bytes,3,rep,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LinkIdentityResponse) Reset() {
+	*x = LinkIdentityResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LinkIdentityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LinkIdentityResponse) ProtoMessage() {}
+
+func (x *LinkIdentityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LinkIdentityResponse.ProtoReflect.Descriptor instead.
+func (*LinkIdentityResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *LinkIdentityResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LinkIdentityResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LinkIdentityResponse) GetData() []*IdentityData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type IdentityData struct {
+	state    protoimpl.MessageState     `protogen:"open.v1"`
+	Provider YM_Common.IdendityProvider `protobuf:"varint,1,opt,name=Provider,proto3,enum=YM.Common.IdendityProvider" json:"Provider,omitempty"`
+	// Only set when the provider verified it
+	Email         string                 `protobuf:"bytes,2,opt,name=Email,proto3" json:"Email,omitempty"`
+	LinkedAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=LinkedAt,proto3" json:"LinkedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IdentityData) Reset() {
+	*x = IdentityData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IdentityData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IdentityData) ProtoMessage() {}
+
+func (x *IdentityData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IdentityData.ProtoReflect.Descriptor instead.
+func (*IdentityData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *IdentityData) GetProvider() YM_Common.IdendityProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return YM_Common.IdendityProvider(0)
+}
+
+func (x *IdentityData) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *IdentityData) GetLinkedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LinkedAt
+	}
+	return nil
+}
+
+// -------------------
+// Set User Role (admin only)
+// -------------------
+type SetUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Role          UserRole               `protobuf:"varint,2,opt,name=Role,proto3,enum=protos.Tipster.UserRole" json:"Role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleRequest) Reset() {
+	*x = SetUserRoleRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleRequest) ProtoMessage() {}
+
+func (x *SetUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *SetUserRoleRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetUserRoleRequest) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+type SetUserRoleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetUserRoleResponse) Reset() {
+	*x = SetUserRoleResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetUserRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetUserRoleResponse) ProtoMessage() {}
+
+func (x *SetUserRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetUserRoleResponse.ProtoReflect.Descriptor instead.
+func (*SetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *SetUserRoleResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SetUserRoleResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// -------------------
+// Account Lifecycle (admin only)
+// -------------------
+type SuspendUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserRequest) Reset() {
+	*x = SuspendUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserRequest) ProtoMessage() {}
+
+func (x *SuspendUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserRequest.ProtoReflect.Descriptor instead.
+func (*SuspendUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *SuspendUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SuspendUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type SuspendUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SuspendUserResponse) Reset() {
+	*x = SuspendUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SuspendUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SuspendUserResponse) ProtoMessage() {}
+
+func (x *SuspendUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SuspendUserResponse.ProtoReflect.Descriptor instead.
+func (*SuspendUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *SuspendUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SuspendUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// Lifts a suspension, or activates a pending or inactive account
+type ReactivateUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserRequest) Reset() {
+	*x = ReactivateUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserRequest) ProtoMessage() {}
+
+func (x *ReactivateUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserRequest.ProtoReflect.Descriptor instead.
+func (*ReactivateUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ReactivateUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type ReactivateUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReactivateUserResponse) Reset() {
+	*x = ReactivateUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReactivateUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReactivateUserResponse) ProtoMessage() {}
+
+func (x *ReactivateUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReactivateUserResponse.ProtoReflect.Descriptor instead.
+func (*ReactivateUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ReactivateUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ReactivateUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// Closes the account for good; its content stays attributed
+type CloseUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseUserRequest) Reset() {
+	*x = CloseUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseUserRequest) ProtoMessage() {}
+
+func (x *CloseUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseUserRequest.ProtoReflect.Descriptor instead.
+func (*CloseUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *CloseUserRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CloseUserRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CloseUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseUserResponse) Reset() {
+	*x = CloseUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseUserResponse) ProtoMessage() {}
+
+func (x *CloseUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseUserResponse.ProtoReflect.Descriptor instead.
+func (*CloseUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CloseUserResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *CloseUserResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+// -------------------
+// Self-Exclusion
+// -------------------
+// Locks the caller out of tips for the period. It cannot be lifted early.
+type SelfExcludeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Period        SelfExclusionPeriod    `protobuf:"varint,1,opt,name=Period,proto3,enum=protos.Tipster.SelfExclusionPeriod" json:"Period,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelfExcludeRequest) Reset() {
+	*x = SelfExcludeRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfExcludeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfExcludeRequest) ProtoMessage() {}
+
+func (x *SelfExcludeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfExcludeRequest.ProtoReflect.Descriptor instead.
+func (*SelfExcludeRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *SelfExcludeRequest) GetPeriod() SelfExclusionPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_UNSPECIFIED
+}
+
+type SelfExcludeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *SelfExclusionData     `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelfExcludeResponse) Reset() {
+	*x = SelfExcludeResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfExcludeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfExcludeResponse) ProtoMessage() {}
+
+func (x *SelfExcludeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfExcludeResponse.ProtoReflect.Descriptor instead.
+func (*SelfExcludeResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *SelfExcludeResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SelfExcludeResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *SelfExcludeResponse) GetData() *SelfExclusionData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Compliance report of exclusions and their expiries (admin only)
+type ListSelfExclusionsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optional: to filter exclusions by user
+	UserId        string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	PageSize      int32  `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSelfExclusionsRequest) Reset() {
+	*x = ListSelfExclusionsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSelfExclusionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSelfExclusionsRequest) ProtoMessage() {}
+
+func (x *ListSelfExclusionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSelfExclusionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSelfExclusionsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListSelfExclusionsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ListSelfExclusionsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListSelfExclusionsRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListSelfExclusionsResponse struct {
+	state         protoimpl.MessageState                             `protogen:"open.v1"`
+	Code          string                                             `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                             `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListSelfExclusionsResponse_ListSelfExclusionsData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSelfExclusionsResponse) Reset() {
+	*x = ListSelfExclusionsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSelfExclusionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSelfExclusionsResponse) ProtoMessage() {}
+
+func (x *ListSelfExclusionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSelfExclusionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSelfExclusionsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ListSelfExclusionsResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *ListSelfExclusionsResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *ListSelfExclusionsResponse) GetData() *ListSelfExclusionsResponse_ListSelfExclusionsData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SelfExclusionData struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ExclusionId string                 `protobuf:"bytes,1,opt,name=ExclusionId,proto3" json:"ExclusionId,omitempty"`
+	UserId      string                 `protobuf:"bytes,2,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Period      SelfExclusionPeriod    `protobuf:"varint,3,opt,name=Period,proto3,enum=protos.Tipster.SelfExclusionPeriod" json:"Period,omitempty"`
+	StartedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`
+	// Unset for permanent exclusions
+	EndsAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	// Set once the account has been released
+	ExpiredAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ExpiredAt,proto3" json:"ExpiredAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SelfExclusionData) Reset() {
+	*x = SelfExclusionData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SelfExclusionData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfExclusionData) ProtoMessage() {}
+
+func (x *SelfExclusionData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfExclusionData.ProtoReflect.Descriptor instead.
+func (*SelfExclusionData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SelfExclusionData) GetExclusionId() string {
+	if x != nil {
+		return x.ExclusionId
+	}
+	return ""
+}
+
+func (x *SelfExclusionData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SelfExclusionData) GetPeriod() SelfExclusionPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return SelfExclusionPeriod_SELF_EXCLUSION_PERIOD_UNSPECIFIED
+}
+
+func (x *SelfExclusionData) GetStartedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartedAt
+	}
+	return nil
+}
+
+func (x *SelfExclusionData) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *SelfExclusionData) GetExpiredAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiredAt
+	}
+	return nil
+}
+
+// -------------------
+//
+//	Like / Unlike Tip
+//
+// -------------------
+type LikeTipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// The Tip ID to be liked
+	TipId         string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeTipRequest) Reset() {
+	*x = LikeTipRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeTipRequest) ProtoMessage() {}
+
+func (x *LikeTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeTipRequest.ProtoReflect.Descriptor instead.
+func (*LikeTipRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{76}
+}
+
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *LikeTipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LikeTipRequest) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+type LikeTipResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Standard three-layer response format
+	Code          string                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *LikeTipResponse_LikeTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeTipResponse) Reset() {
+	*x = LikeTipResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LikeTipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LikeTipResponse) ProtoMessage() {}
+
+func (x *LikeTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeTipResponse.ProtoReflect.Descriptor instead.
+func (*LikeTipResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *LikeTipResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LikeTipResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LikeTipResponse) GetData() *LikeTipResponse_LikeTipData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UnlikeTipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// The Tip ID from which the user removes the "like"
+	TipId         string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeTipRequest) Reset() {
+	*x = UnlikeTipRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeTipRequest) ProtoMessage() {}
+
+func (x *UnlikeTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeTipRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeTipRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{78}
+}
+
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *UnlikeTipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UnlikeTipRequest) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+// For Unlike responses, we can reuse LikeTipResponse structure if the logic is the same.
+// You can alias it if you want separate message names.
+type LikeTipResponseAlias struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Code          string                            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                            `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *LikeTipResponseAlias_LikeTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LikeTipResponseAlias) Reset() {
+	*x = LikeTipResponseAlias{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateUserResponse) String() string {
+func (x *LikeTipResponseAlias) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateUserResponse) ProtoMessage() {}
+func (*LikeTipResponseAlias) ProtoMessage() {}
 
-func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[16]
+func (x *LikeTipResponseAlias) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LikeTipResponseAlias.ProtoReflect.Descriptor instead.
+func (*LikeTipResponseAlias) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *LikeTipResponseAlias) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *LikeTipResponseAlias) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *LikeTipResponseAlias) GetData() *LikeTipResponseAlias_LikeTipData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UnlikeTipResponse struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Code          string                           `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                           `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *UnlikeTipResponse_UnLikeTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnlikeTipResponse) Reset() {
+	*x = UnlikeTipResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnlikeTipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnlikeTipResponse) ProtoMessage() {}
+
+func (x *UnlikeTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnlikeTipResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeTipResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UnlikeTipResponse) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *UnlikeTipResponse) GetMsg() string {
+	if x != nil {
+		return x.Msg
+	}
+	return ""
+}
+
+func (x *UnlikeTipResponse) GetData() *UnlikeTipResponse_UnLikeTipData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// -------------------
+//
+//	Comment / DeleteComment
+//
+// -------------------
+type CommentInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CommentId     string                 `protobuf:"bytes,1,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+	TipId         string                 `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	Content       string                 `protobuf:"bytes,5,opt,name=Content,proto3" json:"Content,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	Likes         []*UserDetail          `protobuf:"bytes,8,rep,name=Likes,proto3" json:"Likes,omitempty"`
+	Unlikes       []*UserDetail          `protobuf:"bytes,9,rep,name=Unlikes,proto3" json:"Unlikes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentInfo) Reset() {
+	*x = CommentInfo{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentInfo) ProtoMessage() {}
+
+func (x *CommentInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommentInfo.ProtoReflect.Descriptor instead.
+func (*CommentInfo) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *CommentInfo) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+func (x *CommentInfo) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+func (x *CommentInfo) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *CommentInfo) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *CommentInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *CommentInfo) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *CommentInfo) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *CommentInfo) GetLikes() []*UserDetail {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *CommentInfo) GetUnlikes() []*UserDetail {
+	if x != nil {
+		return x.Unlikes
+	}
+	return nil
+}
+
+type CommentOnTipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	TipId         string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	ParentId      string `protobuf:"bytes,3,opt,name=ParentId,proto3" json:"ParentId,omitempty"` // Optional: for replies to comments
+	Content       string `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommentOnTipRequest) Reset() {
+	*x = CommentOnTipRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommentOnTipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommentOnTipRequest) ProtoMessage() {}
+
+func (x *CommentOnTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1115,55 +6052,64 @@ func (x *CreateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateUserResponse.ProtoReflect.Descriptor instead.
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CommentOnTipRequest.ProtoReflect.Descriptor instead.
+func (*CommentOnTipRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{82}
+}
+
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *CommentOnTipRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
 }
 
-func (x *CreateUserResponse) GetCode() string {
+func (x *CommentOnTipRequest) GetTipId() string {
 	if x != nil {
-		return x.Code
+		return x.TipId
 	}
 	return ""
 }
 
-func (x *CreateUserResponse) GetMsg() string {
+func (x *CommentOnTipRequest) GetParentId() string {
 	if x != nil {
-		return x.Msg
+		return x.ParentId
 	}
 	return ""
 }
 
-func (x *CreateUserResponse) GetData() *CreateUserResponse_UserData {
+func (x *CommentOnTipRequest) GetContent() string {
 	if x != nil {
-		return x.Data
+		return x.Content
 	}
-	return nil
+	return ""
 }
 
-type UserDetail struct {
+type CommentOnTipResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	UserName      string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *CommentInfo           `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserDetail) Reset() {
-	*x = UserDetail{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[17]
+func (x *CommentOnTipResponse) Reset() {
+	*x = CommentOnTipResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserDetail) String() string {
+func (x *CommentOnTipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserDetail) ProtoMessage() {}
+func (*CommentOnTipResponse) ProtoMessage() {}
 
-func (x *UserDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[17]
+func (x *CommentOnTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1174,50 +6120,60 @@ func (x *UserDetail) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserDetail.ProtoReflect.Descriptor instead.
-func (*UserDetail) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use CommentOnTipResponse.ProtoReflect.Descriptor instead.
+func (*CommentOnTipResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *UserDetail) GetId() string {
+func (x *CommentOnTipResponse) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UserDetail) GetUserName() string {
+func (x *CommentOnTipResponse) GetMsg() string {
 	if x != nil {
-		return x.UserName
+		return x.Msg
 	}
 	return ""
 }
 
+func (x *CommentOnTipResponse) GetData() *CommentInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // -------------------
-// Get User
+//
+//	ListTipComments
+//
 // -------------------
-type GetUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+type ListTipCommentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Tip ID for which we list comments
+	TipId         string `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserRequest) Reset() {
-	*x = GetUserRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[18]
+func (x *ListTipCommentsRequest) Reset() {
+	*x = ListTipCommentsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserRequest) String() string {
+func (x *ListTipCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserRequest) ProtoMessage() {}
+func (*ListTipCommentsRequest) ProtoMessage() {}
 
-func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[18]
+func (x *ListTipCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1228,42 +6184,42 @@ func (x *GetUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserRequest.ProtoReflect.Descriptor instead.
-func (*GetUserRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListTipCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListTipCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *GetUserRequest) GetUserId() string {
+func (x *ListTipCommentsRequest) GetTipId() string {
 	if x != nil {
-		return x.UserId
+		return x.TipId
 	}
 	return ""
 }
 
-type GetUserResponse struct {
-	state         protoimpl.MessageState       `protogen:"open.v1"`
-	Code          string                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *CreateUserResponse_UserData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type ListTipCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Comments      []*CommentInfo         `protobuf:"bytes,3,rep,name=Comments,proto3" json:"Comments,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUserResponse) Reset() {
-	*x = GetUserResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[19]
+func (x *ListTipCommentsResponse) Reset() {
+	*x = ListTipCommentsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUserResponse) String() string {
+func (x *ListTipCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUserResponse) ProtoMessage() {}
+func (*ListTipCommentsResponse) ProtoMessage() {}
 
-func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[19]
+func (x *ListTipCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1274,60 +6230,58 @@ func (x *GetUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUserResponse.ProtoReflect.Descriptor instead.
-func (*GetUserResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use ListTipCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListTipCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{85}
 }
 
-func (x *GetUserResponse) GetCode() string {
+func (x *ListTipCommentsResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *GetUserResponse) GetMsg() string {
+func (x *ListTipCommentsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *GetUserResponse) GetData() *CreateUserResponse_UserData {
+func (x *ListTipCommentsResponse) GetComments() []*CommentInfo {
 	if x != nil {
-		return x.Data
+		return x.Comments
 	}
 	return nil
 }
 
-// -------------------
-// Update User
-// -------------------
-type UpdateUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	UserName      string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+type LikeCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	CommentId     string `protobuf:"bytes,2,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserRequest) Reset() {
-	*x = UpdateUserRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[20]
+func (x *LikeCommentRequest) Reset() {
+	*x = LikeCommentRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserRequest) String() string {
+func (x *LikeCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserRequest) ProtoMessage() {}
+func (*LikeCommentRequest) ProtoMessage() {}
 
-func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[20]
+func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,62 +6292,50 @@ func (x *UpdateUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserRequest.ProtoReflect.Descriptor instead.
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *UpdateUserRequest) GetUserId() string {
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *LikeCommentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetUserName() string {
-	if x != nil {
-		return x.UserName
-	}
-	return ""
-}
-
-func (x *UpdateUserRequest) GetEmail() string {
+func (x *LikeCommentRequest) GetCommentId() string {
 	if x != nil {
-		return x.Email
+		return x.CommentId
 	}
 	return ""
 }
 
-func (x *UpdateUserRequest) GetTags() []string {
-	if x != nil {
-		return x.Tags
-	}
-	return nil
-}
-
-type UpdateUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+type LikeCommentResponse struct {
+	state         protoimpl.MessageState               `protogen:"open.v1"`
+	Code          string                               `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                               `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *LikeCommentResponse_LikeCommentData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateUserResponse) Reset() {
-	*x = UpdateUserResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[21]
+func (x *LikeCommentResponse) Reset() {
+	*x = LikeCommentResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateUserResponse) String() string {
+func (x *LikeCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateUserResponse) ProtoMessage() {}
+func (*LikeCommentResponse) ProtoMessage() {}
 
-func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[21]
+func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1404,50 +6346,58 @@ func (x *UpdateUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateUserResponse.ProtoReflect.Descriptor instead.
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UpdateUserResponse) GetCode() string {
+func (x *LikeCommentResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateUserResponse) GetMsg() string {
+func (x *LikeCommentResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-// -------------------
-// Delete User
-// -------------------
-type DeleteUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+func (x *LikeCommentResponse) GetData() *LikeCommentResponse_LikeCommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type UnlikeCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId        string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	CommentId     string `protobuf:"bytes,2,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserRequest) Reset() {
-	*x = DeleteUserRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[22]
+func (x *UnlikeCommentRequest) Reset() {
+	*x = UnlikeCommentRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserRequest) String() string {
+func (x *UnlikeCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserRequest) ProtoMessage() {}
+func (*UnlikeCommentRequest) ProtoMessage() {}
 
-func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[22]
+func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1458,41 +6408,50 @@ func (x *DeleteUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserRequest.ProtoReflect.Descriptor instead.
-func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
+func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *DeleteUserRequest) GetUserId() string {
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *UnlikeCommentRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-type DeleteUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+func (x *UnlikeCommentRequest) GetCommentId() string {
+	if x != nil {
+		return x.CommentId
+	}
+	return ""
+}
+
+type UnlikeCommentResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Code          string                                   `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                   `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *UnlikeCommentResponse_UnlikeCommentData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteUserResponse) Reset() {
-	*x = DeleteUserResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[23]
+func (x *UnlikeCommentResponse) Reset() {
+	*x = UnlikeCommentResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteUserResponse) String() string {
+func (x *UnlikeCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteUserResponse) ProtoMessage() {}
+func (*UnlikeCommentResponse) ProtoMessage() {}
 
-func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[23]
+func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1503,51 +6462,66 @@ func (x *DeleteUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteUserResponse.ProtoReflect.Descriptor instead.
-func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use UnlikeCommentResponse.ProtoReflect.Descriptor instead.
+func (*UnlikeCommentResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{89}
 }
 
-func (x *DeleteUserResponse) GetCode() string {
+func (x *UnlikeCommentResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *DeleteUserResponse) GetMsg() string {
+func (x *UnlikeCommentResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
+func (x *UnlikeCommentResponse) GetData() *UnlikeCommentResponse_UnlikeCommentData {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
 // -------------------
-// List User
+//
+//	ReplyComment / ListCommentReplies (Thread-like mode)
+//
 // -------------------
-type ListUserRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
-	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+type ReplyCommentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// The comment ID to which this reply is attached
+	ParentCommentId string `protobuf:"bytes,2,opt,name=ParentCommentId,proto3" json:"ParentCommentId,omitempty"`
+	// The reply content
+	Content       string `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserRequest) Reset() {
-	*x = ListUserRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[24]
+func (x *ReplyCommentRequest) Reset() {
+	*x = ReplyCommentRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserRequest) String() string {
+func (x *ReplyCommentRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserRequest) ProtoMessage() {}
+func (*ReplyCommentRequest) ProtoMessage() {}
 
-func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[24]
+func (x *ReplyCommentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,49 +6532,57 @@ func (x *ListUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserRequest.ProtoReflect.Descriptor instead.
-func (*ListUserRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ReplyCommentRequest.ProtoReflect.Descriptor instead.
+func (*ReplyCommentRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *ListUserRequest) GetPageSize() int32 {
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *ReplyCommentRequest) GetUserId() string {
 	if x != nil {
-		return x.PageSize
+		return x.UserId
 	}
-	return 0
+	return ""
 }
 
-func (x *ListUserRequest) GetNextCursor() string {
+func (x *ReplyCommentRequest) GetParentCommentId() string {
 	if x != nil {
-		return x.NextCursor
+		return x.ParentCommentId
 	}
 	return ""
 }
 
-type ListUserResponse struct {
+func (x *ReplyCommentRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ReplyCommentResponse struct {
 	state         protoimpl.MessageState          `protogen:"open.v1"`
 	Code          string                          `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
 	Msg           string                          `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *ListUserResponse_ListUsersData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+	Data          *ReplyCommentResponse_ReplyData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListUserResponse) Reset() {
-	*x = ListUserResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[25]
+func (x *ReplyCommentResponse) Reset() {
+	*x = ReplyCommentResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListUserResponse) String() string {
+func (x *ReplyCommentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListUserResponse) ProtoMessage() {}
+func (*ReplyCommentResponse) ProtoMessage() {}
 
-func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[25]
+func (x *ReplyCommentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1611,62 +6593,55 @@ func (x *ListUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListUserResponse.ProtoReflect.Descriptor instead.
-func (*ListUserResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ReplyCommentResponse.ProtoReflect.Descriptor instead.
+func (*ReplyCommentResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *ListUserResponse) GetCode() string {
+func (x *ReplyCommentResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ListUserResponse) GetMsg() string {
+func (x *ReplyCommentResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListUserResponse) GetData() *ListUserResponse_ListUsersData {
+func (x *ReplyCommentResponse) GetData() *ReplyCommentResponse_ReplyData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-// -------------------
-//
-//	Like / Unlike Tip
-//
-// -------------------
-type LikeTipRequest struct {
+type ListCommentRepliesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user who performs the "like" action
-	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	// The Tip ID to be liked
-	TipId         string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The parent comment ID for which we want to list replies
+	ParentCommentId string `protobuf:"bytes,1,opt,name=ParentCommentId,proto3" json:"ParentCommentId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *LikeTipRequest) Reset() {
-	*x = LikeTipRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[26]
+func (x *ListCommentRepliesRequest) Reset() {
+	*x = ListCommentRepliesRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeTipRequest) String() string {
+func (x *ListCommentRepliesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeTipRequest) ProtoMessage() {}
+func (*ListCommentRepliesRequest) ProtoMessage() {}
 
-func (x *LikeTipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[26]
+func (x *ListCommentRepliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1677,50 +6652,42 @@ func (x *LikeTipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeTipRequest.ProtoReflect.Descriptor instead.
-func (*LikeTipRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *LikeTipRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ListCommentRepliesRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentRepliesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *LikeTipRequest) GetTipId() string {
+func (x *ListCommentRepliesRequest) GetParentCommentId() string {
 	if x != nil {
-		return x.TipId
+		return x.ParentCommentId
 	}
 	return ""
 }
 
-type LikeTipResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Standard three-layer response format
-	Code          string                       `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                       `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *LikeTipResponse_LikeTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type ListCommentRepliesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Replies       []*ReplyInfo           `protobuf:"bytes,3,rep,name=Replies,proto3" json:"Replies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeTipResponse) Reset() {
-	*x = LikeTipResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[27]
+func (x *ListCommentRepliesResponse) Reset() {
+	*x = ListCommentRepliesResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeTipResponse) String() string {
+func (x *ListCommentRepliesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeTipResponse) ProtoMessage() {}
+func (*ListCommentRepliesResponse) ProtoMessage() {}
 
-func (x *LikeTipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[27]
+func (x *ListCommentRepliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1731,57 +6698,60 @@ func (x *LikeTipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeTipResponse.ProtoReflect.Descriptor instead.
-func (*LikeTipResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ListCommentRepliesResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentRepliesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *LikeTipResponse) GetCode() string {
+func (x *ListCommentRepliesResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *LikeTipResponse) GetMsg() string {
+func (x *ListCommentRepliesResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *LikeTipResponse) GetData() *LikeTipResponse_LikeTipData {
+func (x *ListCommentRepliesResponse) GetReplies() []*ReplyInfo {
 	if x != nil {
-		return x.Data
+		return x.Replies
 	}
 	return nil
 }
 
-type UnlikeTipRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user who performs the "unlike" action
-	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	// The Tip ID from which the user removes the "like"
-	TipId         string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ReplyInfo struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ReplyId         string                 `protobuf:"bytes,1,opt,name=ReplyId,proto3" json:"ReplyId,omitempty"`
+	ParentCommentId string                 `protobuf:"bytes,2,opt,name=ParentCommentId,proto3" json:"ParentCommentId,omitempty"`
+	UserId          string                 `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	Content         string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+	DateCreated     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=DateCreated,proto3" json:"DateCreated,omitempty"`
+	Likes           []*UserDetail          `protobuf:"bytes,6,rep,name=Likes,proto3" json:"Likes,omitempty"`
+	Unlikes         []*UserDetail          `protobuf:"bytes,7,rep,name=Unlikes,proto3" json:"Unlikes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *UnlikeTipRequest) Reset() {
-	*x = UnlikeTipRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[28]
+func (x *ReplyInfo) Reset() {
+	*x = ReplyInfo{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeTipRequest) String() string {
+func (x *ReplyInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeTipRequest) ProtoMessage() {}
+func (*ReplyInfo) ProtoMessage() {}
 
-func (x *UnlikeTipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[28]
+func (x *ReplyInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1792,51 +6762,88 @@ func (x *UnlikeTipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeTipRequest.ProtoReflect.Descriptor instead.
-func (*UnlikeTipRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ReplyInfo.ProtoReflect.Descriptor instead.
+func (*ReplyInfo) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UnlikeTipRequest) GetUserId() string {
+func (x *ReplyInfo) GetReplyId() string {
+	if x != nil {
+		return x.ReplyId
+	}
+	return ""
+}
+
+func (x *ReplyInfo) GetParentCommentId() string {
+	if x != nil {
+		return x.ParentCommentId
+	}
+	return ""
+}
+
+func (x *ReplyInfo) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UnlikeTipRequest) GetTipId() string {
+func (x *ReplyInfo) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReplyInfo) GetDateCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateCreated
+	}
+	return nil
+}
+
+func (x *ReplyInfo) GetLikes() []*UserDetail {
+	if x != nil {
+		return x.Likes
+	}
+	return nil
+}
+
+func (x *ReplyInfo) GetUnlikes() []*UserDetail {
 	if x != nil {
-		return x.TipId
+		return x.Unlikes
 	}
-	return ""
+	return nil
 }
 
-// For Unlike responses, we can reuse LikeTipResponse structure if the logic is the same.
-// You can alias it if you want separate message names.
-type LikeTipResponseAlias struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Code          string                            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                            `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *LikeTipResponseAlias_LikeTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+// --------------------
+//
+//	ListComments
+//
+// --------------------
+type ListCommentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeTipResponseAlias) Reset() {
-	*x = LikeTipResponseAlias{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[29]
+func (x *ListCommentsRequest) Reset() {
+	*x = ListCommentsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeTipResponseAlias) String() string {
+func (x *ListCommentsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeTipResponseAlias) ProtoMessage() {}
+func (*ListCommentsRequest) ProtoMessage() {}
 
-func (x *LikeTipResponseAlias) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[29]
+func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1847,56 +6854,50 @@ func (x *LikeTipResponseAlias) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeTipResponseAlias.ProtoReflect.Descriptor instead.
-func (*LikeTipResponseAlias) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
+func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *LikeTipResponseAlias) GetCode() string {
+func (x *ListCommentsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.Code
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *LikeTipResponseAlias) GetMsg() string {
+func (x *ListCommentsRequest) GetNextCursor() string {
 	if x != nil {
-		return x.Msg
+		return x.NextCursor
 	}
 	return ""
 }
 
-func (x *LikeTipResponseAlias) GetData() *LikeTipResponseAlias_LikeTipData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type UnlikeTipResponse struct {
-	state         protoimpl.MessageState           `protogen:"open.v1"`
-	Code          string                           `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                           `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *UnlikeTipResponse_UnLikeTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type ListCommentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Comments      []*CommentInfo         `protobuf:"bytes,3,rep,name=Comments,proto3" json:"Comments,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,4,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeTipResponse) Reset() {
-	*x = UnlikeTipResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[30]
+func (x *ListCommentsResponse) Reset() {
+	*x = ListCommentsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeTipResponse) String() string {
+func (x *ListCommentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeTipResponse) ProtoMessage() {}
+func (*ListCommentsResponse) ProtoMessage() {}
 
-func (x *UnlikeTipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[30]
+func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1907,67 +6908,75 @@ func (x *UnlikeTipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeTipResponse.ProtoReflect.Descriptor instead.
-func (*UnlikeTipResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
+func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *UnlikeTipResponse) GetCode() string {
+func (x *ListCommentsResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *UnlikeTipResponse) GetMsg() string {
+func (x *ListCommentsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *UnlikeTipResponse) GetData() *UnlikeTipResponse_UnLikeTipData {
+func (x *ListCommentsResponse) GetComments() []*CommentInfo {
 	if x != nil {
-		return x.Data
+		return x.Comments
 	}
 	return nil
 }
 
+func (x *ListCommentsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 // -------------------
 //
-//	Comment / DeleteComment
+//	ShareTip
 //
 // -------------------
-type CommentInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CommentId     string                 `protobuf:"bytes,1,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
-	TipId         string                 `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	ParentId      string                 `protobuf:"bytes,4,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
-	Content       string                 `protobuf:"bytes,5,opt,name=Content,proto3" json:"Content,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
-	Likes         []*UserDetail          `protobuf:"bytes,8,rep,name=Likes,proto3" json:"Likes,omitempty"`
-	Unlikes       []*UserDetail          `protobuf:"bytes,9,rep,name=Unlikes,proto3" json:"Unlikes,omitempty"`
+type ShareTipRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// The Tip ID to share
+	TipId string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	// The audience of the share, not of the tip
+	ShareType ShareType `protobuf:"varint,3,opt,name=ShareType,proto3,enum=protos.Tipster.ShareType" json:"ShareType,omitempty"`
+	// Optional text shown with the share
+	Comment       string `protobuf:"bytes,4,opt,name=Comment,proto3" json:"Comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentInfo) Reset() {
-	*x = CommentInfo{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[31]
+func (x *ShareTipRequest) Reset() {
+	*x = ShareTipRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentInfo) String() string {
+func (x *ShareTipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentInfo) ProtoMessage() {}
+func (*ShareTipRequest) ProtoMessage() {}
 
-func (x *CommentInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[31]
+func (x *ShareTipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,99 +6987,64 @@ func (x *CommentInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentInfo.ProtoReflect.Descriptor instead.
-func (*CommentInfo) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use ShareTipRequest.ProtoReflect.Descriptor instead.
+func (*ShareTipRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{97}
 }
 
-func (x *CommentInfo) GetCommentId() string {
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *ShareTipRequest) GetUserId() string {
 	if x != nil {
-		return x.CommentId
+		return x.UserId
 	}
 	return ""
 }
 
-func (x *CommentInfo) GetTipId() string {
+func (x *ShareTipRequest) GetTipId() string {
 	if x != nil {
 		return x.TipId
 	}
 	return ""
 }
 
-func (x *CommentInfo) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
-}
-
-func (x *CommentInfo) GetParentId() string {
+func (x *ShareTipRequest) GetShareType() ShareType {
 	if x != nil {
-		return x.ParentId
+		return x.ShareType
 	}
-	return ""
+	return ShareType_SHARE_TYPE_UNSPECIFIED
 }
 
-func (x *CommentInfo) GetContent() string {
+func (x *ShareTipRequest) GetComment() string {
 	if x != nil {
-		return x.Content
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *CommentInfo) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return nil
-}
-
-func (x *CommentInfo) GetUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return nil
-}
-
-func (x *CommentInfo) GetLikes() []*UserDetail {
-	if x != nil {
-		return x.Likes
-	}
-	return nil
-}
-
-func (x *CommentInfo) GetUnlikes() []*UserDetail {
-	if x != nil {
-		return x.Unlikes
-	}
-	return nil
-}
-
-type CommentOnTipRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	TipId         string                 `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	ParentId      string                 `protobuf:"bytes,3,opt,name=ParentId,proto3" json:"ParentId,omitempty"` // Optional: for replies to comments
-	Content       string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
+type ShareTipResponse struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	Code          string                         `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                         `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ShareTipResponse_ShareTipData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentOnTipRequest) Reset() {
-	*x = CommentOnTipRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[32]
+func (x *ShareTipResponse) Reset() {
+	*x = ShareTipResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentOnTipRequest) String() string {
+func (x *ShareTipResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentOnTipRequest) ProtoMessage() {}
+func (*ShareTipResponse) ProtoMessage() {}
 
-func (x *CommentOnTipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[32]
+func (x *ShareTipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2081,63 +7055,61 @@ func (x *CommentOnTipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentOnTipRequest.ProtoReflect.Descriptor instead.
-func (*CommentOnTipRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *CommentOnTipRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ShareTipResponse.ProtoReflect.Descriptor instead.
+func (*ShareTipResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *CommentOnTipRequest) GetTipId() string {
+func (x *ShareTipResponse) GetCode() string {
 	if x != nil {
-		return x.TipId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *CommentOnTipRequest) GetParentId() string {
+func (x *ShareTipResponse) GetMsg() string {
 	if x != nil {
-		return x.ParentId
+		return x.Msg
 	}
 	return ""
 }
 
-func (x *CommentOnTipRequest) GetContent() string {
+func (x *ShareTipResponse) GetData() *ShareTipResponse_ShareTipData {
 	if x != nil {
-		return x.Content
+		return x.Data
 	}
-	return ""
+	return nil
 }
 
-type CommentOnTipResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *CommentInfo           `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type TipShareData struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ShareId   string                 `protobuf:"bytes,1,opt,name=ShareId,proto3" json:"ShareId,omitempty"`
+	TipId     string                 `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	Sharer    *UserDetail            `protobuf:"bytes,3,opt,name=Sharer,proto3" json:"Sharer,omitempty"`
+	Comment   string                 `protobuf:"bytes,4,opt,name=Comment,proto3" json:"Comment,omitempty"`
+	ShareType ShareType              `protobuf:"varint,5,opt,name=ShareType,proto3,enum=protos.Tipster.ShareType" json:"ShareType,omitempty"`
+	// Set for shares as a story, which disappear at this time
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CommentOnTipResponse) Reset() {
-	*x = CommentOnTipResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[33]
+func (x *TipShareData) Reset() {
+	*x = TipShareData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CommentOnTipResponse) String() string {
+func (x *TipShareData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CommentOnTipResponse) ProtoMessage() {}
+func (*TipShareData) ProtoMessage() {}
 
-func (x *CommentOnTipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[33]
+func (x *TipShareData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2148,60 +7120,85 @@ func (x *CommentOnTipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CommentOnTipResponse.ProtoReflect.Descriptor instead.
-func (*CommentOnTipResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use TipShareData.ProtoReflect.Descriptor instead.
+func (*TipShareData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *TipShareData) GetShareId() string {
+	if x != nil {
+		return x.ShareId
+	}
+	return ""
+}
+
+func (x *TipShareData) GetTipId() string {
+	if x != nil {
+		return x.TipId
+	}
+	return ""
+}
+
+func (x *TipShareData) GetSharer() *UserDetail {
+	if x != nil {
+		return x.Sharer
+	}
+	return nil
 }
 
-func (x *CommentOnTipResponse) GetCode() string {
+func (x *TipShareData) GetComment() string {
 	if x != nil {
-		return x.Code
+		return x.Comment
 	}
 	return ""
 }
 
-func (x *CommentOnTipResponse) GetMsg() string {
+func (x *TipShareData) GetShareType() ShareType {
 	if x != nil {
-		return x.Msg
+		return x.ShareType
 	}
-	return ""
+	return ShareType_SHARE_TYPE_UNSPECIFIED
 }
 
-func (x *CommentOnTipResponse) GetData() *CommentInfo {
+func (x *TipShareData) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.ExpiresAt
 	}
 	return nil
 }
 
-// -------------------
-//
-//	ListTipComments
-//
-// -------------------
-type ListTipCommentsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The Tip ID for which we list comments
-	TipId         string `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+func (x *TipShareData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Lists who shared the tip, newest first
+type ListTipSharesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TipId         string                 `protobuf:"bytes,1,opt,name=TipId,proto3" json:"TipId,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,3,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTipCommentsRequest) Reset() {
-	*x = ListTipCommentsRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[34]
+func (x *ListTipSharesRequest) Reset() {
+	*x = ListTipSharesRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTipCommentsRequest) String() string {
+func (x *ListTipSharesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTipCommentsRequest) ProtoMessage() {}
+func (*ListTipSharesRequest) ProtoMessage() {}
 
-func (x *ListTipCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[34]
+func (x *ListTipSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2212,42 +7209,56 @@ func (x *ListTipCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTipCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListTipCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ListTipSharesRequest.ProtoReflect.Descriptor instead.
+func (*ListTipSharesRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *ListTipCommentsRequest) GetTipId() string {
+func (x *ListTipSharesRequest) GetTipId() string {
 	if x != nil {
 		return x.TipId
 	}
 	return ""
 }
 
-type ListTipCommentsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Comments      []*CommentInfo         `protobuf:"bytes,3,rep,name=Comments,proto3" json:"Comments,omitempty"`
+func (x *ListTipSharesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTipSharesRequest) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListTipSharesResponse struct {
+	state         protoimpl.MessageState                   `protogen:"open.v1"`
+	Code          string                                   `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                   `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListTipSharesResponse_ListTipSharesData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTipCommentsResponse) Reset() {
-	*x = ListTipCommentsResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[35]
+func (x *ListTipSharesResponse) Reset() {
+	*x = ListTipSharesResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTipCommentsResponse) String() string {
+func (x *ListTipSharesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTipCommentsResponse) ProtoMessage() {}
+func (*ListTipSharesResponse) ProtoMessage() {}
 
-func (x *ListTipCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[35]
+func (x *ListTipSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2258,55 +7269,64 @@ func (x *ListTipCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTipCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListTipCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ListTipSharesResponse.ProtoReflect.Descriptor instead.
+func (*ListTipSharesResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ListTipCommentsResponse) GetCode() string {
+func (x *ListTipSharesResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ListTipCommentsResponse) GetMsg() string {
+func (x *ListTipSharesResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListTipCommentsResponse) GetComments() []*CommentInfo {
+func (x *ListTipSharesResponse) GetData() *ListTipSharesResponse_ListTipSharesData {
 	if x != nil {
-		return x.Comments
+		return x.Data
 	}
 	return nil
 }
 
-type LikeCommentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	CommentId     string                 `protobuf:"bytes,2,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+// -------------------
+//
+//	Follow / Unfollow Tipster
+//
+// -------------------
+type FollowTipsterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// The Tipster ID to follow
+	TipsterId     string `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentRequest) Reset() {
-	*x = LikeCommentRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[36]
+func (x *FollowTipsterRequest) Reset() {
+	*x = FollowTipsterRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentRequest) String() string {
+func (x *FollowTipsterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentRequest) ProtoMessage() {}
+func (*FollowTipsterRequest) ProtoMessage() {}
 
-func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[36]
+func (x *FollowTipsterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2317,49 +7337,50 @@ func (x *LikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*LikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use FollowTipsterRequest.ProtoReflect.Descriptor instead.
+func (*FollowTipsterRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *LikeCommentRequest) GetUserId() string {
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *FollowTipsterRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *LikeCommentRequest) GetCommentId() string {
+func (x *FollowTipsterRequest) GetTipsterId() string {
 	if x != nil {
-		return x.CommentId
+		return x.TipsterId
 	}
 	return ""
 }
 
-type LikeCommentResponse struct {
-	state         protoimpl.MessageState               `protogen:"open.v1"`
-	Code          string                               `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                               `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *LikeCommentResponse_LikeCommentData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type FollowTipsterResponse struct {
+	state         protoimpl.MessageState            `protogen:"open.v1"`
+	Code          string                            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                            `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *FollowTipsterResponse_FollowData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LikeCommentResponse) Reset() {
-	*x = LikeCommentResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[37]
+func (x *FollowTipsterResponse) Reset() {
+	*x = FollowTipsterResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LikeCommentResponse) String() string {
+func (x *FollowTipsterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LikeCommentResponse) ProtoMessage() {}
+func (*FollowTipsterResponse) ProtoMessage() {}
 
-func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[37]
+func (x *FollowTipsterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,55 +7391,59 @@ func (x *LikeCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LikeCommentResponse.ProtoReflect.Descriptor instead.
-func (*LikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use FollowTipsterResponse.ProtoReflect.Descriptor instead.
+func (*FollowTipsterResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *LikeCommentResponse) GetCode() string {
+func (x *FollowTipsterResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *LikeCommentResponse) GetMsg() string {
+func (x *FollowTipsterResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *LikeCommentResponse) GetData() *LikeCommentResponse_LikeCommentData {
+func (x *FollowTipsterResponse) GetData() *FollowTipsterResponse_FollowData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type UnlikeCommentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	CommentId     string                 `protobuf:"bytes,2,opt,name=CommentId,proto3" json:"CommentId,omitempty"`
+type UnFollowTipsterRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// The Tipster ID to follow
+	TipsterId     string `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeCommentRequest) Reset() {
-	*x = UnlikeCommentRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[38]
+func (x *UnFollowTipsterRequest) Reset() {
+	*x = UnFollowTipsterRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeCommentRequest) String() string {
+func (x *UnFollowTipsterRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeCommentRequest) ProtoMessage() {}
+func (*UnFollowTipsterRequest) ProtoMessage() {}
 
-func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[38]
+func (x *UnFollowTipsterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2429,49 +7454,50 @@ func (x *UnlikeCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeCommentRequest.ProtoReflect.Descriptor instead.
-func (*UnlikeCommentRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use UnFollowTipsterRequest.ProtoReflect.Descriptor instead.
+func (*UnFollowTipsterRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *UnlikeCommentRequest) GetUserId() string {
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+func (x *UnFollowTipsterRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UnlikeCommentRequest) GetCommentId() string {
+func (x *UnFollowTipsterRequest) GetTipsterId() string {
 	if x != nil {
-		return x.CommentId
+		return x.TipsterId
 	}
 	return ""
 }
 
-type UnlikeCommentResponse struct {
-	state         protoimpl.MessageState                   `protogen:"open.v1"`
-	Code          string                                   `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                                   `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *UnlikeCommentResponse_UnlikeCommentData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type UnfollowTipsterResponse struct {
+	state         protoimpl.MessageState                `protogen:"open.v1"`
+	Code          string                                `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *UnfollowTipsterResponse_UnfollowData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnlikeCommentResponse) Reset() {
-	*x = UnlikeCommentResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[39]
+func (x *UnfollowTipsterResponse) Reset() {
+	*x = UnfollowTipsterResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnlikeCommentResponse) String() string {
+func (x *UnfollowTipsterResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnlikeCommentResponse) ProtoMessage() {}
+func (*UnfollowTipsterResponse) ProtoMessage() {}
 
-func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[39]
+func (x *UnfollowTipsterResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2482,26 +7508,26 @@ func (x *UnlikeCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnlikeCommentResponse.ProtoReflect.Descriptor instead.
-func (*UnlikeCommentResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use UnfollowTipsterResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowTipsterResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *UnlikeCommentResponse) GetCode() string {
+func (x *UnfollowTipsterResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *UnlikeCommentResponse) GetMsg() string {
+func (x *UnfollowTipsterResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *UnlikeCommentResponse) GetData() *UnlikeCommentResponse_UnlikeCommentData {
+func (x *UnfollowTipsterResponse) GetData() *UnfollowTipsterResponse_UnfollowData {
 	if x != nil {
 		return x.Data
 	}
@@ -2510,36 +7536,94 @@ func (x *UnlikeCommentResponse) GetData() *UnlikeCommentResponse_UnlikeCommentDa
 
 // -------------------
 //
-//	ReplyComment / ListCommentReplies (Thread-like mode)
+//	Follow Requests (private accounts)
 //
 // -------------------
-type ReplyCommentRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user who replies
-	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	// The comment ID to which this reply is attached
-	ParentCommentId string `protobuf:"bytes,2,opt,name=ParentCommentId,proto3" json:"ParentCommentId,omitempty"`
-	// The reply content
-	Content       string `protobuf:"bytes,3,opt,name=Content,proto3" json:"Content,omitempty"`
+type FollowRequestData struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,1,opt,name=RequestId,proto3" json:"RequestId,omitempty"`
+	// The user asking to follow
+	Requester     *UserDetail            `protobuf:"bytes,2,opt,name=Requester,proto3" json:"Requester,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowRequestData) Reset() {
+	*x = FollowRequestData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowRequestData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowRequestData) ProtoMessage() {}
+
+func (x *FollowRequestData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowRequestData.ProtoReflect.Descriptor instead.
+func (*FollowRequestData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *FollowRequestData) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *FollowRequestData) GetRequester() *UserDetail {
+	if x != nil {
+		return x.Requester
+	}
+	return nil
+}
+
+func (x *FollowRequestData) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// Lists the requests to follow the caller, newest first
+type ListFollowRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PageSize      int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplyCommentRequest) Reset() {
-	*x = ReplyCommentRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[40]
+func (x *ListFollowRequestsRequest) Reset() {
+	*x = ListFollowRequestsRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplyCommentRequest) String() string {
+func (x *ListFollowRequestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyCommentRequest) ProtoMessage() {}
+func (*ListFollowRequestsRequest) ProtoMessage() {}
 
-func (x *ReplyCommentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[40]
+func (x *ListFollowRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2550,56 +7634,49 @@ func (x *ReplyCommentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyCommentRequest.ProtoReflect.Descriptor instead.
-func (*ReplyCommentRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{40}
-}
-
-func (x *ReplyCommentRequest) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ListFollowRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *ReplyCommentRequest) GetParentCommentId() string {
+func (x *ListFollowRequestsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.ParentCommentId
+		return x.PageSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ReplyCommentRequest) GetContent() string {
+func (x *ListFollowRequestsRequest) GetNextCursor() string {
 	if x != nil {
-		return x.Content
+		return x.NextCursor
 	}
 	return ""
 }
 
-type ReplyCommentResponse struct {
-	state         protoimpl.MessageState          `protogen:"open.v1"`
-	Code          string                          `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                          `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *ReplyCommentResponse_ReplyData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type ListFollowRequestsResponse struct {
+	state         protoimpl.MessageState                             `protogen:"open.v1"`
+	Code          string                                             `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                                             `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	Data          *ListFollowRequestsResponse_ListFollowRequestsData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplyCommentResponse) Reset() {
-	*x = ReplyCommentResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[41]
+func (x *ListFollowRequestsResponse) Reset() {
+	*x = ListFollowRequestsResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplyCommentResponse) String() string {
+func (x *ListFollowRequestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyCommentResponse) ProtoMessage() {}
+func (*ListFollowRequestsResponse) ProtoMessage() {}
 
-func (x *ReplyCommentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[41]
+func (x *ListFollowRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,55 +7687,54 @@ func (x *ReplyCommentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyCommentResponse.ProtoReflect.Descriptor instead.
-func (*ReplyCommentResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ListFollowRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *ReplyCommentResponse) GetCode() string {
+func (x *ListFollowRequestsResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ReplyCommentResponse) GetMsg() string {
+func (x *ListFollowRequestsResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ReplyCommentResponse) GetData() *ReplyCommentResponse_ReplyData {
+func (x *ListFollowRequestsResponse) GetData() *ListFollowRequestsResponse_ListFollowRequestsData {
 	if x != nil {
 		return x.Data
 	}
 	return nil
 }
 
-type ListCommentRepliesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The parent comment ID for which we want to list replies
-	ParentCommentId string `protobuf:"bytes,1,opt,name=ParentCommentId,proto3" json:"ParentCommentId,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type ApproveFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=RequestId,proto3" json:"RequestId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentRepliesRequest) Reset() {
-	*x = ListCommentRepliesRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[42]
+func (x *ApproveFollowRequestRequest) Reset() {
+	*x = ApproveFollowRequestRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentRepliesRequest) String() string {
+func (x *ApproveFollowRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentRepliesRequest) ProtoMessage() {}
+func (*ApproveFollowRequestRequest) ProtoMessage() {}
 
-func (x *ListCommentRepliesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[42]
+func (x *ApproveFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2669,42 +7745,41 @@ func (x *ListCommentRepliesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentRepliesRequest.ProtoReflect.Descriptor instead.
-func (*ListCommentRepliesRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ApproveFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{109}
 }
 
-func (x *ListCommentRepliesRequest) GetParentCommentId() string {
+func (x *ApproveFollowRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.ParentCommentId
+		return x.RequestId
 	}
 	return ""
 }
 
-type ListCommentRepliesResponse struct {
+type ApproveFollowRequestResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Replies       []*ReplyInfo           `protobuf:"bytes,3,rep,name=Replies,proto3" json:"Replies,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentRepliesResponse) Reset() {
-	*x = ListCommentRepliesResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[43]
+func (x *ApproveFollowRequestResponse) Reset() {
+	*x = ApproveFollowRequestResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentRepliesResponse) String() string {
+func (x *ApproveFollowRequestResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentRepliesResponse) ProtoMessage() {}
+func (*ApproveFollowRequestResponse) ProtoMessage() {}
 
-func (x *ListCommentRepliesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[43]
+func (x *ApproveFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,60 +7790,47 @@ func (x *ListCommentRepliesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentRepliesResponse.ProtoReflect.Descriptor instead.
-func (*ListCommentRepliesResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ApproveFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*ApproveFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *ListCommentRepliesResponse) GetCode() string {
+func (x *ApproveFollowRequestResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ListCommentRepliesResponse) GetMsg() string {
+func (x *ApproveFollowRequestResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListCommentRepliesResponse) GetReplies() []*ReplyInfo {
-	if x != nil {
-		return x.Replies
-	}
-	return nil
-}
-
-type ReplyInfo struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	ReplyId         string                 `protobuf:"bytes,1,opt,name=ReplyId,proto3" json:"ReplyId,omitempty"`
-	ParentCommentId string                 `protobuf:"bytes,2,opt,name=ParentCommentId,proto3" json:"ParentCommentId,omitempty"`
-	UserId          string                 `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	Content         string                 `protobuf:"bytes,4,opt,name=Content,proto3" json:"Content,omitempty"`
-	DateCreated     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=DateCreated,proto3" json:"DateCreated,omitempty"`
-	Likes           []*UserDetail          `protobuf:"bytes,6,rep,name=Likes,proto3" json:"Likes,omitempty"`
-	Unlikes         []*UserDetail          `protobuf:"bytes,7,rep,name=Unlikes,proto3" json:"Unlikes,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type RejectFollowRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RequestId     string                 `protobuf:"bytes,1,opt,name=RequestId,proto3" json:"RequestId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplyInfo) Reset() {
-	*x = ReplyInfo{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[44]
+func (x *RejectFollowRequestRequest) Reset() {
+	*x = RejectFollowRequestRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplyInfo) String() string {
+func (x *RejectFollowRequestRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReplyInfo) ProtoMessage() {}
+func (*RejectFollowRequestRequest) ProtoMessage() {}
 
-func (x *ReplyInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[44]
+func (x *RejectFollowRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2779,88 +7841,99 @@ func (x *ReplyInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReplyInfo.ProtoReflect.Descriptor instead.
-func (*ReplyInfo) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RejectFollowRequestRequest.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *ReplyInfo) GetReplyId() string {
+func (x *RejectFollowRequestRequest) GetRequestId() string {
 	if x != nil {
-		return x.ReplyId
+		return x.RequestId
 	}
 	return ""
 }
 
-func (x *ReplyInfo) GetParentCommentId() string {
-	if x != nil {
-		return x.ParentCommentId
-	}
-	return ""
+type RejectFollowRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ReplyInfo) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+func (x *RejectFollowRequestResponse) Reset() {
+	*x = RejectFollowRequestResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ReplyInfo) GetContent() string {
-	if x != nil {
-		return x.Content
-	}
-	return ""
+func (x *RejectFollowRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ReplyInfo) GetDateCreated() *timestamppb.Timestamp {
+func (*RejectFollowRequestResponse) ProtoMessage() {}
+
+func (x *RejectFollowRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[112]
 	if x != nil {
-		return x.DateCreated
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ReplyInfo) GetLikes() []*UserDetail {
+// Deprecated: Use RejectFollowRequestResponse.ProtoReflect.Descriptor instead.
+func (*RejectFollowRequestResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *RejectFollowRequestResponse) GetCode() string {
 	if x != nil {
-		return x.Likes
+		return x.Code
 	}
-	return nil
+	return ""
 }
 
-func (x *ReplyInfo) GetUnlikes() []*UserDetail {
+func (x *RejectFollowRequestResponse) GetMsg() string {
 	if x != nil {
-		return x.Unlikes
+		return x.Msg
 	}
-	return nil
+	return ""
 }
 
-// --------------------
+// -------------------
 //
-//	ListComments
+//	Block / Mute User
 //
-// --------------------
-type ListCommentsRequest struct {
+// -------------------
+// Hides the user's content from the caller, removes the follows between
+// them and stops the user from following or interacting with the caller
+type BlockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageSize      int32                  `protobuf:"varint,1,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
-	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsRequest) Reset() {
-	*x = ListCommentsRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[45]
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[113]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsRequest) String() string {
+func (x *BlockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsRequest) ProtoMessage() {}
+func (*BlockUserRequest) ProtoMessage() {}
 
-func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[45]
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[113]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2871,50 +7944,41 @@ func (x *ListCommentsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsRequest.ProtoReflect.Descriptor instead.
-func (*ListCommentsRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *ListCommentsRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{113}
 }
 
-func (x *ListCommentsRequest) GetNextCursor() string {
+func (x *BlockUserRequest) GetUserId() string {
 	if x != nil {
-		return x.NextCursor
+		return x.UserId
 	}
 	return ""
 }
 
-type ListCommentsResponse struct {
+type BlockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Comments      []*CommentInfo         `protobuf:"bytes,3,rep,name=Comments,proto3" json:"Comments,omitempty"`
-	NextCursor    string                 `protobuf:"bytes,4,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListCommentsResponse) Reset() {
-	*x = ListCommentsResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[46]
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[114]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListCommentsResponse) String() string {
+func (x *BlockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCommentsResponse) ProtoMessage() {}
+func (*BlockUserResponse) ProtoMessage() {}
 
-func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[46]
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[114]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2925,71 +7989,47 @@ func (x *ListCommentsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCommentsResponse.ProtoReflect.Descriptor instead.
-func (*ListCommentsResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{114}
 }
 
-func (x *ListCommentsResponse) GetCode() string {
+func (x *BlockUserResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ListCommentsResponse) GetMsg() string {
+func (x *BlockUserResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *ListCommentsResponse) GetComments() []*CommentInfo {
-	if x != nil {
-		return x.Comments
-	}
-	return nil
-}
-
-func (x *ListCommentsResponse) GetNextCursor() string {
-	if x != nil {
-		return x.NextCursor
-	}
-	return ""
-}
-
-// -------------------
-//
-//	ShareTip
-//
-// -------------------
-type ShareTipRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user who shares the tip
-	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	// The Tip ID to share
-	TipId string `protobuf:"bytes,2,opt,name=TipId,proto3" json:"TipId,omitempty"`
-	// e.g. "PUBLIC", "FRIENDS_ONLY", "STORY"
-	ShareType     string `protobuf:"bytes,3,opt,name=ShareType,proto3" json:"ShareType,omitempty"`
+type UnblockUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShareTipRequest) Reset() {
-	*x = ShareTipRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[47]
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShareTipRequest) String() string {
+func (x *UnblockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareTipRequest) ProtoMessage() {}
+func (*UnblockUserRequest) ProtoMessage() {}
 
-func (x *ShareTipRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[47]
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3000,55 +8040,41 @@ func (x *ShareTipRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareTipRequest.ProtoReflect.Descriptor instead.
-func (*ShareTipRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{115}
 }
 
-func (x *ShareTipRequest) GetUserId() string {
+func (x *UnblockUserRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *ShareTipRequest) GetTipId() string {
-	if x != nil {
-		return x.TipId
-	}
-	return ""
-}
-
-func (x *ShareTipRequest) GetShareType() string {
-	if x != nil {
-		return x.ShareType
-	}
-	return ""
-}
-
-type ShareTipResponse struct {
+type UnblockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
 	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ShareTipResponse) Reset() {
-	*x = ShareTipResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[48]
+}
+
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShareTipResponse) String() string {
+func (x *UnblockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareTipResponse) ProtoMessage() {}
+func (*UnblockUserResponse) ProtoMessage() {}
 
-func (x *ShareTipResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[48]
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3059,55 +8085,48 @@ func (x *ShareTipResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareTipResponse.ProtoReflect.Descriptor instead.
-func (*ShareTipResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{116}
 }
 
-func (x *ShareTipResponse) GetCode() string {
+func (x *UnblockUserResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *ShareTipResponse) GetMsg() string {
+func (x *UnblockUserResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-// -------------------
-//
-//	Follow / Unfollow Tipster
-//
-// -------------------
-type FollowTipsterRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user who follows
-	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	// The Tipster ID to follow
-	TipsterId     string `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+// Only hides the user's content from the caller
+type MuteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowTipsterRequest) Reset() {
-	*x = FollowTipsterRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[49]
+func (x *MuteUserRequest) Reset() {
+	*x = MuteUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowTipsterRequest) String() string {
+func (x *MuteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowTipsterRequest) ProtoMessage() {}
+func (*MuteUserRequest) ProtoMessage() {}
 
-func (x *FollowTipsterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[49]
+func (x *MuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3118,49 +8137,41 @@ func (x *FollowTipsterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowTipsterRequest.ProtoReflect.Descriptor instead.
-func (*FollowTipsterRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use MuteUserRequest.ProtoReflect.Descriptor instead.
+func (*MuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *FollowTipsterRequest) GetUserId() string {
+func (x *MuteUserRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *FollowTipsterRequest) GetTipsterId() string {
-	if x != nil {
-		return x.TipsterId
-	}
-	return ""
-}
-
-type FollowTipsterResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Code          string                            `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                            `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *FollowTipsterResponse_FollowData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type MuteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowTipsterResponse) Reset() {
-	*x = FollowTipsterResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[50]
+func (x *MuteUserResponse) Reset() {
+	*x = MuteUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowTipsterResponse) String() string {
+func (x *MuteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowTipsterResponse) ProtoMessage() {}
+func (*MuteUserResponse) ProtoMessage() {}
 
-func (x *FollowTipsterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[50]
+func (x *MuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3171,57 +8182,47 @@ func (x *FollowTipsterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowTipsterResponse.ProtoReflect.Descriptor instead.
-func (*FollowTipsterResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use MuteUserResponse.ProtoReflect.Descriptor instead.
+func (*MuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *FollowTipsterResponse) GetCode() string {
+func (x *MuteUserResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *FollowTipsterResponse) GetMsg() string {
+func (x *MuteUserResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *FollowTipsterResponse) GetData() *FollowTipsterResponse_FollowData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
-type UnFollowTipsterRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user who follows
-	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	// The Tipster ID to follow
-	TipsterId     string `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
+type UnmuteUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnFollowTipsterRequest) Reset() {
-	*x = UnFollowTipsterRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[51]
+func (x *UnmuteUserRequest) Reset() {
+	*x = UnmuteUserRequest{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnFollowTipsterRequest) String() string {
+func (x *UnmuteUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnFollowTipsterRequest) ProtoMessage() {}
+func (*UnmuteUserRequest) ProtoMessage() {}
 
-func (x *UnFollowTipsterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[51]
+func (x *UnmuteUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3232,49 +8233,41 @@ func (x *UnFollowTipsterRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnFollowTipsterRequest.ProtoReflect.Descriptor instead.
-func (*UnFollowTipsterRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use UnmuteUserRequest.ProtoReflect.Descriptor instead.
+func (*UnmuteUserRequest) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *UnFollowTipsterRequest) GetUserId() string {
+func (x *UnmuteUserRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
 	}
 	return ""
 }
 
-func (x *UnFollowTipsterRequest) GetTipsterId() string {
-	if x != nil {
-		return x.TipsterId
-	}
-	return ""
-}
-
-type UnfollowTipsterResponse struct {
-	state         protoimpl.MessageState                `protogen:"open.v1"`
-	Code          string                                `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Msg           string                                `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
-	Data          *UnfollowTipsterResponse_UnfollowData `protobuf:"bytes,3,opt,name=Data,proto3" json:"Data,omitempty"`
+type UnmuteUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Msg           string                 `protobuf:"bytes,2,opt,name=Msg,proto3" json:"Msg,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowTipsterResponse) Reset() {
-	*x = UnfollowTipsterResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[52]
+func (x *UnmuteUserResponse) Reset() {
+	*x = UnmuteUserResponse{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowTipsterResponse) String() string {
+func (x *UnmuteUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowTipsterResponse) ProtoMessage() {}
+func (*UnmuteUserResponse) ProtoMessage() {}
 
-func (x *UnfollowTipsterResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[52]
+func (x *UnmuteUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3285,32 +8278,25 @@ func (x *UnfollowTipsterResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowTipsterResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowTipsterResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use UnmuteUserResponse.ProtoReflect.Descriptor instead.
+func (*UnmuteUserResponse) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *UnfollowTipsterResponse) GetCode() string {
+func (x *UnmuteUserResponse) GetCode() string {
 	if x != nil {
 		return x.Code
 	}
 	return ""
 }
 
-func (x *UnfollowTipsterResponse) GetMsg() string {
+func (x *UnmuteUserResponse) GetMsg() string {
 	if x != nil {
 		return x.Msg
 	}
 	return ""
 }
 
-func (x *UnfollowTipsterResponse) GetData() *UnfollowTipsterResponse_UnfollowData {
-	if x != nil {
-		return x.Data
-	}
-	return nil
-}
-
 // -------------------
 //
 //	ListFollowingFeed
@@ -3318,7 +8304,9 @@ func (x *UnfollowTipsterResponse) GetData() *UnfollowTipsterResponse_UnfollowDat
 // -------------------
 type ListFollowingFeedRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user whose feed we want
+	// Deprecated: ignored, the caller is taken from the access token
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
 	UserId string `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
 	// Number of items to retrieve each time
 	PageSize int32 `protobuf:"varint,2,opt,name=PageSize,proto3" json:"PageSize,omitempty"`
@@ -3330,7 +8318,7 @@ type ListFollowingFeedRequest struct {
 
 func (x *ListFollowingFeedRequest) Reset() {
 	*x = ListFollowingFeedRequest{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[53]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3342,7 +8330,7 @@ func (x *ListFollowingFeedRequest) String() string {
 func (*ListFollowingFeedRequest) ProtoMessage() {}
 
 func (x *ListFollowingFeedRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[53]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3355,9 +8343,10 @@ func (x *ListFollowingFeedRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowingFeedRequest.ProtoReflect.Descriptor instead.
 func (*ListFollowingFeedRequest) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{53}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{121}
 }
 
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
 func (x *ListFollowingFeedRequest) GetUserId() string {
 	if x != nil {
 		return x.UserId
@@ -3390,7 +8379,7 @@ type ListFollowingFeedResponse struct {
 
 func (x *ListFollowingFeedResponse) Reset() {
 	*x = ListFollowingFeedResponse{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[54]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3402,7 +8391,7 @@ func (x *ListFollowingFeedResponse) String() string {
 func (*ListFollowingFeedResponse) ProtoMessage() {}
 
 func (x *ListFollowingFeedResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[54]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3415,7 +8404,7 @@ func (x *ListFollowingFeedResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListFollowingFeedResponse.ProtoReflect.Descriptor instead.
 func (*ListFollowingFeedResponse) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{54}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{122}
 }
 
 func (x *ListFollowingFeedResponse) GetCode() string {
@@ -3452,7 +8441,8 @@ type FeedItem struct {
 	TargetId string `protobuf:"bytes,4,opt,name=TargetId,proto3" json:"TargetId,omitempty"`
 	// The timestamp of when this feed action occurred
 	DateCreated *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=DateCreated,proto3" json:"DateCreated,omitempty"`
-	// Optional extra field to store text or additional info
+	// Optional extra field to store text or additional info, the share ID
+	// for SHARE_TIP
 	ExtraInfo     string `protobuf:"bytes,6,opt,name=ExtraInfo,proto3" json:"ExtraInfo,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -3460,7 +8450,7 @@ type FeedItem struct {
 
 func (x *FeedItem) Reset() {
 	*x = FeedItem{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[55]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3472,7 +8462,7 @@ func (x *FeedItem) String() string {
 func (*FeedItem) ProtoMessage() {}
 
 func (x *FeedItem) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[55]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3485,7 +8475,7 @@ func (x *FeedItem) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
 func (*FeedItem) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{55}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{123}
 }
 
 func (x *FeedItem) GetFeedId() string {
@@ -3527,32 +8517,202 @@ func (x *FeedItem) GetExtraInfo() string {
 	if x != nil {
 		return x.ExtraInfo
 	}
-	return ""
+	return ""
+}
+
+type ListTipRevisionsResponse_ListTipRevisionsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Revisions     []*TipRevisionData     `protobuf:"bytes,1,rep,name=Revisions,proto3" json:"Revisions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTipRevisionsResponse_ListTipRevisionsData) Reset() {
+	*x = ListTipRevisionsResponse_ListTipRevisionsData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTipRevisionsResponse_ListTipRevisionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTipRevisionsResponse_ListTipRevisionsData) ProtoMessage() {}
+
+func (x *ListTipRevisionsResponse_ListTipRevisionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTipRevisionsResponse_ListTipRevisionsData.ProtoReflect.Descriptor instead.
+func (*ListTipRevisionsResponse_ListTipRevisionsData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{13, 0}
+}
+
+func (x *ListTipRevisionsResponse_ListTipRevisionsData) GetRevisions() []*TipRevisionData {
+	if x != nil {
+		return x.Revisions
+	}
+	return nil
+}
+
+func (x *ListTipRevisionsResponse_ListTipRevisionsData) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListTipsResponse_ListTipsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tips          []*TipData             `protobuf:"bytes,1,rep,name=Tips,proto3" json:"Tips,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTipsResponse_ListTipsData) Reset() {
+	*x = ListTipsResponse_ListTipsData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTipsResponse_ListTipsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTipsResponse_ListTipsData) ProtoMessage() {}
+
+func (x *ListTipsResponse_ListTipsData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTipsResponse_ListTipsData.ProtoReflect.Descriptor instead.
+func (*ListTipsResponse_ListTipsData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *ListTipsResponse_ListTipsData) GetTips() []*TipData {
+	if x != nil {
+		return x.Tips
+	}
+	return nil
+}
+
+func (x *ListTipsResponse_ListTipsData) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+type ListLeaderboardResponse_ListLeaderboardData struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Entries       []*LeaderboardEntryData `protobuf:"bytes,1,rep,name=Entries,proto3" json:"Entries,omitempty"`
+	NextCursor    string                  `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	ComputedAt    *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=ComputedAt,proto3" json:"ComputedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLeaderboardResponse_ListLeaderboardData) Reset() {
+	*x = ListLeaderboardResponse_ListLeaderboardData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLeaderboardResponse_ListLeaderboardData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLeaderboardResponse_ListLeaderboardData) ProtoMessage() {}
+
+func (x *ListLeaderboardResponse_ListLeaderboardData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLeaderboardResponse_ListLeaderboardData.ProtoReflect.Descriptor instead.
+func (*ListLeaderboardResponse_ListLeaderboardData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{31, 0}
+}
+
+func (x *ListLeaderboardResponse_ListLeaderboardData) GetEntries() []*LeaderboardEntryData {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListLeaderboardResponse_ListLeaderboardData) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListLeaderboardResponse_ListLeaderboardData) GetComputedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ComputedAt
+	}
+	return nil
 }
 
-type ListTipsResponse_ListTipsData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Tips          []*TipData             `protobuf:"bytes,1,rep,name=Tips,proto3" json:"Tips,omitempty"`
-	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+type VerifyTipsterLedgerResponse_VerifyTipsterLedgerData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the ledger has no issues
+	Valid bool `protobuf:"varint,1,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	// How many entries were checked
+	Entries int64 `protobuf:"varint,2,opt,name=Entries,proto3" json:"Entries,omitempty"`
+	// Hash of the last entry, which commits to the whole ledger
+	HeadHash string `protobuf:"bytes,3,opt,name=HeadHash,proto3" json:"HeadHash,omitempty"`
+	// Issues in ledger order
+	Issues        []*LedgerIssue `protobuf:"bytes,4,rep,name=Issues,proto3" json:"Issues,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListTipsResponse_ListTipsData) Reset() {
-	*x = ListTipsResponse_ListTipsData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[56]
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) Reset() {
+	*x = VerifyTipsterLedgerResponse_VerifyTipsterLedgerData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListTipsResponse_ListTipsData) String() string {
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListTipsResponse_ListTipsData) ProtoMessage() {}
+func (*VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) ProtoMessage() {}
 
-func (x *ListTipsResponse_ListTipsData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[56]
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3563,43 +8723,72 @@ func (x *ListTipsResponse_ListTipsData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListTipsResponse_ListTipsData.ProtoReflect.Descriptor instead.
-func (*ListTipsResponse_ListTipsData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{13, 0}
+// Deprecated: Use VerifyTipsterLedgerResponse_VerifyTipsterLedgerData.ProtoReflect.Descriptor instead.
+func (*VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{34, 0}
 }
 
-func (x *ListTipsResponse_ListTipsData) GetTips() []*TipData {
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) GetValid() bool {
 	if x != nil {
-		return x.Tips
+		return x.Valid
 	}
-	return nil
+	return false
 }
 
-func (x *ListTipsResponse_ListTipsData) GetNextCursor() string {
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) GetEntries() int64 {
 	if x != nil {
-		return x.NextCursor
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) GetHeadHash() string {
+	if x != nil {
+		return x.HeadHash
 	}
 	return ""
 }
 
+func (x *VerifyTipsterLedgerResponse_VerifyTipsterLedgerData) GetIssues() []*LedgerIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
 // User data message
 type CreateUserResponse_UserData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
-	UserName      string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
-	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
-	Tags          []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
-	Followers     []*UserDetail          `protobuf:"bytes,7,rep,name=Followers,proto3" json:"Followers,omitempty"`
-	Followings    []*UserDetail          `protobuf:"bytes,8,rep,name=Followings,proto3" json:"Followings,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	UserName  string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	Email     string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Tags      []string               `protobuf:"bytes,4,rep,name=Tags,proto3" json:"Tags,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	// Deprecated: no longer populated, use ListFollowers and ListFollowing
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	Followers []*UserDetail `protobuf:"bytes,7,rep,name=Followers,proto3" json:"Followers,omitempty"`
+	// Deprecated: no longer populated, use ListFollowers and ListFollowing
+	//
+	// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
+	Followings []*UserDetail           `protobuf:"bytes,8,rep,name=Followings,proto3" json:"Followings,omitempty"`
+	Role       UserRole                `protobuf:"varint,9,opt,name=Role,proto3,enum=protos.Tipster.UserRole" json:"Role,omitempty"`
+	Status     YM_Common.AccountStatus `protobuf:"varint,10,opt,name=Status,proto3,enum=YM.Common.AccountStatus" json:"Status,omitempty"`
+	// Set while a time-boxed self-exclusion is running
+	SelfExcludedUntil *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=SelfExcludedUntil,proto3" json:"SelfExcludedUntil,omitempty"`
+	Identities        []*IdentityData        `protobuf:"bytes,12,rep,name=Identities,proto3" json:"Identities,omitempty"`
+	FollowersCount    int64                  `protobuf:"varint,13,opt,name=FollowersCount,proto3" json:"FollowersCount,omitempty"`
+	FollowingCount    int64                  `protobuf:"varint,14,opt,name=FollowingCount,proto3" json:"FollowingCount,omitempty"`
+	// Followers of private users need the user's approval
+	Private       bool `protobuf:"varint,15,opt,name=Private,proto3" json:"Private,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *CreateUserResponse_UserData) Reset() {
 	*x = CreateUserResponse_UserData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[57]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3611,7 +8800,7 @@ func (x *CreateUserResponse_UserData) String() string {
 func (*CreateUserResponse_UserData) ProtoMessage() {}
 
 func (x *CreateUserResponse_UserData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[57]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3624,7 +8813,7 @@ func (x *CreateUserResponse_UserData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateUserResponse_UserData.ProtoReflect.Descriptor instead.
 func (*CreateUserResponse_UserData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{16, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *CreateUserResponse_UserData) GetUserId() string {
@@ -3669,6 +8858,7 @@ func (x *CreateUserResponse_UserData) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
 func (x *CreateUserResponse_UserData) GetFollowers() []*UserDetail {
 	if x != nil {
 		return x.Followers
@@ -3676,6 +8866,7 @@ func (x *CreateUserResponse_UserData) GetFollowers() []*UserDetail {
 	return nil
 }
 
+// Deprecated: Marked as deprecated in src/protos/Tipster/SocialMessage.proto.
 func (x *CreateUserResponse_UserData) GetFollowings() []*UserDetail {
 	if x != nil {
 		return x.Followings
@@ -3683,6 +8874,55 @@ func (x *CreateUserResponse_UserData) GetFollowings() []*UserDetail {
 	return nil
 }
 
+func (x *CreateUserResponse_UserData) GetRole() UserRole {
+	if x != nil {
+		return x.Role
+	}
+	return UserRole_USER_ROLE_UNSPECIFIED
+}
+
+func (x *CreateUserResponse_UserData) GetStatus() YM_Common.AccountStatus {
+	if x != nil {
+		return x.Status
+	}
+	return YM_Common.AccountStatus(0)
+}
+
+func (x *CreateUserResponse_UserData) GetSelfExcludedUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SelfExcludedUntil
+	}
+	return nil
+}
+
+func (x *CreateUserResponse_UserData) GetIdentities() []*IdentityData {
+	if x != nil {
+		return x.Identities
+	}
+	return nil
+}
+
+func (x *CreateUserResponse_UserData) GetFollowersCount() int64 {
+	if x != nil {
+		return x.FollowersCount
+	}
+	return 0
+}
+
+func (x *CreateUserResponse_UserData) GetFollowingCount() int64 {
+	if x != nil {
+		return x.FollowingCount
+	}
+	return 0
+}
+
+func (x *CreateUserResponse_UserData) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
 type ListUserResponse_ListUsersData struct {
 	state         protoimpl.MessageState         `protogen:"open.v1"`
 	Users         []*CreateUserResponse_UserData `protobuf:"bytes,1,rep,name=Users,proto3" json:"Users,omitempty"`
@@ -3693,7 +8933,7 @@ type ListUserResponse_ListUsersData struct {
 
 func (x *ListUserResponse_ListUsersData) Reset() {
 	*x = ListUserResponse_ListUsersData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[58]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3705,7 +8945,7 @@ func (x *ListUserResponse_ListUsersData) String() string {
 func (*ListUserResponse_ListUsersData) ProtoMessage() {}
 
 func (x *ListUserResponse_ListUsersData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[58]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3718,7 +8958,7 @@ func (x *ListUserResponse_ListUsersData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListUserResponse_ListUsersData.ProtoReflect.Descriptor instead.
 func (*ListUserResponse_ListUsersData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{25, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{52, 0}
 }
 
 func (x *ListUserResponse_ListUsersData) GetUsers() []*CreateUserResponse_UserData {
@@ -3735,6 +8975,126 @@ func (x *ListUserResponse_ListUsersData) GetNextCursor() string {
 	return ""
 }
 
+type LoginResponse_LoginData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	UserName      string                 `protobuf:"bytes,2,opt,name=UserName,proto3" json:"UserName,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=Email,proto3" json:"Email,omitempty"`
+	Token         *AuthToken             `protobuf:"bytes,4,opt,name=Token,proto3" json:"Token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginResponse_LoginData) Reset() {
+	*x = LoginResponse_LoginData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginResponse_LoginData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginResponse_LoginData) ProtoMessage() {}
+
+func (x *LoginResponse_LoginData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginResponse_LoginData.ProtoReflect.Descriptor instead.
+func (*LoginResponse_LoginData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{54, 0}
+}
+
+func (x *LoginResponse_LoginData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *LoginResponse_LoginData) GetUserName() string {
+	if x != nil {
+		return x.UserName
+	}
+	return ""
+}
+
+func (x *LoginResponse_LoginData) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LoginResponse_LoginData) GetToken() *AuthToken {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+type ListSelfExclusionsResponse_ListSelfExclusionsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Exclusions    []*SelfExclusionData   `protobuf:"bytes,1,rep,name=Exclusions,proto3" json:"Exclusions,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSelfExclusionsResponse_ListSelfExclusionsData) Reset() {
+	*x = ListSelfExclusionsResponse_ListSelfExclusionsData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSelfExclusionsResponse_ListSelfExclusionsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSelfExclusionsResponse_ListSelfExclusionsData) ProtoMessage() {}
+
+func (x *ListSelfExclusionsResponse_ListSelfExclusionsData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSelfExclusionsResponse_ListSelfExclusionsData.ProtoReflect.Descriptor instead.
+func (*ListSelfExclusionsResponse_ListSelfExclusionsData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{74, 0}
+}
+
+func (x *ListSelfExclusionsResponse_ListSelfExclusionsData) GetExclusions() []*SelfExclusionData {
+	if x != nil {
+		return x.Exclusions
+	}
+	return nil
+}
+
+func (x *ListSelfExclusionsResponse_ListSelfExclusionsData) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type LikeTipResponse_LikeTipData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Total number of likes after the action
@@ -3747,7 +9107,7 @@ type LikeTipResponse_LikeTipData struct {
 
 func (x *LikeTipResponse_LikeTipData) Reset() {
 	*x = LikeTipResponse_LikeTipData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[59]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3759,7 +9119,7 @@ func (x *LikeTipResponse_LikeTipData) String() string {
 func (*LikeTipResponse_LikeTipData) ProtoMessage() {}
 
 func (x *LikeTipResponse_LikeTipData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[59]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3772,7 +9132,7 @@ func (x *LikeTipResponse_LikeTipData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeTipResponse_LikeTipData.ProtoReflect.Descriptor instead.
 func (*LikeTipResponse_LikeTipData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{27, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{77, 0}
 }
 
 func (x *LikeTipResponse_LikeTipData) GetTotalLikes() int32 {
@@ -3800,7 +9160,7 @@ type LikeTipResponseAlias_LikeTipData struct {
 
 func (x *LikeTipResponseAlias_LikeTipData) Reset() {
 	*x = LikeTipResponseAlias_LikeTipData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[60]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3812,7 +9172,7 @@ func (x *LikeTipResponseAlias_LikeTipData) String() string {
 func (*LikeTipResponseAlias_LikeTipData) ProtoMessage() {}
 
 func (x *LikeTipResponseAlias_LikeTipData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[60]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3825,7 +9185,7 @@ func (x *LikeTipResponseAlias_LikeTipData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeTipResponseAlias_LikeTipData.ProtoReflect.Descriptor instead.
 func (*LikeTipResponseAlias_LikeTipData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{29, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{79, 0}
 }
 
 func (x *LikeTipResponseAlias_LikeTipData) GetTotalLikes() int32 {
@@ -3853,7 +9213,7 @@ type UnlikeTipResponse_UnLikeTipData struct {
 
 func (x *UnlikeTipResponse_UnLikeTipData) Reset() {
 	*x = UnlikeTipResponse_UnLikeTipData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[61]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3865,7 +9225,7 @@ func (x *UnlikeTipResponse_UnLikeTipData) String() string {
 func (*UnlikeTipResponse_UnLikeTipData) ProtoMessage() {}
 
 func (x *UnlikeTipResponse_UnLikeTipData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[61]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3878,7 +9238,7 @@ func (x *UnlikeTipResponse_UnLikeTipData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UnlikeTipResponse_UnLikeTipData.ProtoReflect.Descriptor instead.
 func (*UnlikeTipResponse_UnLikeTipData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{30, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{80, 0}
 }
 
 func (x *UnlikeTipResponse_UnLikeTipData) GetTotalUnLikes() int32 {
@@ -3905,7 +9265,7 @@ type LikeCommentResponse_LikeCommentData struct {
 
 func (x *LikeCommentResponse_LikeCommentData) Reset() {
 	*x = LikeCommentResponse_LikeCommentData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[62]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[135]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3917,7 +9277,7 @@ func (x *LikeCommentResponse_LikeCommentData) String() string {
 func (*LikeCommentResponse_LikeCommentData) ProtoMessage() {}
 
 func (x *LikeCommentResponse_LikeCommentData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[62]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[135]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3930,7 +9290,7 @@ func (x *LikeCommentResponse_LikeCommentData) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use LikeCommentResponse_LikeCommentData.ProtoReflect.Descriptor instead.
 func (*LikeCommentResponse_LikeCommentData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{37, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{87, 0}
 }
 
 func (x *LikeCommentResponse_LikeCommentData) GetTotalLikes() int32 {
@@ -3957,7 +9317,7 @@ type UnlikeCommentResponse_UnlikeCommentData struct {
 
 func (x *UnlikeCommentResponse_UnlikeCommentData) Reset() {
 	*x = UnlikeCommentResponse_UnlikeCommentData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[63]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[136]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3969,7 +9329,7 @@ func (x *UnlikeCommentResponse_UnlikeCommentData) String() string {
 func (*UnlikeCommentResponse_UnlikeCommentData) ProtoMessage() {}
 
 func (x *UnlikeCommentResponse_UnlikeCommentData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[63]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[136]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3982,7 +9342,7 @@ func (x *UnlikeCommentResponse_UnlikeCommentData) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use UnlikeCommentResponse_UnlikeCommentData.ProtoReflect.Descriptor instead.
 func (*UnlikeCommentResponse_UnlikeCommentData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{39, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{89, 0}
 }
 
 func (x *UnlikeCommentResponse_UnlikeCommentData) GetTotalUnLikes() int32 {
@@ -4017,7 +9377,7 @@ type ReplyCommentResponse_ReplyData struct {
 
 func (x *ReplyCommentResponse_ReplyData) Reset() {
 	*x = ReplyCommentResponse_ReplyData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[64]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[137]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4029,7 +9389,7 @@ func (x *ReplyCommentResponse_ReplyData) String() string {
 func (*ReplyCommentResponse_ReplyData) ProtoMessage() {}
 
 func (x *ReplyCommentResponse_ReplyData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[64]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[137]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4042,7 +9402,7 @@ func (x *ReplyCommentResponse_ReplyData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReplyCommentResponse_ReplyData.ProtoReflect.Descriptor instead.
 func (*ReplyCommentResponse_ReplyData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{41, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{91, 0}
 }
 
 func (x *ReplyCommentResponse_ReplyData) GetReplyId() string {
@@ -4056,28 +9416,133 @@ func (x *ReplyCommentResponse_ReplyData) GetParentCommentId() string {
 	if x != nil {
 		return x.ParentCommentId
 	}
-	return ""
+	return ""
+}
+
+func (x *ReplyCommentResponse_ReplyData) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReplyCommentResponse_ReplyData) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ReplyCommentResponse_ReplyData) GetDateCreated() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DateCreated
+	}
+	return nil
+}
+
+type ShareTipResponse_ShareTipData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Share *TipShareData          `protobuf:"bytes,1,opt,name=Share,proto3" json:"Share,omitempty"`
+	// The caller had already shared the tip, Share is the earlier share
+	AlreadyShared bool `protobuf:"varint,2,opt,name=AlreadyShared,proto3" json:"AlreadyShared,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareTipResponse_ShareTipData) Reset() {
+	*x = ShareTipResponse_ShareTipData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareTipResponse_ShareTipData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareTipResponse_ShareTipData) ProtoMessage() {}
+
+func (x *ShareTipResponse_ShareTipData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareTipResponse_ShareTipData.ProtoReflect.Descriptor instead.
+func (*ShareTipResponse_ShareTipData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{98, 0}
+}
+
+func (x *ShareTipResponse_ShareTipData) GetShare() *TipShareData {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+func (x *ShareTipResponse_ShareTipData) GetAlreadyShared() bool {
+	if x != nil {
+		return x.AlreadyShared
+	}
+	return false
+}
+
+type ListTipSharesResponse_ListTipSharesData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Shares        []*TipShareData        `protobuf:"bytes,1,rep,name=Shares,proto3" json:"Shares,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTipSharesResponse_ListTipSharesData) Reset() {
+	*x = ListTipSharesResponse_ListTipSharesData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTipSharesResponse_ListTipSharesData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTipSharesResponse_ListTipSharesData) ProtoMessage() {}
+
+func (x *ListTipSharesResponse_ListTipSharesData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-func (x *ReplyCommentResponse_ReplyData) GetUserId() string {
-	if x != nil {
-		return x.UserId
-	}
-	return ""
+// Deprecated: Use ListTipSharesResponse_ListTipSharesData.ProtoReflect.Descriptor instead.
+func (*ListTipSharesResponse_ListTipSharesData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{101, 0}
 }
 
-func (x *ReplyCommentResponse_ReplyData) GetContent() string {
+func (x *ListTipSharesResponse_ListTipSharesData) GetShares() []*TipShareData {
 	if x != nil {
-		return x.Content
+		return x.Shares
 	}
-	return ""
+	return nil
 }
 
-func (x *ReplyCommentResponse_ReplyData) GetDateCreated() *timestamppb.Timestamp {
+func (x *ListTipSharesResponse_ListTipSharesData) GetNextCursor() string {
 	if x != nil {
-		return x.DateCreated
+		return x.NextCursor
 	}
-	return nil
+	return ""
 }
 
 type FollowTipsterResponse_FollowData struct {
@@ -4087,14 +9552,18 @@ type FollowTipsterResponse_FollowData struct {
 	// The tipster's ID
 	TipsterId string `protobuf:"bytes,2,opt,name=TipsterId,proto3" json:"TipsterId,omitempty"`
 	// The user who followed
-	UserId        string `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	UserId string `protobuf:"bytes,3,opt,name=UserId,proto3" json:"UserId,omitempty"`
+	// Set when the user was already following this tipster
+	AlreadyFollowing bool `protobuf:"varint,4,opt,name=AlreadyFollowing,proto3" json:"AlreadyFollowing,omitempty"`
+	// Set when the tipster is private and a follow request awaits approval
+	Pending       bool `protobuf:"varint,5,opt,name=Pending,proto3" json:"Pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *FollowTipsterResponse_FollowData) Reset() {
 	*x = FollowTipsterResponse_FollowData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[65]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[140]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4106,7 +9575,7 @@ func (x *FollowTipsterResponse_FollowData) String() string {
 func (*FollowTipsterResponse_FollowData) ProtoMessage() {}
 
 func (x *FollowTipsterResponse_FollowData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[65]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[140]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4119,7 +9588,7 @@ func (x *FollowTipsterResponse_FollowData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FollowTipsterResponse_FollowData.ProtoReflect.Descriptor instead.
 func (*FollowTipsterResponse_FollowData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{50, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{103, 0}
 }
 
 func (x *FollowTipsterResponse_FollowData) GetIsFollowing() bool {
@@ -4143,6 +9612,20 @@ func (x *FollowTipsterResponse_FollowData) GetUserId() string {
 	return ""
 }
 
+func (x *FollowTipsterResponse_FollowData) GetAlreadyFollowing() bool {
+	if x != nil {
+		return x.AlreadyFollowing
+	}
+	return false
+}
+
+func (x *FollowTipsterResponse_FollowData) GetPending() bool {
+	if x != nil {
+		return x.Pending
+	}
+	return false
+}
+
 type UnfollowTipsterResponse_UnfollowData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Indicates if the user is now following this tipster (usually false for unfollow)
@@ -4157,7 +9640,7 @@ type UnfollowTipsterResponse_UnfollowData struct {
 
 func (x *UnfollowTipsterResponse_UnfollowData) Reset() {
 	*x = UnfollowTipsterResponse_UnfollowData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[66]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[141]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4169,7 +9652,7 @@ func (x *UnfollowTipsterResponse_UnfollowData) String() string {
 func (*UnfollowTipsterResponse_UnfollowData) ProtoMessage() {}
 
 func (x *UnfollowTipsterResponse_UnfollowData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[66]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[141]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4182,7 +9665,7 @@ func (x *UnfollowTipsterResponse_UnfollowData) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UnfollowTipsterResponse_UnfollowData.ProtoReflect.Descriptor instead.
 func (*UnfollowTipsterResponse_UnfollowData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{52, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{105, 0}
 }
 
 func (x *UnfollowTipsterResponse_UnfollowData) GetIsFollowing() bool {
@@ -4206,6 +9689,58 @@ func (x *UnfollowTipsterResponse_UnfollowData) GetUserId() string {
 	return ""
 }
 
+type ListFollowRequestsResponse_ListFollowRequestsData struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Requests      []*FollowRequestData   `protobuf:"bytes,1,rep,name=Requests,proto3" json:"Requests,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=NextCursor,proto3" json:"NextCursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFollowRequestsResponse_ListFollowRequestsData) Reset() {
+	*x = ListFollowRequestsResponse_ListFollowRequestsData{}
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFollowRequestsResponse_ListFollowRequestsData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFollowRequestsResponse_ListFollowRequestsData) ProtoMessage() {}
+
+func (x *ListFollowRequestsResponse_ListFollowRequestsData) ProtoReflect() protoreflect.Message {
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFollowRequestsResponse_ListFollowRequestsData.ProtoReflect.Descriptor instead.
+func (*ListFollowRequestsResponse_ListFollowRequestsData) Descriptor() ([]byte, []int) {
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{108, 0}
+}
+
+func (x *ListFollowRequestsResponse_ListFollowRequestsData) GetRequests() []*FollowRequestData {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *ListFollowRequestsResponse_ListFollowRequestsData) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
 type ListFollowingFeedResponse_ListFollowingFeedData struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Items []*FeedItem            `protobuf:"bytes,1,rep,name=Items,proto3" json:"Items,omitempty"`
@@ -4217,7 +9752,7 @@ type ListFollowingFeedResponse_ListFollowingFeedData struct {
 
 func (x *ListFollowingFeedResponse_ListFollowingFeedData) Reset() {
 	*x = ListFollowingFeedResponse_ListFollowingFeedData{}
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[67]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[143]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4229,7 +9764,7 @@ func (x *ListFollowingFeedResponse_ListFollowingFeedData) String() string {
 func (*ListFollowingFeedResponse_ListFollowingFeedData) ProtoMessage() {}
 
 func (x *ListFollowingFeedResponse_ListFollowingFeedData) ProtoReflect() protoreflect.Message {
-	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[67]
+	mi := &file_src_protos_Tipster_SocialMessage_proto_msgTypes[143]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4242,7 +9777,7 @@ func (x *ListFollowingFeedResponse_ListFollowingFeedData) ProtoReflect() protore
 
 // Deprecated: Use ListFollowingFeedResponse_ListFollowingFeedData.ProtoReflect.Descriptor instead.
 func (*ListFollowingFeedResponse_ListFollowingFeedData) Descriptor() ([]byte, []int) {
-	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{54, 0}
+	return file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP(), []int{122, 0}
 }
 
 func (x *ListFollowingFeedResponse_ListFollowingFeedData) GetItems() []*FeedItem {
@@ -4267,7 +9802,11 @@ var file_src_protos_Tipster_SocialMessage_proto_rawDesc = string([]byte{
 	0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
 	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x14, 0x55, 0x70, 0x64,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x59, 0x4d, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1d, 0x59, 0x4d, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x14, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12,
@@ -4283,490 +9822,1286 @@ var file_src_protos_Tipster_SocialMessage_proto_rawDesc = string([]byte{
 	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x92, 0x01,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0xa9, 0x02,
 	0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x54, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x22, 0x66, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72,
+	0x73, 0x74, 0x12, 0x20, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x0a,
+	0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x4f,
+	0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x66, 0x0a, 0x11, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x22, 0x61, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x4f, 0x64, 0x64, 0x73,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x64,
+	0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x22, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0xe1, 0x01, 0x0a, 0x10, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54,
+	0x69, 0x70, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x39, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0xc9, 0x01, 0x0a, 0x0e, 0x54, 0x69, 0x70,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x54,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54,
+	0x61, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12,
+	0x37, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3a, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x25, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49,
-	0x64, 0x22, 0x63, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0xb1, 0x02, 0x0a, 0x0f, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x4e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1a, 0x0a, 0x08, 0x45, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x45, 0x64, 0x69, 0x74, 0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x08,
+	0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x45, 0x64, 0x69, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x64, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06, 0x42, 0x65, 0x66, 0x6f,
+	0x72, 0x65, 0x12, 0x34, 0x0a, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x05, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0xa7, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x64, 0x64, 0x73,
+	0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x22, 0x8a, 0x02, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65,
+	0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x51, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x75, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x3d, 0x0a, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x28, 0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4d, 0x73, 0x67, 0x22, 0xa7, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x3a, 0x0a, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d,
+	0x61, 0x74, 0x52, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0xd8,
+	0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2b, 0x0a, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8a, 0x01, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49,
-	0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0x39, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x28,
-	0x0a, 0x10, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x4d, 0x73, 0x67, 0x22, 0x6b, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69,
-	0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
-	0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0xd8, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x41, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x69, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x5b,
-	0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b,
-	0x0a, 0x04, 0x54, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x41, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69,
+	0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x5b, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2b, 0x0a, 0x04,
+	0x54, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x54, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xc1, 0x05, 0x0a, 0x07, 0x54, 0x69,
+	0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x30, 0x0a,
+	0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12,
+	0x34, 0x0a, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x55, 0x6e,
+	0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18,
+	0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0a, 0x53, 0x68, 0x61, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3a, 0x0a, 0x09,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x54, 0x69, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x53,
+	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69,
-	0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x54, 0x69, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e,
-	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xfb, 0x02, 0x0a, 0x07,
-	0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x54,
-	0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12,
-	0x30, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a,
+	0x70, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x6f, 0x63, 0x6b, 0x65,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x4c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x06, 0x45, 0x64, 0x69, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xba, 0x03,
+	0x0a, 0x0c, 0x54, 0x69, 0x70, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53,
+	0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65,
+	0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x04,
+	0x4f, 0x64, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x42, 0x02, 0x18, 0x01, 0x52, 0x04,
+	0x4f, 0x64, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x12, 0x3c, 0x0a, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e,
+	0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69,
+	0x6c, 0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x12, 0x49, 0x6d, 0x70, 0x6c,
+	0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x2a,
+	0x0a, 0x04, 0x4c, 0x65, 0x67, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69,
+	0x70, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x4c, 0x65, 0x67, 0x73, 0x22, 0x8d, 0x03, 0x0a, 0x06, 0x54,
+	0x69, 0x70, 0x4c, 0x65, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x43,
+	0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x43, 0x6f, 0x6d, 0x70, 0x65, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x0d, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x4d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x3c, 0x0a, 0x0b, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65,
-	0x73, 0x12, 0x34, 0x0a, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x07,
-	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x49, 0x6d, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x50, 0x72, 0x6f, 0x62, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x12, 0x49, 0x6d, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x62,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0xb2, 0x01, 0x0a, 0x0d, 0x54,
+	0x69, 0x70, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x31, 0x0a, 0x06,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x74, 0x6c,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x75, 0x0a, 0x11, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73,
-	0x22, 0xd0, 0x03, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x3f, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0xd2,
-	0x02, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x42, 0x79, 0x22,
+	0x6d, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x31, 0x0a, 0x06, 0x52, 0x65, 0x73,
+	0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x4c, 0x65, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x4c, 0x65, 0x67, 0x22, 0xa7,
+	0x01, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x31, 0x0a, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a,
+	0x0a, 0x4c, 0x65, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x0a, 0x4c, 0x65,
+	0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xb0, 0x01, 0x0a, 0x18, 0x4c, 0x69, 0x73,
+	0x74, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x3a, 0x0a, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x52,
+	0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x19,
+	0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x41, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61,
+	0x74, 0x61, 0x22, 0xda, 0x03, 0x0a, 0x0c, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x69, 0x70, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x54, 0x69, 0x70, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x57, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x03, 0x57, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x4c, 0x6f, 0x73, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x56,
+	0x6f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x50, 0x75, 0x73, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x50,
+	0x75, 0x73, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x48, 0x61, 0x6c, 0x66, 0x57, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x48, 0x61, 0x6c, 0x66, 0x57, 0x6f, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x48, 0x61, 0x6c, 0x66, 0x4c, 0x6f, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x08, 0x48, 0x61, 0x6c, 0x66, 0x4c, 0x6f, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72,
+	0x69, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x53,
+	0x74, 0x72, 0x69, 0x6b, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x55, 0x6e, 0x69, 0x74, 0x73, 0x53, 0x74, 0x61, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x52, 0x6f, 0x69, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x03, 0x52, 0x6f, 0x69, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65,
+	0x4f, 0x64, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x41, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x4f, 0x64, 0x64, 0x73, 0x12, 0x32, 0x0a, 0x14, 0x4c, 0x6f, 0x6e, 0x67, 0x65,
+	0x73, 0x74, 0x57, 0x69, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x57, 0x69,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x30, 0x0a, 0x13, 0x4c,
+	0x6f, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x4c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6b, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x4c, 0x6f, 0x6e, 0x67, 0x65, 0x73,
+	0x74, 0x4c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6b, 0x12, 0x20, 0x0a,
+	0x0b, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x18, 0x10, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x44, 0x65, 0x63, 0x69, 0x64, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x22,
+	0x92, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67,
+	0x12, 0x32, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x59, 0x4d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x44, 0x61, 0x74, 0x65, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x09, 0x44, 0x61, 0x74, 0x65, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0x71, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x8c, 0x02, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74,
+	0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x39, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x57,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x39, 0x0a,
+	0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x21, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x52, 0x06, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x53, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x54, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x54, 0x61, 0x67,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x07, 0x4d, 0x69, 0x6e, 0x54, 0x69, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61,
+	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x94, 0x01, 0x0a, 0x14, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x52, 0x61, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x52,
+	0x61, 0x6e, 0x6b, 0x12, 0x34, 0x0a, 0x07, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x52, 0x07, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x53, 0x74, 0x61,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x53, 0x74, 0x61, 0x74, 0x73, 0x22, 0xc4, 0x02,
+	0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x4f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x3b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0xb1, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3e, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x3a, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x70,
+	0x75, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a,
-	0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x46, 0x6f,
-	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
-	0x77, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x43, 0x6f, 0x6d, 0x70, 0x75, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x8c, 0x01, 0x0a, 0x0b, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4b,
+	0x69, 0x6e, 0x64, 0x52, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x53, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x22, 0x3a, 0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0xb9, 0x02, 0x0a, 0x1b, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x57, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x43, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65,
+	0x64, 0x67, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x9a,
+	0x01, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x48, 0x65,
+	0x61, 0x64, 0x48, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x48, 0x65,
+	0x61, 0x64, 0x48, 0x61, 0x73, 0x68, 0x12, 0x33, 0x0a, 0x06, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x49, 0x73,
+	0x73, 0x75, 0x65, 0x52, 0x06, 0x49, 0x73, 0x73, 0x75, 0x65, 0x73, 0x22, 0x75, 0x0a, 0x11, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61,
+	0x67, 0x73, 0x22, 0xaa, 0x06, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12,
+	0x3f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0xac, 0x05, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67, 0x73, 0x12, 0x38, 0x0a, 0x09, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x3c, 0x0a, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x09, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x3e, 0x0a,
+	0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x2c, 0x0a,
+	0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x73, 0x22, 0x38, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49,
-	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a,
-	0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67,
-	0x12, 0x3f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x22, 0x71, 0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d,
-	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
-	0x12, 0x12, 0x0a, 0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
-	0x54, 0x61, 0x67, 0x73, 0x22, 0x3a, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10,
-	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67,
-	0x22, 0x2b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3a, 0x0a,
-	0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x4d, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74,
-	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65,
-	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x59, 0x4d,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x48, 0x0a,
+	0x11, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x55, 0x6e, 0x74,
+	0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x11, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64,
+	0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x3c, 0x0a, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0a, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x69, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a,
+	0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22,
+	0x38, 0x0a, 0x0a, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x28, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01,
+	0x0a, 0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x54, 0x61, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x54, 0x61, 0x67,
+	0x73, 0x12, 0x1d, 0x0a, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01,
+	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x3a, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x2b, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x76, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73,
+	0x67, 0x12, 0x3a, 0x0a, 0x0a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0a, 0x50, 0x75, 0x72, 0x67, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x22, 0x2c, 0x0a,
+	0x12, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x6a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x71, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
 	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x4d, 0x73, 0x67, 0x12, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x72, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x55,
-	0x73, 0x65, 0x72, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e,
-	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3e, 0x0a, 0x0e, 0x4c,
-	0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x0f,
-	0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x3f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61,
-	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4b, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69,
-	0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69,
-	0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b,
-	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69,
-	0x6b, 0x65, 0x64, 0x22, 0x40, 0x0a, 0x10, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12,
+	0x4d, 0x73, 0x67, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x67,
+	0x65, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x6a, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0x71, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d,
+	0x73, 0x67, 0x12, 0x32, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x67, 0x65,
+	0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x62, 0x0a, 0x0e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x55, 0x73, 0x65, 0x72,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65,
+	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x4d, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xf0, 0x01, 0x0a, 0x10, 0x4c, 0x69,
+	0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
 	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
 	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x4d, 0x73, 0x67, 0x12, 0x44, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
-	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4b, 0x0a, 0x0b, 0x4c, 0x69,
-	0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54,
-	0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65,
-	0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x73,
-	0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x55, 0x6e, 0x6c, 0x69,
-	0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
+	0x03, 0x4d, 0x73, 0x67, 0x12, 0x42, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x72, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x05, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x40, 0x0a, 0x0c,
+	0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61,
+	0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x22, 0xfb,
+	0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x1a, 0x86, 0x01, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x55, 0x73, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x2f, 0x0a, 0x05, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x05, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x39, 0x0a, 0x13,
+	0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x6b, 0x0a, 0x14, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2d, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x22, 0xf3, 0x01, 0x0a, 0x09, 0x41, 0x75, 0x74, 0x68, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x4e, 0x0a, 0x14, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x14,
+	0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72,
+	0x65, 0x73, 0x41, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x50, 0x0a, 0x15, 0x52, 0x65, 0x66, 0x72,
+	0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x15, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x22, 0x73, 0x0a, 0x18, 0x4c, 0x6f,
+	0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x59, 0x4d, 0x2e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12,
+	0x1e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x72, 0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22,
+	0xa6, 0x01, 0x0a, 0x19, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
 	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
 	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x4d, 0x73, 0x67, 0x12, 0x43, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x55, 0x0a, 0x0d, 0x55, 0x6e, 0x4c, 0x69,
-	0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x20, 0x0a,
-	0x0b, 0x55, 0x73, 0x65, 0x72, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22,
-	0xeb, 0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69,
-	0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06,
+	0x4d, 0x73, 0x67, 0x12, 0x3b, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x26, 0x0a, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x6e, 0x0a, 0x13, 0x4c, 0x69, 0x6e, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x37, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x59, 0x4d, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x64,
+	0x65, 0x6e, 0x64, 0x69, 0x74, 0x79, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x72,
+	0x65, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x6e, 0x0a, 0x14, 0x4c, 0x69, 0x6e, 0x6b,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x30, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x61,
+	0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x95, 0x01, 0x0a, 0x0c, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x37, 0x0a, 0x08, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x59, 0x4d,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x49, 0x64, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x79,
+	0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x08, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x36, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b,
+	0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x41, 0x74,
+	0x22, 0x5a, 0x0a, 0x12, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x18, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x52, 0x6f, 0x6c, 0x65, 0x22, 0x3b, 0x0a, 0x13,
+	0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x44, 0x0a, 0x12, 0x53, 0x75, 0x73,
+	0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x3b, 0x0a, 0x13, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x2f, 0x0a, 0x15,
+	0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3e, 0x0a,
+	0x16, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x42, 0x0a,
+	0x10, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x52, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x52, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x22, 0x39, 0x0a, 0x11, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x51, 0x0a, 0x12,
+	0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x3b, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22,
+	0x72, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x35, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x66,
+	0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x22, 0x6f, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x96, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c,
+	0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c,
+	0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0x7b, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x41, 0x0a, 0x0a, 0x45, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x0a, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xb2, 0x02,
+	0x0a, 0x11, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3b, 0x0a,
+	0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69,
+	0x6f, 0x64, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x18, 0x05,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x08,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b,
-	0x65, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x79, 0x0a,
-	0x13, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x52, 0x06, 0x45, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x64,
+	0x41, 0x74, 0x22, 0x42, 0x0a, 0x0e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0xc5, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x54,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10,
+	0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67,
+	0x12, 0x3f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x1a, 0x4b, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73,
+	0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x44,
+	0x0a, 0x10, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54,
+	0x69, 0x70, 0x49, 0x64, 0x22, 0xcf, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x4d, 0x73, 0x67, 0x12, 0x44, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x44,
+	0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4b, 0x0a, 0x0b, 0x4c, 0x69, 0x6b,
+	0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x73, 0x65,
+	0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x11, 0x55, 0x6e, 0x6c, 0x69, 0x6b,
+	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d,
+	0x73, 0x67, 0x12, 0x43, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x55, 0x0a, 0x0d, 0x55, 0x6e, 0x4c, 0x69, 0x6b,
+	0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b,
+	0x55, 0x73, 0x65, 0x72, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0xeb,
+	0x02, 0x0a, 0x0b, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
 	0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70,
-	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18,
-	0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x14, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2e, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x54,
-	0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x22, 0x4a, 0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
-	0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xd5, 0x01,
-	0x0a, 0x13, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x08, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x13,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x6d, 0x0a, 0x14, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x2f, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x22, 0x2e, 0x0a, 0x16, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x22, 0x78, 0x0a, 0x17, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x47, 0x0a, 0x04, 0x44,
-	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
-	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04,
-	0x44, 0x61, 0x74, 0x61, 0x1a, 0x4f, 0x0a, 0x0f, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e, 0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c,
-	0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72,
-	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x4c, 0x0a, 0x14, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x4d, 0x73, 0x67, 0x12, 0x4b, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x37, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
-	0x1a, 0x59, 0x0a, 0x11, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e,
-	0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x54, 0x6f, 0x74,
-	0x61, 0x6c, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x55, 0x73, 0x65,
-	0x72, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
-	0x55, 0x73, 0x65, 0x72, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x71, 0x0a, 0x13, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc2,
-	0x02, 0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x42, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74,
-	0x61, 0x1a, 0xbf, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12,
-	0x18, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x37, 0x0a, 0x08, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x12, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x22, 0xd5, 0x01, 0x0a, 0x13, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d,
+	0x73, 0x67, 0x12, 0x47, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x33, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x4f, 0x0a, 0x0f, 0x4c,
+	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1e,
+	0x0a, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x55, 0x73, 0x65, 0x72, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x14,
+	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1c, 0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xe5,
+	0x01, 0x0a, 0x15, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x4b,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e,
+	0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x59, 0x0a, 0x11, 0x55,
+	0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x22, 0x0a, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x55, 0x6e, 0x4c,
+	0x69, 0x6b, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x55, 0x6e, 0x4c, 0x69,
+	0x6b, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x55, 0x73, 0x65, 0x72, 0x55,
+	0x6e, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x22, 0x75, 0x0a, 0x13, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18,
+	0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x72,
 	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43,
-	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f,
-	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e,
-	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x1a, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0xc2, 0x02,
+	0x0a, 0x14, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x42, 0x0a, 0x04,
+	0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0xbf, 0x01, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6e,
+	0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x22, 0x45, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x28, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x1a, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x33, 0x0a,
+	0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52,
+	0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x52, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x51, 0x0a, 0x13,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
+	0x95, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73,
 	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
-	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x33,
-	0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6c,
-	0x69, 0x65, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x09, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x6e, 0x66,
-	0x6f, 0x12, 0x18, 0x0a, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x50,
-	0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x50, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x18, 0x0a,
-	0x07, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x18, 0x06,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x52, 0x05, 0x4c, 0x69, 0x6b, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b,
-	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x52, 0x07, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x73, 0x22, 0x51, 0x0a,
-	0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65,
-	0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72,
-	0x22, 0x95, 0x01, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12,
-	0x37, 0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
-	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74,
-	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65,
-	0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x5d, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x38, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43,
-	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73,
-	0x67, 0x22, 0x4c, 0x0a, 0x14, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74,
-	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x37,
+	0x0a, 0x08, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43,
+	0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x96, 0x01, 0x0a, 0x0f, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x37, 0x0a,
+	0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
+	0x22, 0xe5, 0x01, 0x0a, 0x10, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x41, 0x0a, 0x04, 0x44,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x68,
+	0x0a, 0x0c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x70, 0x44, 0x61, 0x74, 0x61, 0x12, 0x32,
+	0x0a, 0x05, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x54,
+	0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x05, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x41, 0x6c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x22, 0xb9, 0x02, 0x0a, 0x0c, 0x54, 0x69, 0x70,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70, 0x49, 0x64, 0x12, 0x32, 0x0a, 0x06, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x38, 0x0a, 0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x22, 0x68, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x54, 0x69, 0x70, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x54, 0x69, 0x70,
+	0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0xf5,
+	0x01, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x4b,
+	0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x37, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x69, 0x0a, 0x11, 0x4c,
+	0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x44, 0x61, 0x74, 0x61,
+	0x12, 0x34, 0x0a, 0x06, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x54, 0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x06,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74,
+	0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x50, 0x0a, 0x14, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a,
+	0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02,
+	0x18, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xb0, 0x02, 0x0a, 0x15, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x44, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0xaa,
+	0x01, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a,
+	0x0b, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12,
+	0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x10, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x10, 0x41, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
+	0x67, 0x12, 0x18, 0x0a, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x22, 0x52, 0x0a, 0x16, 0x55,
+	0x6e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
 	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0xe9, 0x01, 0x0a, 0x15, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0xf1, 0x01, 0x0a, 0x17, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73,
+	0x67, 0x12, 0x48, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x34, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x66, 0x0a, 0x0c, 0x55,
+	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x49,
+	0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x11, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x52, 0x09, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x65,
+	0x72, 0x12, 0x38, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x19, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0x92, 0x02, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x55, 0x0a, 0x04, 0x44, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x41, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61,
+	0x1a, 0x77, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x44, 0x61, 0x74, 0x61, 0x12, 0x3d, 0x0a, 0x08, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x08, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78,
+	0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e,
+	0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22, 0x3b, 0x0a, 0x1b, 0x41, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x44, 0x0a, 0x1c, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73,
+	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x3a, 0x0a, 0x1a,
+	0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x22, 0x43, 0x0a, 0x1b, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x2a, 0x0a,
+	0x10, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x39, 0x0a, 0x11, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4d, 0x73, 0x67, 0x22, 0x2c, 0x0a, 0x12, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x3b, 0x0a, 0x13, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
 	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64,
 	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a,
-	0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12,
-	0x44, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x30, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x52,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x64, 0x0a, 0x0a, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73, 0x46, 0x6f, 0x6c, 0x6c,
-	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x16, 0x55,
-	0x6e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x22, 0xf1, 0x01, 0x0a, 0x17,
-	0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22,
+	0x29, 0x0a, 0x0f, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x38, 0x0a, 0x10, 0x4d, 0x75,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x4d, 0x73, 0x67, 0x22, 0x2b, 0x0a, 0x11, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x3a, 0x0a, 0x12, 0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d,
-	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x48, 0x0a,
-	0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74,
-	0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x66, 0x0a, 0x0c, 0x55, 0x6e, 0x66, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x44, 0x61, 0x74, 0x61, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x73, 0x46, 0x6f, 0x6c,
-	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x73,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x69, 0x70,
-	0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x6e, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12,
-	0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x22,
-	0xff, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e,
-	0x67, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x4d, 0x73, 0x67, 0x12, 0x53, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
-	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67,
-	0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x44, 0x61,
-	0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x67, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x44, 0x61, 0x74,
-	0x61, 0x12, 0x2e, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74, 0x65, 0x6d,
-	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
-	0x72, 0x22, 0xee, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x16,
-	0x0a, 0x06, 0x46, 0x65, 0x65, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x46, 0x65, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
-	0x74, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x54, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e, 0x66,
-	0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49, 0x6e,
-	0x66, 0x6f, 0x2a, 0x99, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
-	0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
-	0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49, 0x4b, 0x45,
-	0x5f, 0x54, 0x49, 0x50, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x49,
-	0x50, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x04, 0x42, 0x2e,
-	0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
-	0x50, 0x01, 0x5a, 0x1a, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x54,
-	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x3b, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x4d, 0x73, 0x67, 0x22, 0x72, 0x0a,
+	0x18, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x06, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x06, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x22, 0xff, 0x01, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x53, 0x0a, 0x04, 0x44, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
+	0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x04, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x67, 0x0a, 0x15, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x2e, 0x0a, 0x05, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72, 0x73, 0x6f,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x65, 0x78, 0x74, 0x43, 0x75, 0x72,
+	0x73, 0x6f, 0x72, 0x22, 0xee, 0x01, 0x0a, 0x08, 0x46, 0x65, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x46, 0x65, 0x65, 0x64, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x46, 0x65, 0x65, 0x64, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x49, 0x64, 0x12, 0x36, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x65, 0x65, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x3c, 0x0a, 0x0b, 0x44, 0x61, 0x74, 0x65,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x44, 0x61, 0x74, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61, 0x49,
+	0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x49, 0x6e, 0x66, 0x6f, 0x2a, 0xad, 0x01, 0x0a, 0x09, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x12, 0x1a, 0x0a, 0x16, 0x54, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x12,
+	0x0a, 0x0e, 0x54, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x57, 0x4f, 0x4e,
+	0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54,
+	0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0x02, 0x12, 0x13, 0x0a, 0x0f, 0x54, 0x49, 0x50, 0x5f, 0x52,
+	0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x56, 0x4f, 0x49, 0x44, 0x10, 0x03, 0x12, 0x13, 0x0a, 0x0f,
+	0x54, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x50, 0x55, 0x53, 0x48, 0x10,
+	0x04, 0x12, 0x17, 0x0a, 0x13, 0x54, 0x49, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f,
+	0x48, 0x41, 0x4c, 0x46, 0x5f, 0x57, 0x4f, 0x4e, 0x10, 0x05, 0x12, 0x18, 0x0a, 0x14, 0x54, 0x49,
+	0x50, 0x5f, 0x52, 0x45, 0x53, 0x55, 0x4c, 0x54, 0x5f, 0x48, 0x41, 0x4c, 0x46, 0x5f, 0x4c, 0x4f,
+	0x53, 0x54, 0x10, 0x06, 0x2a, 0xc6, 0x01, 0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45,
+	0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x22,
+	0x0a, 0x1e, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49,
+	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x37, 0x5f, 0x44, 0x41, 0x59, 0x53,
+	0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52,
+	0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x33, 0x30,
+	0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x45, 0x41, 0x44, 0x45,
+	0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x4c, 0x41,
+	0x53, 0x54, 0x5f, 0x39, 0x30, 0x5f, 0x44, 0x41, 0x59, 0x53, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b,
+	0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x57, 0x49, 0x4e, 0x44,
+	0x4f, 0x57, 0x5f, 0x41, 0x4c, 0x4c, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x10, 0x04, 0x2a, 0x96, 0x01,
+	0x0a, 0x11, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41,
+	0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d, 0x0a, 0x19, 0x4c, 0x45, 0x41, 0x44, 0x45,
+	0x52, 0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x49, 0x54, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52,
+	0x42, 0x4f, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x52, 0x4f, 0x49,
+	0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x45, 0x41, 0x44, 0x45, 0x52, 0x42, 0x4f, 0x41, 0x52,
+	0x44, 0x5f, 0x4d, 0x45, 0x54, 0x52, 0x49, 0x43, 0x5f, 0x53, 0x54, 0x52, 0x49, 0x4b, 0x45, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x10, 0x03, 0x2a, 0xdd, 0x01, 0x0a, 0x0f, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x49, 0x73, 0x73, 0x75, 0x65, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45,
+	0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19, 0x0a,
+	0x15, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x4b, 0x49,
+	0x4e, 0x44, 0x5f, 0x47, 0x41, 0x50, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x44, 0x47,
+	0x45, 0x52, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x42, 0x52,
+	0x4f, 0x4b, 0x45, 0x4e, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x10, 0x02, 0x12, 0x23, 0x0a, 0x1f, 0x4c,
+	0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44,
+	0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x4d, 0x55, 0x54, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03,
+	0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45,
+	0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x50, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
+	0x44, 0x10, 0x04, 0x12, 0x21, 0x0a, 0x1d, 0x4c, 0x45, 0x44, 0x47, 0x45, 0x52, 0x5f, 0x49, 0x53,
+	0x53, 0x55, 0x45, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x5f, 0x54, 0x49, 0x50, 0x5f, 0x4d, 0x55, 0x54,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x78, 0x0a, 0x0a, 0x4f, 0x64, 0x64, 0x73, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x12, 0x1b, 0x0a, 0x17, 0x4f, 0x44, 0x44, 0x53, 0x5f, 0x46, 0x4f, 0x52,
+	0x4d, 0x41, 0x54, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x17, 0x0a, 0x13, 0x4f, 0x44, 0x44, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54,
+	0x5f, 0x44, 0x45, 0x43, 0x49, 0x4d, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x4f, 0x44,
+	0x44, 0x53, 0x5f, 0x46, 0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x46, 0x52, 0x41, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x41, 0x4c, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x4f, 0x44, 0x44, 0x53, 0x5f, 0x46,
+	0x4f, 0x52, 0x4d, 0x41, 0x54, 0x5f, 0x41, 0x4d, 0x45, 0x52, 0x49, 0x43, 0x41, 0x4e, 0x10, 0x03,
+	0x2a, 0xcb, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69,
+	0x6f, 0x6e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x25, 0x0a, 0x21, 0x53, 0x45, 0x4c, 0x46,
+	0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f,
+	0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12,
+	0x22, 0x0a, 0x1e, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x32, 0x34, 0x5f, 0x48, 0x4f, 0x55, 0x52,
+	0x53, 0x10, 0x01, 0x12, 0x20, 0x0a, 0x1c, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x45, 0x58, 0x43, 0x4c,
+	0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x37, 0x5f, 0x44,
+	0x41, 0x59, 0x53, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x53, 0x45, 0x4c, 0x46, 0x5f, 0x45, 0x58,
+	0x43, 0x4c, 0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49, 0x4f, 0x44, 0x5f, 0x36,
+	0x5f, 0x4d, 0x4f, 0x4e, 0x54, 0x48, 0x53, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x53, 0x45, 0x4c,
+	0x46, 0x5f, 0x45, 0x58, 0x43, 0x4c, 0x55, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x49,
+	0x4f, 0x44, 0x5f, 0x50, 0x45, 0x52, 0x4d, 0x41, 0x4e, 0x45, 0x4e, 0x54, 0x10, 0x04, 0x2a, 0x71,
+	0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x53,
+	0x48, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x53, 0x48, 0x41, 0x52, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x10, 0x01, 0x12, 0x1b,
+	0x0a, 0x17, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x52, 0x49,
+	0x45, 0x4e, 0x44, 0x53, 0x5f, 0x4f, 0x4e, 0x4c, 0x59, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x53,
+	0x48, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x59, 0x10,
+	0x03, 0x2a, 0x80, 0x01, 0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x19,
+	0x0a, 0x15, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
+	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x55, 0x53, 0x45,
+	0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x50, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x54, 0x49, 0x50,
+	0x53, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x17, 0x0a, 0x13, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52,
+	0x4f, 0x4c, 0x45, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x12,
+	0x13, 0x0a, 0x0f, 0x55, 0x53, 0x45, 0x52, 0x5f, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x41, 0x44, 0x4d,
+	0x49, 0x4e, 0x10, 0x04, 0x2a, 0x99, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x65, 0x64, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x45, 0x44, 0x5f,
+	0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x01, 0x12, 0x18,
+	0x0a, 0x14, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x49,
+	0x4b, 0x45, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x02, 0x12, 0x1b, 0x0a, 0x17, 0x46, 0x45, 0x45, 0x44,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x5f,
+	0x54, 0x49, 0x50, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x46, 0x45, 0x45, 0x44, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x54, 0x49, 0x50, 0x10, 0x04,
+	0x42, 0x2e, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x50, 0x01, 0x5a, 0x1a, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2f, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x3b, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -4781,126 +11116,298 @@ func file_src_protos_Tipster_SocialMessage_proto_rawDescGZIP() []byte {
 	return file_src_protos_Tipster_SocialMessage_proto_rawDescData
 }
 
-var file_src_protos_Tipster_SocialMessage_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_src_protos_Tipster_SocialMessage_proto_msgTypes = make([]protoimpl.MessageInfo, 68)
+var file_src_protos_Tipster_SocialMessage_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_src_protos_Tipster_SocialMessage_proto_msgTypes = make([]protoimpl.MessageInfo, 144)
 var file_src_protos_Tipster_SocialMessage_proto_goTypes = []any{
-	(FeedActionType)(0),                                     // 0: protos.Tipster.FeedActionType
-	(*UpdateCommentRequest)(nil),                            // 1: protos.Tipster.UpdateCommentRequest
-	(*UpdateCommentResponse)(nil),                           // 2: protos.Tipster.UpdateCommentResponse
-	(*DeleteCommentRequest)(nil),                            // 3: protos.Tipster.DeleteCommentRequest
-	(*DeleteCommentResponse)(nil),                           // 4: protos.Tipster.DeleteCommentResponse
-	(*CreateTipRequest)(nil),                                // 5: protos.Tipster.CreateTipRequest
-	(*CreateTipResponse)(nil),                               // 6: protos.Tipster.CreateTipResponse
-	(*GetTipRequest)(nil),                                   // 7: protos.Tipster.GetTipRequest
-	(*GetTipResponse)(nil),                                  // 8: protos.Tipster.GetTipResponse
-	(*UpdateTipRequest)(nil),                                // 9: protos.Tipster.UpdateTipRequest
-	(*UpdateTipResponse)(nil),                               // 10: protos.Tipster.UpdateTipResponse
-	(*DeleteTipRequest)(nil),                                // 11: protos.Tipster.DeleteTipRequest
-	(*DeleteTipResponse)(nil),                               // 12: protos.Tipster.DeleteTipResponse
-	(*ListTipsRequest)(nil),                                 // 13: protos.Tipster.ListTipsRequest
-	(*ListTipsResponse)(nil),                                // 14: protos.Tipster.ListTipsResponse
-	(*TipData)(nil),                                         // 15: protos.Tipster.TipData
-	(*CreateUserRequest)(nil),                               // 16: protos.Tipster.CreateUserRequest
-	(*CreateUserResponse)(nil),                              // 17: protos.Tipster.CreateUserResponse
-	(*UserDetail)(nil),                                      // 18: protos.Tipster.UserDetail
-	(*GetUserRequest)(nil),                                  // 19: protos.Tipster.GetUserRequest
-	(*GetUserResponse)(nil),                                 // 20: protos.Tipster.GetUserResponse
-	(*UpdateUserRequest)(nil),                               // 21: protos.Tipster.UpdateUserRequest
-	(*UpdateUserResponse)(nil),                              // 22: protos.Tipster.UpdateUserResponse
-	(*DeleteUserRequest)(nil),                               // 23: protos.Tipster.DeleteUserRequest
-	(*DeleteUserResponse)(nil),                              // 24: protos.Tipster.DeleteUserResponse
-	(*ListUserRequest)(nil),                                 // 25: protos.Tipster.ListUserRequest
-	(*ListUserResponse)(nil),                                // 26: protos.Tipster.ListUserResponse
-	(*LikeTipRequest)(nil),                                  // 27: protos.Tipster.LikeTipRequest
-	(*LikeTipResponse)(nil),                                 // 28: protos.Tipster.LikeTipResponse
-	(*UnlikeTipRequest)(nil),                                // 29: protos.Tipster.UnlikeTipRequest
-	(*LikeTipResponseAlias)(nil),                            // 30: protos.Tipster.LikeTipResponseAlias
-	(*UnlikeTipResponse)(nil),                               // 31: protos.Tipster.UnlikeTipResponse
-	(*CommentInfo)(nil),                                     // 32: protos.Tipster.CommentInfo
-	(*CommentOnTipRequest)(nil),                             // 33: protos.Tipster.CommentOnTipRequest
-	(*CommentOnTipResponse)(nil),                            // 34: protos.Tipster.CommentOnTipResponse
-	(*ListTipCommentsRequest)(nil),                          // 35: protos.Tipster.ListTipCommentsRequest
-	(*ListTipCommentsResponse)(nil),                         // 36: protos.Tipster.ListTipCommentsResponse
-	(*LikeCommentRequest)(nil),                              // 37: protos.Tipster.LikeCommentRequest
-	(*LikeCommentResponse)(nil),                             // 38: protos.Tipster.LikeCommentResponse
-	(*UnlikeCommentRequest)(nil),                            // 39: protos.Tipster.UnlikeCommentRequest
-	(*UnlikeCommentResponse)(nil),                           // 40: protos.Tipster.UnlikeCommentResponse
-	(*ReplyCommentRequest)(nil),                             // 41: protos.Tipster.ReplyCommentRequest
-	(*ReplyCommentResponse)(nil),                            // 42: protos.Tipster.ReplyCommentResponse
-	(*ListCommentRepliesRequest)(nil),                       // 43: protos.Tipster.ListCommentRepliesRequest
-	(*ListCommentRepliesResponse)(nil),                      // 44: protos.Tipster.ListCommentRepliesResponse
-	(*ReplyInfo)(nil),                                       // 45: protos.Tipster.ReplyInfo
-	(*ListCommentsRequest)(nil),                             // 46: protos.Tipster.ListCommentsRequest
-	(*ListCommentsResponse)(nil),                            // 47: protos.Tipster.ListCommentsResponse
-	(*ShareTipRequest)(nil),                                 // 48: protos.Tipster.ShareTipRequest
-	(*ShareTipResponse)(nil),                                // 49: protos.Tipster.ShareTipResponse
-	(*FollowTipsterRequest)(nil),                            // 50: protos.Tipster.FollowTipsterRequest
-	(*FollowTipsterResponse)(nil),                           // 51: protos.Tipster.FollowTipsterResponse
-	(*UnFollowTipsterRequest)(nil),                          // 52: protos.Tipster.UnFollowTipsterRequest
-	(*UnfollowTipsterResponse)(nil),                         // 53: protos.Tipster.UnfollowTipsterResponse
-	(*ListFollowingFeedRequest)(nil),                        // 54: protos.Tipster.ListFollowingFeedRequest
-	(*ListFollowingFeedResponse)(nil),                       // 55: protos.Tipster.ListFollowingFeedResponse
-	(*FeedItem)(nil),                                        // 56: protos.Tipster.FeedItem
-	(*ListTipsResponse_ListTipsData)(nil),                   // 57: protos.Tipster.ListTipsResponse.ListTipsData
-	(*CreateUserResponse_UserData)(nil),                     // 58: protos.Tipster.CreateUserResponse.UserData
-	(*ListUserResponse_ListUsersData)(nil),                  // 59: protos.Tipster.ListUserResponse.ListUsersData
-	(*LikeTipResponse_LikeTipData)(nil),                     // 60: protos.Tipster.LikeTipResponse.LikeTipData
-	(*LikeTipResponseAlias_LikeTipData)(nil),                // 61: protos.Tipster.LikeTipResponseAlias.LikeTipData
-	(*UnlikeTipResponse_UnLikeTipData)(nil),                 // 62: protos.Tipster.UnlikeTipResponse.UnLikeTipData
-	(*LikeCommentResponse_LikeCommentData)(nil),             // 63: protos.Tipster.LikeCommentResponse.LikeCommentData
-	(*UnlikeCommentResponse_UnlikeCommentData)(nil),         // 64: protos.Tipster.UnlikeCommentResponse.UnlikeCommentData
-	(*ReplyCommentResponse_ReplyData)(nil),                  // 65: protos.Tipster.ReplyCommentResponse.ReplyData
-	(*FollowTipsterResponse_FollowData)(nil),                // 66: protos.Tipster.FollowTipsterResponse.FollowData
-	(*UnfollowTipsterResponse_UnfollowData)(nil),            // 67: protos.Tipster.UnfollowTipsterResponse.UnfollowData
-	(*ListFollowingFeedResponse_ListFollowingFeedData)(nil), // 68: protos.Tipster.ListFollowingFeedResponse.ListFollowingFeedData
-	(*timestamppb.Timestamp)(nil),                           // 69: google.protobuf.Timestamp
+	(TipResult)(0),                                              // 0: protos.Tipster.TipResult
+	(LeaderboardWindow)(0),                                      // 1: protos.Tipster.LeaderboardWindow
+	(LeaderboardMetric)(0),                                      // 2: protos.Tipster.LeaderboardMetric
+	(LedgerIssueKind)(0),                                        // 3: protos.Tipster.LedgerIssueKind
+	(OddsFormat)(0),                                             // 4: protos.Tipster.OddsFormat
+	(SelfExclusionPeriod)(0),                                    // 5: protos.Tipster.SelfExclusionPeriod
+	(ShareType)(0),                                              // 6: protos.Tipster.ShareType
+	(UserRole)(0),                                               // 7: protos.Tipster.UserRole
+	(FeedActionType)(0),                                         // 8: protos.Tipster.FeedActionType
+	(*UpdateCommentRequest)(nil),                                // 9: protos.Tipster.UpdateCommentRequest
+	(*UpdateCommentResponse)(nil),                               // 10: protos.Tipster.UpdateCommentResponse
+	(*DeleteCommentRequest)(nil),                                // 11: protos.Tipster.DeleteCommentRequest
+	(*DeleteCommentResponse)(nil),                               // 12: protos.Tipster.DeleteCommentResponse
+	(*CreateTipRequest)(nil),                                    // 13: protos.Tipster.CreateTipRequest
+	(*CreateTipResponse)(nil),                                   // 14: protos.Tipster.CreateTipResponse
+	(*GetTipRequest)(nil),                                       // 15: protos.Tipster.GetTipRequest
+	(*GetTipResponse)(nil),                                      // 16: protos.Tipster.GetTipResponse
+	(*UpdateTipRequest)(nil),                                    // 17: protos.Tipster.UpdateTipRequest
+	(*UpdateTipResponse)(nil),                                   // 18: protos.Tipster.UpdateTipResponse
+	(*TipVersionData)(nil),                                      // 19: protos.Tipster.TipVersionData
+	(*TipRevisionData)(nil),                                     // 20: protos.Tipster.TipRevisionData
+	(*ListTipRevisionsRequest)(nil),                             // 21: protos.Tipster.ListTipRevisionsRequest
+	(*ListTipRevisionsResponse)(nil),                            // 22: protos.Tipster.ListTipRevisionsResponse
+	(*DeleteTipRequest)(nil),                                    // 23: protos.Tipster.DeleteTipRequest
+	(*DeleteTipResponse)(nil),                                   // 24: protos.Tipster.DeleteTipResponse
+	(*ListTipsRequest)(nil),                                     // 25: protos.Tipster.ListTipsRequest
+	(*ListTipsResponse)(nil),                                    // 26: protos.Tipster.ListTipsResponse
+	(*TipData)(nil),                                             // 27: protos.Tipster.TipData
+	(*TipSelection)(nil),                                        // 28: protos.Tipster.TipSelection
+	(*TipLeg)(nil),                                              // 29: protos.Tipster.TipLeg
+	(*TipSettlement)(nil),                                       // 30: protos.Tipster.TipSettlement
+	(*SettleTipRequest)(nil),                                    // 31: protos.Tipster.SettleTipRequest
+	(*SettleTipResponse)(nil),                                   // 32: protos.Tipster.SettleTipResponse
+	(*ListUnsettledTipsRequest)(nil),                            // 33: protos.Tipster.ListUnsettledTipsRequest
+	(*ListUnsettledTipsResponse)(nil),                           // 34: protos.Tipster.ListUnsettledTipsResponse
+	(*TipsterStats)(nil),                                        // 35: protos.Tipster.TipsterStats
+	(*GetTipsterStatsRequest)(nil),                              // 36: protos.Tipster.GetTipsterStatsRequest
+	(*GetTipsterStatsResponse)(nil),                             // 37: protos.Tipster.GetTipsterStatsResponse
+	(*ListLeaderboardRequest)(nil),                              // 38: protos.Tipster.ListLeaderboardRequest
+	(*LeaderboardEntryData)(nil),                                // 39: protos.Tipster.LeaderboardEntryData
+	(*ListLeaderboardResponse)(nil),                             // 40: protos.Tipster.ListLeaderboardResponse
+	(*LedgerIssue)(nil),                                         // 41: protos.Tipster.LedgerIssue
+	(*VerifyTipsterLedgerRequest)(nil),                          // 42: protos.Tipster.VerifyTipsterLedgerRequest
+	(*VerifyTipsterLedgerResponse)(nil),                         // 43: protos.Tipster.VerifyTipsterLedgerResponse
+	(*CreateUserRequest)(nil),                                   // 44: protos.Tipster.CreateUserRequest
+	(*CreateUserResponse)(nil),                                  // 45: protos.Tipster.CreateUserResponse
+	(*UserDetail)(nil),                                          // 46: protos.Tipster.UserDetail
+	(*GetUserRequest)(nil),                                      // 47: protos.Tipster.GetUserRequest
+	(*GetUserResponse)(nil),                                     // 48: protos.Tipster.GetUserResponse
+	(*UpdateUserRequest)(nil),                                   // 49: protos.Tipster.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                                  // 50: protos.Tipster.UpdateUserResponse
+	(*DeleteUserRequest)(nil),                                   // 51: protos.Tipster.DeleteUserRequest
+	(*DeleteUserResponse)(nil),                                  // 52: protos.Tipster.DeleteUserResponse
+	(*RestoreUserRequest)(nil),                                  // 53: protos.Tipster.RestoreUserRequest
+	(*RestoreUserResponse)(nil),                                 // 54: protos.Tipster.RestoreUserResponse
+	(*ListFollowersRequest)(nil),                                // 55: protos.Tipster.ListFollowersRequest
+	(*ListFollowersResponse)(nil),                               // 56: protos.Tipster.ListFollowersResponse
+	(*ListFollowingRequest)(nil),                                // 57: protos.Tipster.ListFollowingRequest
+	(*ListFollowingResponse)(nil),                               // 58: protos.Tipster.ListFollowingResponse
+	(*UserDetailPage)(nil),                                      // 59: protos.Tipster.UserDetailPage
+	(*ListUserRequest)(nil),                                     // 60: protos.Tipster.ListUserRequest
+	(*ListUserResponse)(nil),                                    // 61: protos.Tipster.ListUserResponse
+	(*LoginRequest)(nil),                                        // 62: protos.Tipster.LoginRequest
+	(*LoginResponse)(nil),                                       // 63: protos.Tipster.LoginResponse
+	(*RefreshTokenRequest)(nil),                                 // 64: protos.Tipster.RefreshTokenRequest
+	(*RefreshTokenResponse)(nil),                                // 65: protos.Tipster.RefreshTokenResponse
+	(*AuthToken)(nil),                                           // 66: protos.Tipster.AuthToken
+	(*LoginWithIdentityRequest)(nil),                            // 67: protos.Tipster.LoginWithIdentityRequest
+	(*LoginWithIdentityResponse)(nil),                           // 68: protos.Tipster.LoginWithIdentityResponse
+	(*LinkIdentityRequest)(nil),                                 // 69: protos.Tipster.LinkIdentityRequest
+	(*LinkIdentityResponse)(nil),                                // 70: protos.Tipster.LinkIdentityResponse
+	(*IdentityData)(nil),                                        // 71: protos.Tipster.IdentityData
+	(*SetUserRoleRequest)(nil),                                  // 72: protos.Tipster.SetUserRoleRequest
+	(*SetUserRoleResponse)(nil),                                 // 73: protos.Tipster.SetUserRoleResponse
+	(*SuspendUserRequest)(nil),                                  // 74: protos.Tipster.SuspendUserRequest
+	(*SuspendUserResponse)(nil),                                 // 75: protos.Tipster.SuspendUserResponse
+	(*ReactivateUserRequest)(nil),                               // 76: protos.Tipster.ReactivateUserRequest
+	(*ReactivateUserResponse)(nil),                              // 77: protos.Tipster.ReactivateUserResponse
+	(*CloseUserRequest)(nil),                                    // 78: protos.Tipster.CloseUserRequest
+	(*CloseUserResponse)(nil),                                   // 79: protos.Tipster.CloseUserResponse
+	(*SelfExcludeRequest)(nil),                                  // 80: protos.Tipster.SelfExcludeRequest
+	(*SelfExcludeResponse)(nil),                                 // 81: protos.Tipster.SelfExcludeResponse
+	(*ListSelfExclusionsRequest)(nil),                           // 82: protos.Tipster.ListSelfExclusionsRequest
+	(*ListSelfExclusionsResponse)(nil),                          // 83: protos.Tipster.ListSelfExclusionsResponse
+	(*SelfExclusionData)(nil),                                   // 84: protos.Tipster.SelfExclusionData
+	(*LikeTipRequest)(nil),                                      // 85: protos.Tipster.LikeTipRequest
+	(*LikeTipResponse)(nil),                                     // 86: protos.Tipster.LikeTipResponse
+	(*UnlikeTipRequest)(nil),                                    // 87: protos.Tipster.UnlikeTipRequest
+	(*LikeTipResponseAlias)(nil),                                // 88: protos.Tipster.LikeTipResponseAlias
+	(*UnlikeTipResponse)(nil),                                   // 89: protos.Tipster.UnlikeTipResponse
+	(*CommentInfo)(nil),                                         // 90: protos.Tipster.CommentInfo
+	(*CommentOnTipRequest)(nil),                                 // 91: protos.Tipster.CommentOnTipRequest
+	(*CommentOnTipResponse)(nil),                                // 92: protos.Tipster.CommentOnTipResponse
+	(*ListTipCommentsRequest)(nil),                              // 93: protos.Tipster.ListTipCommentsRequest
+	(*ListTipCommentsResponse)(nil),                             // 94: protos.Tipster.ListTipCommentsResponse
+	(*LikeCommentRequest)(nil),                                  // 95: protos.Tipster.LikeCommentRequest
+	(*LikeCommentResponse)(nil),                                 // 96: protos.Tipster.LikeCommentResponse
+	(*UnlikeCommentRequest)(nil),                                // 97: protos.Tipster.UnlikeCommentRequest
+	(*UnlikeCommentResponse)(nil),                               // 98: protos.Tipster.UnlikeCommentResponse
+	(*ReplyCommentRequest)(nil),                                 // 99: protos.Tipster.ReplyCommentRequest
+	(*ReplyCommentResponse)(nil),                                // 100: protos.Tipster.ReplyCommentResponse
+	(*ListCommentRepliesRequest)(nil),                           // 101: protos.Tipster.ListCommentRepliesRequest
+	(*ListCommentRepliesResponse)(nil),                          // 102: protos.Tipster.ListCommentRepliesResponse
+	(*ReplyInfo)(nil),                                           // 103: protos.Tipster.ReplyInfo
+	(*ListCommentsRequest)(nil),                                 // 104: protos.Tipster.ListCommentsRequest
+	(*ListCommentsResponse)(nil),                                // 105: protos.Tipster.ListCommentsResponse
+	(*ShareTipRequest)(nil),                                     // 106: protos.Tipster.ShareTipRequest
+	(*ShareTipResponse)(nil),                                    // 107: protos.Tipster.ShareTipResponse
+	(*TipShareData)(nil),                                        // 108: protos.Tipster.TipShareData
+	(*ListTipSharesRequest)(nil),                                // 109: protos.Tipster.ListTipSharesRequest
+	(*ListTipSharesResponse)(nil),                               // 110: protos.Tipster.ListTipSharesResponse
+	(*FollowTipsterRequest)(nil),                                // 111: protos.Tipster.FollowTipsterRequest
+	(*FollowTipsterResponse)(nil),                               // 112: protos.Tipster.FollowTipsterResponse
+	(*UnFollowTipsterRequest)(nil),                              // 113: protos.Tipster.UnFollowTipsterRequest
+	(*UnfollowTipsterResponse)(nil),                             // 114: protos.Tipster.UnfollowTipsterResponse
+	(*FollowRequestData)(nil),                                   // 115: protos.Tipster.FollowRequestData
+	(*ListFollowRequestsRequest)(nil),                           // 116: protos.Tipster.ListFollowRequestsRequest
+	(*ListFollowRequestsResponse)(nil),                          // 117: protos.Tipster.ListFollowRequestsResponse
+	(*ApproveFollowRequestRequest)(nil),                         // 118: protos.Tipster.ApproveFollowRequestRequest
+	(*ApproveFollowRequestResponse)(nil),                        // 119: protos.Tipster.ApproveFollowRequestResponse
+	(*RejectFollowRequestRequest)(nil),                          // 120: protos.Tipster.RejectFollowRequestRequest
+	(*RejectFollowRequestResponse)(nil),                         // 121: protos.Tipster.RejectFollowRequestResponse
+	(*BlockUserRequest)(nil),                                    // 122: protos.Tipster.BlockUserRequest
+	(*BlockUserResponse)(nil),                                   // 123: protos.Tipster.BlockUserResponse
+	(*UnblockUserRequest)(nil),                                  // 124: protos.Tipster.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                                 // 125: protos.Tipster.UnblockUserResponse
+	(*MuteUserRequest)(nil),                                     // 126: protos.Tipster.MuteUserRequest
+	(*MuteUserResponse)(nil),                                    // 127: protos.Tipster.MuteUserResponse
+	(*UnmuteUserRequest)(nil),                                   // 128: protos.Tipster.UnmuteUserRequest
+	(*UnmuteUserResponse)(nil),                                  // 129: protos.Tipster.UnmuteUserResponse
+	(*ListFollowingFeedRequest)(nil),                            // 130: protos.Tipster.ListFollowingFeedRequest
+	(*ListFollowingFeedResponse)(nil),                           // 131: protos.Tipster.ListFollowingFeedResponse
+	(*FeedItem)(nil),                                            // 132: protos.Tipster.FeedItem
+	(*ListTipRevisionsResponse_ListTipRevisionsData)(nil),       // 133: protos.Tipster.ListTipRevisionsResponse.ListTipRevisionsData
+	(*ListTipsResponse_ListTipsData)(nil),                       // 134: protos.Tipster.ListTipsResponse.ListTipsData
+	(*ListLeaderboardResponse_ListLeaderboardData)(nil),         // 135: protos.Tipster.ListLeaderboardResponse.ListLeaderboardData
+	(*VerifyTipsterLedgerResponse_VerifyTipsterLedgerData)(nil), // 136: protos.Tipster.VerifyTipsterLedgerResponse.VerifyTipsterLedgerData
+	(*CreateUserResponse_UserData)(nil),                         // 137: protos.Tipster.CreateUserResponse.UserData
+	(*ListUserResponse_ListUsersData)(nil),                      // 138: protos.Tipster.ListUserResponse.ListUsersData
+	(*LoginResponse_LoginData)(nil),                             // 139: protos.Tipster.LoginResponse.LoginData
+	(*ListSelfExclusionsResponse_ListSelfExclusionsData)(nil),   // 140: protos.Tipster.ListSelfExclusionsResponse.ListSelfExclusionsData
+	(*LikeTipResponse_LikeTipData)(nil),                         // 141: protos.Tipster.LikeTipResponse.LikeTipData
+	(*LikeTipResponseAlias_LikeTipData)(nil),                    // 142: protos.Tipster.LikeTipResponseAlias.LikeTipData
+	(*UnlikeTipResponse_UnLikeTipData)(nil),                     // 143: protos.Tipster.UnlikeTipResponse.UnLikeTipData
+	(*LikeCommentResponse_LikeCommentData)(nil),                 // 144: protos.Tipster.LikeCommentResponse.LikeCommentData
+	(*UnlikeCommentResponse_UnlikeCommentData)(nil),             // 145: protos.Tipster.UnlikeCommentResponse.UnlikeCommentData
+	(*ReplyCommentResponse_ReplyData)(nil),                      // 146: protos.Tipster.ReplyCommentResponse.ReplyData
+	(*ShareTipResponse_ShareTipData)(nil),                       // 147: protos.Tipster.ShareTipResponse.ShareTipData
+	(*ListTipSharesResponse_ListTipSharesData)(nil),             // 148: protos.Tipster.ListTipSharesResponse.ListTipSharesData
+	(*FollowTipsterResponse_FollowData)(nil),                    // 149: protos.Tipster.FollowTipsterResponse.FollowData
+	(*UnfollowTipsterResponse_UnfollowData)(nil),                // 150: protos.Tipster.UnfollowTipsterResponse.UnfollowData
+	(*ListFollowRequestsResponse_ListFollowRequestsData)(nil),   // 151: protos.Tipster.ListFollowRequestsResponse.ListFollowRequestsData
+	(*ListFollowingFeedResponse_ListFollowingFeedData)(nil),     // 152: protos.Tipster.ListFollowingFeedResponse.ListFollowingFeedData
+	(*timestamppb.Timestamp)(nil),                               // 153: google.protobuf.Timestamp
+	(*YM_Common.DateRange)(nil),                                 // 154: YM.Common.DateRange
+	(YM_Common.IdendityProvider)(0),                             // 155: YM.Common.IdendityProvider
+	(YM_Common.AccountStatus)(0),                                // 156: YM.Common.AccountStatus
 }
 var file_src_protos_Tipster_SocialMessage_proto_depIdxs = []int32{
-	15, // 0: protos.Tipster.CreateTipResponse.Data:type_name -> protos.Tipster.TipData
-	15, // 1: protos.Tipster.GetTipResponse.Data:type_name -> protos.Tipster.TipData
-	57, // 2: protos.Tipster.ListTipsResponse.Data:type_name -> protos.Tipster.ListTipsResponse.ListTipsData
-	18, // 3: protos.Tipster.TipData.Likes:type_name -> protos.Tipster.UserDetail
-	18, // 4: protos.Tipster.TipData.Unlikes:type_name -> protos.Tipster.UserDetail
-	69, // 5: protos.Tipster.TipData.CreatedAt:type_name -> google.protobuf.Timestamp
-	69, // 6: protos.Tipster.TipData.UpdatedAt:type_name -> google.protobuf.Timestamp
-	58, // 7: protos.Tipster.CreateUserResponse.Data:type_name -> protos.Tipster.CreateUserResponse.UserData
-	58, // 8: protos.Tipster.GetUserResponse.Data:type_name -> protos.Tipster.CreateUserResponse.UserData
-	59, // 9: protos.Tipster.ListUserResponse.Data:type_name -> protos.Tipster.ListUserResponse.ListUsersData
-	60, // 10: protos.Tipster.LikeTipResponse.Data:type_name -> protos.Tipster.LikeTipResponse.LikeTipData
-	61, // 11: protos.Tipster.LikeTipResponseAlias.Data:type_name -> protos.Tipster.LikeTipResponseAlias.LikeTipData
-	62, // 12: protos.Tipster.UnlikeTipResponse.Data:type_name -> protos.Tipster.UnlikeTipResponse.UnLikeTipData
-	69, // 13: protos.Tipster.CommentInfo.CreatedAt:type_name -> google.protobuf.Timestamp
-	69, // 14: protos.Tipster.CommentInfo.UpdatedAt:type_name -> google.protobuf.Timestamp
-	18, // 15: protos.Tipster.CommentInfo.Likes:type_name -> protos.Tipster.UserDetail
-	18, // 16: protos.Tipster.CommentInfo.Unlikes:type_name -> protos.Tipster.UserDetail
-	32, // 17: protos.Tipster.CommentOnTipResponse.Data:type_name -> protos.Tipster.CommentInfo
-	32, // 18: protos.Tipster.ListTipCommentsResponse.Comments:type_name -> protos.Tipster.CommentInfo
-	63, // 19: protos.Tipster.LikeCommentResponse.Data:type_name -> protos.Tipster.LikeCommentResponse.LikeCommentData
-	64, // 20: protos.Tipster.UnlikeCommentResponse.Data:type_name -> protos.Tipster.UnlikeCommentResponse.UnlikeCommentData
-	65, // 21: protos.Tipster.ReplyCommentResponse.Data:type_name -> protos.Tipster.ReplyCommentResponse.ReplyData
-	45, // 22: protos.Tipster.ListCommentRepliesResponse.Replies:type_name -> protos.Tipster.ReplyInfo
-	69, // 23: protos.Tipster.ReplyInfo.DateCreated:type_name -> google.protobuf.Timestamp
-	18, // 24: protos.Tipster.ReplyInfo.Likes:type_name -> protos.Tipster.UserDetail
-	18, // 25: protos.Tipster.ReplyInfo.Unlikes:type_name -> protos.Tipster.UserDetail
-	32, // 26: protos.Tipster.ListCommentsResponse.Comments:type_name -> protos.Tipster.CommentInfo
-	66, // 27: protos.Tipster.FollowTipsterResponse.Data:type_name -> protos.Tipster.FollowTipsterResponse.FollowData
-	67, // 28: protos.Tipster.UnfollowTipsterResponse.Data:type_name -> protos.Tipster.UnfollowTipsterResponse.UnfollowData
-	68, // 29: protos.Tipster.ListFollowingFeedResponse.Data:type_name -> protos.Tipster.ListFollowingFeedResponse.ListFollowingFeedData
-	0,  // 30: protos.Tipster.FeedItem.Action:type_name -> protos.Tipster.FeedActionType
-	69, // 31: protos.Tipster.FeedItem.DateCreated:type_name -> google.protobuf.Timestamp
-	15, // 32: protos.Tipster.ListTipsResponse.ListTipsData.Tips:type_name -> protos.Tipster.TipData
-	69, // 33: protos.Tipster.CreateUserResponse.UserData.CreatedAt:type_name -> google.protobuf.Timestamp
-	69, // 34: protos.Tipster.CreateUserResponse.UserData.UpdatedAt:type_name -> google.protobuf.Timestamp
-	18, // 35: protos.Tipster.CreateUserResponse.UserData.Followers:type_name -> protos.Tipster.UserDetail
-	18, // 36: protos.Tipster.CreateUserResponse.UserData.Followings:type_name -> protos.Tipster.UserDetail
-	58, // 37: protos.Tipster.ListUserResponse.ListUsersData.Users:type_name -> protos.Tipster.CreateUserResponse.UserData
-	69, // 38: protos.Tipster.ReplyCommentResponse.ReplyData.DateCreated:type_name -> google.protobuf.Timestamp
-	56, // 39: protos.Tipster.ListFollowingFeedResponse.ListFollowingFeedData.Items:type_name -> protos.Tipster.FeedItem
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	6,   // 0: protos.Tipster.CreateTipRequest.ShareType:type_name -> protos.Tipster.ShareType
+	28,  // 1: protos.Tipster.CreateTipRequest.Selection:type_name -> protos.Tipster.TipSelection
+	4,   // 2: protos.Tipster.CreateTipRequest.OddsFormat:type_name -> protos.Tipster.OddsFormat
+	27,  // 3: protos.Tipster.CreateTipResponse.Data:type_name -> protos.Tipster.TipData
+	4,   // 4: protos.Tipster.GetTipRequest.OddsFormat:type_name -> protos.Tipster.OddsFormat
+	27,  // 5: protos.Tipster.GetTipResponse.Data:type_name -> protos.Tipster.TipData
+	6,   // 6: protos.Tipster.UpdateTipRequest.ShareType:type_name -> protos.Tipster.ShareType
+	28,  // 7: protos.Tipster.UpdateTipRequest.Selection:type_name -> protos.Tipster.TipSelection
+	6,   // 8: protos.Tipster.TipVersionData.ShareType:type_name -> protos.Tipster.ShareType
+	28,  // 9: protos.Tipster.TipVersionData.Selection:type_name -> protos.Tipster.TipSelection
+	153, // 10: protos.Tipster.TipRevisionData.EditedAt:type_name -> google.protobuf.Timestamp
+	19,  // 11: protos.Tipster.TipRevisionData.Before:type_name -> protos.Tipster.TipVersionData
+	19,  // 12: protos.Tipster.TipRevisionData.After:type_name -> protos.Tipster.TipVersionData
+	4,   // 13: protos.Tipster.ListTipRevisionsRequest.OddsFormat:type_name -> protos.Tipster.OddsFormat
+	133, // 14: protos.Tipster.ListTipRevisionsResponse.Data:type_name -> protos.Tipster.ListTipRevisionsResponse.ListTipRevisionsData
+	4,   // 15: protos.Tipster.ListTipsRequest.OddsFormat:type_name -> protos.Tipster.OddsFormat
+	134, // 16: protos.Tipster.ListTipsResponse.Data:type_name -> protos.Tipster.ListTipsResponse.ListTipsData
+	46,  // 17: protos.Tipster.TipData.Likes:type_name -> protos.Tipster.UserDetail
+	46,  // 18: protos.Tipster.TipData.Unlikes:type_name -> protos.Tipster.UserDetail
+	153, // 19: protos.Tipster.TipData.CreatedAt:type_name -> google.protobuf.Timestamp
+	153, // 20: protos.Tipster.TipData.UpdatedAt:type_name -> google.protobuf.Timestamp
+	6,   // 21: protos.Tipster.TipData.ShareType:type_name -> protos.Tipster.ShareType
+	153, // 22: protos.Tipster.TipData.ExpiresAt:type_name -> google.protobuf.Timestamp
+	28,  // 23: protos.Tipster.TipData.Selection:type_name -> protos.Tipster.TipSelection
+	30,  // 24: protos.Tipster.TipData.Settlement:type_name -> protos.Tipster.TipSettlement
+	153, // 25: protos.Tipster.TipSelection.EventStartsAt:type_name -> google.protobuf.Timestamp
+	4,   // 26: protos.Tipster.TipSelection.PriceFormat:type_name -> protos.Tipster.OddsFormat
+	29,  // 27: protos.Tipster.TipSelection.Legs:type_name -> protos.Tipster.TipLeg
+	153, // 28: protos.Tipster.TipLeg.EventStartsAt:type_name -> google.protobuf.Timestamp
+	4,   // 29: protos.Tipster.TipLeg.PriceFormat:type_name -> protos.Tipster.OddsFormat
+	0,   // 30: protos.Tipster.TipLeg.Result:type_name -> protos.Tipster.TipResult
+	0,   // 31: protos.Tipster.TipSettlement.Result:type_name -> protos.Tipster.TipResult
+	153, // 32: protos.Tipster.TipSettlement.SettledAt:type_name -> google.protobuf.Timestamp
+	0,   // 33: protos.Tipster.SettleTipRequest.Result:type_name -> protos.Tipster.TipResult
+	30,  // 34: protos.Tipster.SettleTipResponse.Data:type_name -> protos.Tipster.TipSettlement
+	0,   // 35: protos.Tipster.SettleTipResponse.LegResults:type_name -> protos.Tipster.TipResult
+	4,   // 36: protos.Tipster.ListUnsettledTipsRequest.OddsFormat:type_name -> protos.Tipster.OddsFormat
+	134, // 37: protos.Tipster.ListUnsettledTipsResponse.Data:type_name -> protos.Tipster.ListTipsResponse.ListTipsData
+	154, // 38: protos.Tipster.GetTipsterStatsRequest.DateRange:type_name -> YM.Common.DateRange
+	35,  // 39: protos.Tipster.GetTipsterStatsResponse.Data:type_name -> protos.Tipster.TipsterStats
+	1,   // 40: protos.Tipster.ListLeaderboardRequest.Window:type_name -> protos.Tipster.LeaderboardWindow
+	2,   // 41: protos.Tipster.ListLeaderboardRequest.Metric:type_name -> protos.Tipster.LeaderboardMetric
+	46,  // 42: protos.Tipster.LeaderboardEntryData.Tipster:type_name -> protos.Tipster.UserDetail
+	35,  // 43: protos.Tipster.LeaderboardEntryData.Stats:type_name -> protos.Tipster.TipsterStats
+	135, // 44: protos.Tipster.ListLeaderboardResponse.Data:type_name -> protos.Tipster.ListLeaderboardResponse.ListLeaderboardData
+	3,   // 45: protos.Tipster.LedgerIssue.Kind:type_name -> protos.Tipster.LedgerIssueKind
+	136, // 46: protos.Tipster.VerifyTipsterLedgerResponse.Data:type_name -> protos.Tipster.VerifyTipsterLedgerResponse.VerifyTipsterLedgerData
+	137, // 47: protos.Tipster.CreateUserResponse.Data:type_name -> protos.Tipster.CreateUserResponse.UserData
+	137, // 48: protos.Tipster.GetUserResponse.Data:type_name -> protos.Tipster.CreateUserResponse.UserData
+	153, // 49: protos.Tipster.DeleteUserResponse.PurgeAfter:type_name -> google.protobuf.Timestamp
+	59,  // 50: protos.Tipster.ListFollowersResponse.Data:type_name -> protos.Tipster.UserDetailPage
+	59,  // 51: protos.Tipster.ListFollowingResponse.Data:type_name -> protos.Tipster.UserDetailPage
+	46,  // 52: protos.Tipster.UserDetailPage.Users:type_name -> protos.Tipster.UserDetail
+	138, // 53: protos.Tipster.ListUserResponse.Data:type_name -> protos.Tipster.ListUserResponse.ListUsersData
+	139, // 54: protos.Tipster.LoginResponse.Data:type_name -> protos.Tipster.LoginResponse.LoginData
+	66,  // 55: protos.Tipster.RefreshTokenResponse.Data:type_name -> protos.Tipster.AuthToken
+	153, // 56: protos.Tipster.AuthToken.AccessTokenExpiresAt:type_name -> google.protobuf.Timestamp
+	153, // 57: protos.Tipster.AuthToken.RefreshTokenExpiresAt:type_name -> google.protobuf.Timestamp
+	155, // 58: protos.Tipster.LoginWithIdentityRequest.Provider:type_name -> YM.Common.IdendityProvider
+	139, // 59: protos.Tipster.LoginWithIdentityResponse.Data:type_name -> protos.Tipster.LoginResponse.LoginData
+	155, // 60: protos.Tipster.LinkIdentityRequest.Provider:type_name -> YM.Common.IdendityProvider
+	71,  // 61: protos.Tipster.LinkIdentityResponse.Data:type_name -> protos.Tipster.IdentityData
+	155, // 62: protos.Tipster.IdentityData.Provider:type_name -> YM.Common.IdendityProvider
+	153, // 63: protos.Tipster.IdentityData.LinkedAt:type_name -> google.protobuf.Timestamp
+	7,   // 64: protos.Tipster.SetUserRoleRequest.Role:type_name -> protos.Tipster.UserRole
+	5,   // 65: protos.Tipster.SelfExcludeRequest.Period:type_name -> protos.Tipster.SelfExclusionPeriod
+	84,  // 66: protos.Tipster.SelfExcludeResponse.Data:type_name -> protos.Tipster.SelfExclusionData
+	140, // 67: protos.Tipster.ListSelfExclusionsResponse.Data:type_name -> protos.Tipster.ListSelfExclusionsResponse.ListSelfExclusionsData
+	5,   // 68: protos.Tipster.SelfExclusionData.Period:type_name -> protos.Tipster.SelfExclusionPeriod
+	153, // 69: protos.Tipster.SelfExclusionData.StartedAt:type_name -> google.protobuf.Timestamp
+	153, // 70: protos.Tipster.SelfExclusionData.EndsAt:type_name -> google.protobuf.Timestamp
+	153, // 71: protos.Tipster.SelfExclusionData.ExpiredAt:type_name -> google.protobuf.Timestamp
+	141, // 72: protos.Tipster.LikeTipResponse.Data:type_name -> protos.Tipster.LikeTipResponse.LikeTipData
+	142, // 73: protos.Tipster.LikeTipResponseAlias.Data:type_name -> protos.Tipster.LikeTipResponseAlias.LikeTipData
+	143, // 74: protos.Tipster.UnlikeTipResponse.Data:type_name -> protos.Tipster.UnlikeTipResponse.UnLikeTipData
+	153, // 75: protos.Tipster.CommentInfo.CreatedAt:type_name -> google.protobuf.Timestamp
+	153, // 76: protos.Tipster.CommentInfo.UpdatedAt:type_name -> google.protobuf.Timestamp
+	46,  // 77: protos.Tipster.CommentInfo.Likes:type_name -> protos.Tipster.UserDetail
+	46,  // 78: protos.Tipster.CommentInfo.Unlikes:type_name -> protos.Tipster.UserDetail
+	90,  // 79: protos.Tipster.CommentOnTipResponse.Data:type_name -> protos.Tipster.CommentInfo
+	90,  // 80: protos.Tipster.ListTipCommentsResponse.Comments:type_name -> protos.Tipster.CommentInfo
+	144, // 81: protos.Tipster.LikeCommentResponse.Data:type_name -> protos.Tipster.LikeCommentResponse.LikeCommentData
+	145, // 82: protos.Tipster.UnlikeCommentResponse.Data:type_name -> protos.Tipster.UnlikeCommentResponse.UnlikeCommentData
+	146, // 83: protos.Tipster.ReplyCommentResponse.Data:type_name -> protos.Tipster.ReplyCommentResponse.ReplyData
+	103, // 84: protos.Tipster.ListCommentRepliesResponse.Replies:type_name -> protos.Tipster.ReplyInfo
+	153, // 85: protos.Tipster.ReplyInfo.DateCreated:type_name -> google.protobuf.Timestamp
+	46,  // 86: protos.Tipster.ReplyInfo.Likes:type_name -> protos.Tipster.UserDetail
+	46,  // 87: protos.Tipster.ReplyInfo.Unlikes:type_name -> protos.Tipster.UserDetail
+	90,  // 88: protos.Tipster.ListCommentsResponse.Comments:type_name -> protos.Tipster.CommentInfo
+	6,   // 89: protos.Tipster.ShareTipRequest.ShareType:type_name -> protos.Tipster.ShareType
+	147, // 90: protos.Tipster.ShareTipResponse.Data:type_name -> protos.Tipster.ShareTipResponse.ShareTipData
+	46,  // 91: protos.Tipster.TipShareData.Sharer:type_name -> protos.Tipster.UserDetail
+	6,   // 92: protos.Tipster.TipShareData.ShareType:type_name -> protos.Tipster.ShareType
+	153, // 93: protos.Tipster.TipShareData.ExpiresAt:type_name -> google.protobuf.Timestamp
+	153, // 94: protos.Tipster.TipShareData.CreatedAt:type_name -> google.protobuf.Timestamp
+	148, // 95: protos.Tipster.ListTipSharesResponse.Data:type_name -> protos.Tipster.ListTipSharesResponse.ListTipSharesData
+	149, // 96: protos.Tipster.FollowTipsterResponse.Data:type_name -> protos.Tipster.FollowTipsterResponse.FollowData
+	150, // 97: protos.Tipster.UnfollowTipsterResponse.Data:type_name -> protos.Tipster.UnfollowTipsterResponse.UnfollowData
+	46,  // 98: protos.Tipster.FollowRequestData.Requester:type_name -> protos.Tipster.UserDetail
+	153, // 99: protos.Tipster.FollowRequestData.CreatedAt:type_name -> google.protobuf.Timestamp
+	151, // 100: protos.Tipster.ListFollowRequestsResponse.Data:type_name -> protos.Tipster.ListFollowRequestsResponse.ListFollowRequestsData
+	152, // 101: protos.Tipster.ListFollowingFeedResponse.Data:type_name -> protos.Tipster.ListFollowingFeedResponse.ListFollowingFeedData
+	8,   // 102: protos.Tipster.FeedItem.Action:type_name -> protos.Tipster.FeedActionType
+	153, // 103: protos.Tipster.FeedItem.DateCreated:type_name -> google.protobuf.Timestamp
+	20,  // 104: protos.Tipster.ListTipRevisionsResponse.ListTipRevisionsData.Revisions:type_name -> protos.Tipster.TipRevisionData
+	27,  // 105: protos.Tipster.ListTipsResponse.ListTipsData.Tips:type_name -> protos.Tipster.TipData
+	39,  // 106: protos.Tipster.ListLeaderboardResponse.ListLeaderboardData.Entries:type_name -> protos.Tipster.LeaderboardEntryData
+	153, // 107: protos.Tipster.ListLeaderboardResponse.ListLeaderboardData.ComputedAt:type_name -> google.protobuf.Timestamp
+	41,  // 108: protos.Tipster.VerifyTipsterLedgerResponse.VerifyTipsterLedgerData.Issues:type_name -> protos.Tipster.LedgerIssue
+	153, // 109: protos.Tipster.CreateUserResponse.UserData.CreatedAt:type_name -> google.protobuf.Timestamp
+	153, // 110: protos.Tipster.CreateUserResponse.UserData.UpdatedAt:type_name -> google.protobuf.Timestamp
+	46,  // 111: protos.Tipster.CreateUserResponse.UserData.Followers:type_name -> protos.Tipster.UserDetail
+	46,  // 112: protos.Tipster.CreateUserResponse.UserData.Followings:type_name -> protos.Tipster.UserDetail
+	7,   // 113: protos.Tipster.CreateUserResponse.UserData.Role:type_name -> protos.Tipster.UserRole
+	156, // 114: protos.Tipster.CreateUserResponse.UserData.Status:type_name -> YM.Common.AccountStatus
+	153, // 115: protos.Tipster.CreateUserResponse.UserData.SelfExcludedUntil:type_name -> google.protobuf.Timestamp
+	71,  // 116: protos.Tipster.CreateUserResponse.UserData.Identities:type_name -> protos.Tipster.IdentityData
+	137, // 117: protos.Tipster.ListUserResponse.ListUsersData.Users:type_name -> protos.Tipster.CreateUserResponse.UserData
+	66,  // 118: protos.Tipster.LoginResponse.LoginData.Token:type_name -> protos.Tipster.AuthToken
+	84,  // 119: protos.Tipster.ListSelfExclusionsResponse.ListSelfExclusionsData.Exclusions:type_name -> protos.Tipster.SelfExclusionData
+	153, // 120: protos.Tipster.ReplyCommentResponse.ReplyData.DateCreated:type_name -> google.protobuf.Timestamp
+	108, // 121: protos.Tipster.ShareTipResponse.ShareTipData.Share:type_name -> protos.Tipster.TipShareData
+	108, // 122: protos.Tipster.ListTipSharesResponse.ListTipSharesData.Shares:type_name -> protos.Tipster.TipShareData
+	115, // 123: protos.Tipster.ListFollowRequestsResponse.ListFollowRequestsData.Requests:type_name -> protos.Tipster.FollowRequestData
+	132, // 124: protos.Tipster.ListFollowingFeedResponse.ListFollowingFeedData.Items:type_name -> protos.Tipster.FeedItem
+	125, // [125:125] is the sub-list for method output_type
+	125, // [125:125] is the sub-list for method input_type
+	125, // [125:125] is the sub-list for extension type_name
+	125, // [125:125] is the sub-list for extension extendee
+	0,   // [0:125] is the sub-list for field type_name
 }
 
 func init() { file_src_protos_Tipster_SocialMessage_proto_init() }
@@ -4908,13 +11415,14 @@ func file_src_protos_Tipster_SocialMessage_proto_init() {
 	if File_src_protos_Tipster_SocialMessage_proto != nil {
 		return
 	}
+	file_src_protos_Tipster_SocialMessage_proto_msgTypes[40].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_src_protos_Tipster_SocialMessage_proto_rawDesc), len(file_src_protos_Tipster_SocialMessage_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   68,
+			NumEnums:      9,
+			NumMessages:   144,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -29,7 +29,7 @@ var file_src_protos_Tipster_SocialService_proto_rawDesc = string([]byte{
 	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x1a, 0x26, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2f, 0x53, 0x6f, 0x63,
 	0x69, 0x61, 0x6c, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x32, 0xa5, 0x11, 0x0a, 0x0d, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x32, 0x9e, 0x25, 0x0a, 0x0d, 0x53, 0x6f, 0x63, 0x69, 0x61, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69,
 	0x63, 0x65, 0x12, 0x53, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
 	0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
 	0x72, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
@@ -50,24 +50,140 @@ var file_src_protos_Tipster_SocialService_proto_rawDesc = string([]byte{
 	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x55, 0x73,
 	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a,
-	0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
-	0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a,
+	0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65,
+	0x72, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73,
-	0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x55,
-	0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x12, 0x26,
+	0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x71, 0x0a, 0x14, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f,
+	0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x65, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x41, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x65, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f, 0x6c,
+	0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6a, 0x65,
+	0x63, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x46, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x55, 0x73, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
+	0x08, 0x4d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x75, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4d, 0x75, 0x74, 0x65,
+	0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x53, 0x0a, 0x0a,
+	0x55, 0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x21, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6d, 0x75,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55,
+	0x6e, 0x6d, 0x75, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x05, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x12, 0x1c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x52, 0x65, 0x66, 0x72, 0x65,
+	0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65,
+	0x66, 0x72, 0x65, 0x73, 0x68, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69,
+	0x74, 0x68, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x57, 0x69, 0x74, 0x68, 0x49, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c,
+	0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x23, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x74, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x56, 0x0a, 0x0b, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x12, 0x22,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x55, 0x6e, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77,
-	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0e, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x53, 0x65,
+	0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x45,
+	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53,
+	0x65, 0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78,
+	0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65,
+	0x6c, 0x66, 0x45, 0x78, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x45, 0x78, 0x63,
+	0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
 	0x50, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x12, 0x20, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
@@ -82,205 +198,357 @@ var file_src_protos_Tipster_SocialService_proto_rawDesc = string([]byte{
 	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x54,
 	0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d,
-	0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f,
+	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x65, 0x0a, 0x10,
+	0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54,
+	0x69, 0x70, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x70,
+	0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70,
+	0x73, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a, 0x08, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x70,
+	0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73,
-	0x74, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4d, 0x0a,
-	0x08, 0x53, 0x68, 0x61, 0x72, 0x65, 0x54, 0x69, 0x70, 0x12, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07,
-	0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x50, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x69,
-	0x6b, 0x65, 0x54, 0x69, 0x70, 0x12, 0x20, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
-	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54,
-	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
-	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
-	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
-	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a,
-	0x0d, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24,
+	0x54, 0x69, 0x70, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x69, 0x70, 0x12, 0x20,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
-	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
-	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
-	0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70,
+	0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x53, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x65, 0x74,
+	0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x54, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a,
+	0x0f, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73,
+	0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62,
+	0x6f, 0x61, 0x72, 0x64, 0x12, 0x26, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
-	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
-	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f,
-	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68,
-	0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46,
-	0x65, 0x65, 0x64, 0x12, 0x28, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
-	0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69,
-	0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e,
+	0x73, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6e, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x12, 0x2a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x69, 0x66, 0x79, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x4c, 0x65, 0x64, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4a, 0x0a, 0x07, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70,
+	0x12, 0x1e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x50, 0x0a, 0x09, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x12, 0x20,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e,
+	0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e,
+	0x54, 0x69, 0x70, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x6e, 0x54, 0x69,
+	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x6e, 0x54, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c,
+	0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70,
+	0x73, 0x74, 0x65, 0x72, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x0f, 0x4c, 0x69,
+	0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x2e, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x1a, 0x73, 0x72,
-	0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2f, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72,
-	0x3b, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x69, 0x70, 0x43, 0x6f,
+	0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x56,
+	0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x22, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c,
+	0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5c, 0x0a, 0x0d, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73,
+	0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55, 0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43,
+	0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x55,
+	0x6e, 0x6c, 0x69, 0x6b, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69,
+	0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6b, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x70, 0x6c, 0x69, 0x65, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54,
+	0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x0c,
+	0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74,
+	0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x68, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x12, 0x28, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e,
+	0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x6f, 0x6c, 0x6c,
+	0x6f, 0x77, 0x69, 0x6e, 0x67, 0x46, 0x65, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x2e, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x54, 0x69, 0x70, 0x73,
+	0x74, 0x65, 0x72, 0x50, 0x01, 0x5a, 0x1a, 0x73, 0x72, 0x63, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65, 0x72, 0x3b, 0x54, 0x69, 0x70, 0x73, 0x74, 0x65,
+	0x72, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_src_protos_Tipster_SocialService_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),          // 0: protos.Tipster.CreateUserRequest
-	(*GetUserRequest)(nil),             // 1: protos.Tipster.GetUserRequest
-	(*UpdateUserRequest)(nil),          // 2: protos.Tipster.UpdateUserRequest
-	(*DeleteUserRequest)(nil),          // 3: protos.Tipster.DeleteUserRequest
-	(*ListUserRequest)(nil),            // 4: protos.Tipster.ListUserRequest
-	(*FollowTipsterRequest)(nil),       // 5: protos.Tipster.FollowTipsterRequest
-	(*UnFollowTipsterRequest)(nil),     // 6: protos.Tipster.UnFollowTipsterRequest
-	(*CreateTipRequest)(nil),           // 7: protos.Tipster.CreateTipRequest
-	(*GetTipRequest)(nil),              // 8: protos.Tipster.GetTipRequest
-	(*UpdateTipRequest)(nil),           // 9: protos.Tipster.UpdateTipRequest
-	(*DeleteTipRequest)(nil),           // 10: protos.Tipster.DeleteTipRequest
-	(*ListTipsRequest)(nil),            // 11: protos.Tipster.ListTipsRequest
-	(*ShareTipRequest)(nil),            // 12: protos.Tipster.ShareTipRequest
-	(*LikeTipRequest)(nil),             // 13: protos.Tipster.LikeTipRequest
-	(*UnlikeTipRequest)(nil),           // 14: protos.Tipster.UnlikeTipRequest
-	(*CommentOnTipRequest)(nil),        // 15: protos.Tipster.CommentOnTipRequest
-	(*UpdateCommentRequest)(nil),       // 16: protos.Tipster.UpdateCommentRequest
-	(*DeleteCommentRequest)(nil),       // 17: protos.Tipster.DeleteCommentRequest
-	(*ListTipCommentsRequest)(nil),     // 18: protos.Tipster.ListTipCommentsRequest
-	(*LikeCommentRequest)(nil),         // 19: protos.Tipster.LikeCommentRequest
-	(*UnlikeCommentRequest)(nil),       // 20: protos.Tipster.UnlikeCommentRequest
-	(*ReplyCommentRequest)(nil),        // 21: protos.Tipster.ReplyCommentRequest
-	(*ListCommentRepliesRequest)(nil),  // 22: protos.Tipster.ListCommentRepliesRequest
-	(*ListCommentsRequest)(nil),        // 23: protos.Tipster.ListCommentsRequest
-	(*ListFollowingFeedRequest)(nil),   // 24: protos.Tipster.ListFollowingFeedRequest
-	(*CreateUserResponse)(nil),         // 25: protos.Tipster.CreateUserResponse
-	(*GetUserResponse)(nil),            // 26: protos.Tipster.GetUserResponse
-	(*UpdateUserResponse)(nil),         // 27: protos.Tipster.UpdateUserResponse
-	(*DeleteUserResponse)(nil),         // 28: protos.Tipster.DeleteUserResponse
-	(*ListUserResponse)(nil),           // 29: protos.Tipster.ListUserResponse
-	(*FollowTipsterResponse)(nil),      // 30: protos.Tipster.FollowTipsterResponse
-	(*UnfollowTipsterResponse)(nil),    // 31: protos.Tipster.UnfollowTipsterResponse
-	(*CreateTipResponse)(nil),          // 32: protos.Tipster.CreateTipResponse
-	(*GetTipResponse)(nil),             // 33: protos.Tipster.GetTipResponse
-	(*UpdateTipResponse)(nil),          // 34: protos.Tipster.UpdateTipResponse
-	(*DeleteTipResponse)(nil),          // 35: protos.Tipster.DeleteTipResponse
-	(*ListTipsResponse)(nil),           // 36: protos.Tipster.ListTipsResponse
-	(*ShareTipResponse)(nil),           // 37: protos.Tipster.ShareTipResponse
-	(*LikeTipResponse)(nil),            // 38: protos.Tipster.LikeTipResponse
-	(*UnlikeTipResponse)(nil),          // 39: protos.Tipster.UnlikeTipResponse
-	(*CommentOnTipResponse)(nil),       // 40: protos.Tipster.CommentOnTipResponse
-	(*UpdateCommentResponse)(nil),      // 41: protos.Tipster.UpdateCommentResponse
-	(*DeleteCommentResponse)(nil),      // 42: protos.Tipster.DeleteCommentResponse
-	(*ListTipCommentsResponse)(nil),    // 43: protos.Tipster.ListTipCommentsResponse
-	(*LikeCommentResponse)(nil),        // 44: protos.Tipster.LikeCommentResponse
-	(*UnlikeCommentResponse)(nil),      // 45: protos.Tipster.UnlikeCommentResponse
-	(*ReplyCommentResponse)(nil),       // 46: protos.Tipster.ReplyCommentResponse
-	(*ListCommentRepliesResponse)(nil), // 47: protos.Tipster.ListCommentRepliesResponse
-	(*ListCommentsResponse)(nil),       // 48: protos.Tipster.ListCommentsResponse
-	(*ListFollowingFeedResponse)(nil),  // 49: protos.Tipster.ListFollowingFeedResponse
+	(*CreateUserRequest)(nil),            // 0: protos.Tipster.CreateUserRequest
+	(*GetUserRequest)(nil),               // 1: protos.Tipster.GetUserRequest
+	(*UpdateUserRequest)(nil),            // 2: protos.Tipster.UpdateUserRequest
+	(*DeleteUserRequest)(nil),            // 3: protos.Tipster.DeleteUserRequest
+	(*RestoreUserRequest)(nil),           // 4: protos.Tipster.RestoreUserRequest
+	(*ListUserRequest)(nil),              // 5: protos.Tipster.ListUserRequest
+	(*FollowTipsterRequest)(nil),         // 6: protos.Tipster.FollowTipsterRequest
+	(*UnFollowTipsterRequest)(nil),       // 7: protos.Tipster.UnFollowTipsterRequest
+	(*ListFollowersRequest)(nil),         // 8: protos.Tipster.ListFollowersRequest
+	(*ListFollowingRequest)(nil),         // 9: protos.Tipster.ListFollowingRequest
+	(*ListFollowRequestsRequest)(nil),    // 10: protos.Tipster.ListFollowRequestsRequest
+	(*ApproveFollowRequestRequest)(nil),  // 11: protos.Tipster.ApproveFollowRequestRequest
+	(*RejectFollowRequestRequest)(nil),   // 12: protos.Tipster.RejectFollowRequestRequest
+	(*BlockUserRequest)(nil),             // 13: protos.Tipster.BlockUserRequest
+	(*UnblockUserRequest)(nil),           // 14: protos.Tipster.UnblockUserRequest
+	(*MuteUserRequest)(nil),              // 15: protos.Tipster.MuteUserRequest
+	(*UnmuteUserRequest)(nil),            // 16: protos.Tipster.UnmuteUserRequest
+	(*LoginRequest)(nil),                 // 17: protos.Tipster.LoginRequest
+	(*RefreshTokenRequest)(nil),          // 18: protos.Tipster.RefreshTokenRequest
+	(*LoginWithIdentityRequest)(nil),     // 19: protos.Tipster.LoginWithIdentityRequest
+	(*LinkIdentityRequest)(nil),          // 20: protos.Tipster.LinkIdentityRequest
+	(*SetUserRoleRequest)(nil),           // 21: protos.Tipster.SetUserRoleRequest
+	(*SuspendUserRequest)(nil),           // 22: protos.Tipster.SuspendUserRequest
+	(*ReactivateUserRequest)(nil),        // 23: protos.Tipster.ReactivateUserRequest
+	(*CloseUserRequest)(nil),             // 24: protos.Tipster.CloseUserRequest
+	(*SelfExcludeRequest)(nil),           // 25: protos.Tipster.SelfExcludeRequest
+	(*ListSelfExclusionsRequest)(nil),    // 26: protos.Tipster.ListSelfExclusionsRequest
+	(*CreateTipRequest)(nil),             // 27: protos.Tipster.CreateTipRequest
+	(*GetTipRequest)(nil),                // 28: protos.Tipster.GetTipRequest
+	(*UpdateTipRequest)(nil),             // 29: protos.Tipster.UpdateTipRequest
+	(*ListTipRevisionsRequest)(nil),      // 30: protos.Tipster.ListTipRevisionsRequest
+	(*DeleteTipRequest)(nil),             // 31: protos.Tipster.DeleteTipRequest
+	(*ListTipsRequest)(nil),              // 32: protos.Tipster.ListTipsRequest
+	(*ShareTipRequest)(nil),              // 33: protos.Tipster.ShareTipRequest
+	(*ListTipSharesRequest)(nil),         // 34: protos.Tipster.ListTipSharesRequest
+	(*SettleTipRequest)(nil),             // 35: protos.Tipster.SettleTipRequest
+	(*ListUnsettledTipsRequest)(nil),     // 36: protos.Tipster.ListUnsettledTipsRequest
+	(*GetTipsterStatsRequest)(nil),       // 37: protos.Tipster.GetTipsterStatsRequest
+	(*ListLeaderboardRequest)(nil),       // 38: protos.Tipster.ListLeaderboardRequest
+	(*VerifyTipsterLedgerRequest)(nil),   // 39: protos.Tipster.VerifyTipsterLedgerRequest
+	(*LikeTipRequest)(nil),               // 40: protos.Tipster.LikeTipRequest
+	(*UnlikeTipRequest)(nil),             // 41: protos.Tipster.UnlikeTipRequest
+	(*CommentOnTipRequest)(nil),          // 42: protos.Tipster.CommentOnTipRequest
+	(*UpdateCommentRequest)(nil),         // 43: protos.Tipster.UpdateCommentRequest
+	(*DeleteCommentRequest)(nil),         // 44: protos.Tipster.DeleteCommentRequest
+	(*ListTipCommentsRequest)(nil),       // 45: protos.Tipster.ListTipCommentsRequest
+	(*LikeCommentRequest)(nil),           // 46: protos.Tipster.LikeCommentRequest
+	(*UnlikeCommentRequest)(nil),         // 47: protos.Tipster.UnlikeCommentRequest
+	(*ReplyCommentRequest)(nil),          // 48: protos.Tipster.ReplyCommentRequest
+	(*ListCommentRepliesRequest)(nil),    // 49: protos.Tipster.ListCommentRepliesRequest
+	(*ListCommentsRequest)(nil),          // 50: protos.Tipster.ListCommentsRequest
+	(*ListFollowingFeedRequest)(nil),     // 51: protos.Tipster.ListFollowingFeedRequest
+	(*CreateUserResponse)(nil),           // 52: protos.Tipster.CreateUserResponse
+	(*GetUserResponse)(nil),              // 53: protos.Tipster.GetUserResponse
+	(*UpdateUserResponse)(nil),           // 54: protos.Tipster.UpdateUserResponse
+	(*DeleteUserResponse)(nil),           // 55: protos.Tipster.DeleteUserResponse
+	(*RestoreUserResponse)(nil),          // 56: protos.Tipster.RestoreUserResponse
+	(*ListUserResponse)(nil),             // 57: protos.Tipster.ListUserResponse
+	(*FollowTipsterResponse)(nil),        // 58: protos.Tipster.FollowTipsterResponse
+	(*UnfollowTipsterResponse)(nil),      // 59: protos.Tipster.UnfollowTipsterResponse
+	(*ListFollowersResponse)(nil),        // 60: protos.Tipster.ListFollowersResponse
+	(*ListFollowingResponse)(nil),        // 61: protos.Tipster.ListFollowingResponse
+	(*ListFollowRequestsResponse)(nil),   // 62: protos.Tipster.ListFollowRequestsResponse
+	(*ApproveFollowRequestResponse)(nil), // 63: protos.Tipster.ApproveFollowRequestResponse
+	(*RejectFollowRequestResponse)(nil),  // 64: protos.Tipster.RejectFollowRequestResponse
+	(*BlockUserResponse)(nil),            // 65: protos.Tipster.BlockUserResponse
+	(*UnblockUserResponse)(nil),          // 66: protos.Tipster.UnblockUserResponse
+	(*MuteUserResponse)(nil),             // 67: protos.Tipster.MuteUserResponse
+	(*UnmuteUserResponse)(nil),           // 68: protos.Tipster.UnmuteUserResponse
+	(*LoginResponse)(nil),                // 69: protos.Tipster.LoginResponse
+	(*RefreshTokenResponse)(nil),         // 70: protos.Tipster.RefreshTokenResponse
+	(*LoginWithIdentityResponse)(nil),    // 71: protos.Tipster.LoginWithIdentityResponse
+	(*LinkIdentityResponse)(nil),         // 72: protos.Tipster.LinkIdentityResponse
+	(*SetUserRoleResponse)(nil),          // 73: protos.Tipster.SetUserRoleResponse
+	(*SuspendUserResponse)(nil),          // 74: protos.Tipster.SuspendUserResponse
+	(*ReactivateUserResponse)(nil),       // 75: protos.Tipster.ReactivateUserResponse
+	(*CloseUserResponse)(nil),            // 76: protos.Tipster.CloseUserResponse
+	(*SelfExcludeResponse)(nil),          // 77: protos.Tipster.SelfExcludeResponse
+	(*ListSelfExclusionsResponse)(nil),   // 78: protos.Tipster.ListSelfExclusionsResponse
+	(*CreateTipResponse)(nil),            // 79: protos.Tipster.CreateTipResponse
+	(*GetTipResponse)(nil),               // 80: protos.Tipster.GetTipResponse
+	(*UpdateTipResponse)(nil),            // 81: protos.Tipster.UpdateTipResponse
+	(*ListTipRevisionsResponse)(nil),     // 82: protos.Tipster.ListTipRevisionsResponse
+	(*DeleteTipResponse)(nil),            // 83: protos.Tipster.DeleteTipResponse
+	(*ListTipsResponse)(nil),             // 84: protos.Tipster.ListTipsResponse
+	(*ShareTipResponse)(nil),             // 85: protos.Tipster.ShareTipResponse
+	(*ListTipSharesResponse)(nil),        // 86: protos.Tipster.ListTipSharesResponse
+	(*SettleTipResponse)(nil),            // 87: protos.Tipster.SettleTipResponse
+	(*ListUnsettledTipsResponse)(nil),    // 88: protos.Tipster.ListUnsettledTipsResponse
+	(*GetTipsterStatsResponse)(nil),      // 89: protos.Tipster.GetTipsterStatsResponse
+	(*ListLeaderboardResponse)(nil),      // 90: protos.Tipster.ListLeaderboardResponse
+	(*VerifyTipsterLedgerResponse)(nil),  // 91: protos.Tipster.VerifyTipsterLedgerResponse
+	(*LikeTipResponse)(nil),              // 92: protos.Tipster.LikeTipResponse
+	(*UnlikeTipResponse)(nil),            // 93: protos.Tipster.UnlikeTipResponse
+	(*CommentOnTipResponse)(nil),         // 94: protos.Tipster.CommentOnTipResponse
+	(*UpdateCommentResponse)(nil),        // 95: protos.Tipster.UpdateCommentResponse
+	(*DeleteCommentResponse)(nil),        // 96: protos.Tipster.DeleteCommentResponse
+	(*ListTipCommentsResponse)(nil),      // 97: protos.Tipster.ListTipCommentsResponse
+	(*LikeCommentResponse)(nil),          // 98: protos.Tipster.LikeCommentResponse
+	(*UnlikeCommentResponse)(nil),        // 99: protos.Tipster.UnlikeCommentResponse
+	(*ReplyCommentResponse)(nil),         // 100: protos.Tipster.ReplyCommentResponse
+	(*ListCommentRepliesResponse)(nil),   // 101: protos.Tipster.ListCommentRepliesResponse
+	(*ListCommentsResponse)(nil),         // 102: protos.Tipster.ListCommentsResponse
+	(*ListFollowingFeedResponse)(nil),    // 103: protos.Tipster.ListFollowingFeedResponse
 }
 var file_src_protos_Tipster_SocialService_proto_depIdxs = []int32{
-	0,  // 0: protos.Tipster.SocialService.CreateUser:input_type -> protos.Tipster.CreateUserRequest
-	1,  // 1: protos.Tipster.SocialService.GetUser:input_type -> protos.Tipster.GetUserRequest
-	2,  // 2: protos.Tipster.SocialService.UpdateUser:input_type -> protos.Tipster.UpdateUserRequest
-	3,  // 3: protos.Tipster.SocialService.DeleteUser:input_type -> protos.Tipster.DeleteUserRequest
-	4,  // 4: protos.Tipster.SocialService.ListUsers:input_type -> protos.Tipster.ListUserRequest
-	5,  // 5: protos.Tipster.SocialService.FollowTipster:input_type -> protos.Tipster.FollowTipsterRequest
-	6,  // 6: protos.Tipster.SocialService.UnfollowTipster:input_type -> protos.Tipster.UnFollowTipsterRequest
-	7,  // 7: protos.Tipster.SocialService.CreateTip:input_type -> protos.Tipster.CreateTipRequest
-	8,  // 8: protos.Tipster.SocialService.GetTip:input_type -> protos.Tipster.GetTipRequest
-	9,  // 9: protos.Tipster.SocialService.UpdateTip:input_type -> protos.Tipster.UpdateTipRequest
-	10, // 10: protos.Tipster.SocialService.DeleteTip:input_type -> protos.Tipster.DeleteTipRequest
-	11, // 11: protos.Tipster.SocialService.ListTips:input_type -> protos.Tipster.ListTipsRequest
-	12, // 12: protos.Tipster.SocialService.ShareTip:input_type -> protos.Tipster.ShareTipRequest
-	13, // 13: protos.Tipster.SocialService.LikeTip:input_type -> protos.Tipster.LikeTipRequest
-	14, // 14: protos.Tipster.SocialService.UnlikeTip:input_type -> protos.Tipster.UnlikeTipRequest
-	15, // 15: protos.Tipster.SocialService.CommentOnTip:input_type -> protos.Tipster.CommentOnTipRequest
-	16, // 16: protos.Tipster.SocialService.UpdateComment:input_type -> protos.Tipster.UpdateCommentRequest
-	17, // 17: protos.Tipster.SocialService.DeleteComment:input_type -> protos.Tipster.DeleteCommentRequest
-	18, // 18: protos.Tipster.SocialService.ListTipComments:input_type -> protos.Tipster.ListTipCommentsRequest
-	19, // 19: protos.Tipster.SocialService.LikeComment:input_type -> protos.Tipster.LikeCommentRequest
-	20, // 20: protos.Tipster.SocialService.UnlikeComment:input_type -> protos.Tipster.UnlikeCommentRequest
-	21, // 21: protos.Tipster.SocialService.ReplyComment:input_type -> protos.Tipster.ReplyCommentRequest
-	22, // 22: protos.Tipster.SocialService.ListCommentReplies:input_type -> protos.Tipster.ListCommentRepliesRequest
-	23, // 23: protos.Tipster.SocialService.ListComments:input_type -> protos.Tipster.ListCommentsRequest
-	24, // 24: protos.Tipster.SocialService.ListFollowingFeed:input_type -> protos.Tipster.ListFollowingFeedRequest
-	25, // 25: protos.Tipster.SocialService.CreateUser:output_type -> protos.Tipster.CreateUserResponse
-	26, // 26: protos.Tipster.SocialService.GetUser:output_type -> protos.Tipster.GetUserResponse
-	27, // 27: protos.Tipster.SocialService.UpdateUser:output_type -> protos.Tipster.UpdateUserResponse
-	28, // 28: protos.Tipster.SocialService.DeleteUser:output_type -> protos.Tipster.DeleteUserResponse
-	29, // 29: protos.Tipster.SocialService.ListUsers:output_type -> protos.Tipster.ListUserResponse
-	30, // 30: protos.Tipster.SocialService.FollowTipster:output_type -> protos.Tipster.FollowTipsterResponse
-	31, // 31: protos.Tipster.SocialService.UnfollowTipster:output_type -> protos.Tipster.UnfollowTipsterResponse
-	32, // 32: protos.Tipster.SocialService.CreateTip:output_type -> protos.Tipster.CreateTipResponse
-	33, // 33: protos.Tipster.SocialService.GetTip:output_type -> protos.Tipster.GetTipResponse
-	34, // 34: protos.Tipster.SocialService.UpdateTip:output_type -> protos.Tipster.UpdateTipResponse
-	35, // 35: protos.Tipster.SocialService.DeleteTip:output_type -> protos.Tipster.DeleteTipResponse
-	36, // 36: protos.Tipster.SocialService.ListTips:output_type -> protos.Tipster.ListTipsResponse
-	37, // 37: protos.Tipster.SocialService.ShareTip:output_type -> protos.Tipster.ShareTipResponse
-	38, // 38: protos.Tipster.SocialService.LikeTip:output_type -> protos.Tipster.LikeTipResponse
-	39, // 39: protos.Tipster.SocialService.UnlikeTip:output_type -> protos.Tipster.UnlikeTipResponse
-	40, // 40: protos.Tipster.SocialService.CommentOnTip:output_type -> protos.Tipster.CommentOnTipResponse
-	41, // 41: protos.Tipster.SocialService.UpdateComment:output_type -> protos.Tipster.UpdateCommentResponse
-	42, // 42: protos.Tipster.SocialService.DeleteComment:output_type -> protos.Tipster.DeleteCommentResponse
-	43, // 43: protos.Tipster.SocialService.ListTipComments:output_type -> protos.Tipster.ListTipCommentsResponse
-	44, // 44: protos.Tipster.SocialService.LikeComment:output_type -> protos.Tipster.LikeCommentResponse
-	45, // 45: protos.Tipster.SocialService.UnlikeComment:output_type -> protos.Tipster.UnlikeCommentResponse
-	46, // 46: protos.Tipster.SocialService.ReplyComment:output_type -> protos.Tipster.ReplyCommentResponse
-	47, // 47: protos.Tipster.SocialService.ListCommentReplies:output_type -> protos.Tipster.ListCommentRepliesResponse
-	48, // 48: protos.Tipster.SocialService.ListComments:output_type -> protos.Tipster.ListCommentsResponse
-	49, // 49: protos.Tipster.SocialService.ListFollowingFeed:output_type -> protos.Tipster.ListFollowingFeedResponse
-	25, // [25:50] is the sub-list for method output_type
-	0,  // [0:25] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: protos.Tipster.SocialService.CreateUser:input_type -> protos.Tipster.CreateUserRequest
+	1,   // 1: protos.Tipster.SocialService.GetUser:input_type -> protos.Tipster.GetUserRequest
+	2,   // 2: protos.Tipster.SocialService.UpdateUser:input_type -> protos.Tipster.UpdateUserRequest
+	3,   // 3: protos.Tipster.SocialService.DeleteUser:input_type -> protos.Tipster.DeleteUserRequest
+	4,   // 4: protos.Tipster.SocialService.RestoreUser:input_type -> protos.Tipster.RestoreUserRequest
+	5,   // 5: protos.Tipster.SocialService.ListUsers:input_type -> protos.Tipster.ListUserRequest
+	6,   // 6: protos.Tipster.SocialService.FollowTipster:input_type -> protos.Tipster.FollowTipsterRequest
+	7,   // 7: protos.Tipster.SocialService.UnfollowTipster:input_type -> protos.Tipster.UnFollowTipsterRequest
+	8,   // 8: protos.Tipster.SocialService.ListFollowers:input_type -> protos.Tipster.ListFollowersRequest
+	9,   // 9: protos.Tipster.SocialService.ListFollowing:input_type -> protos.Tipster.ListFollowingRequest
+	10,  // 10: protos.Tipster.SocialService.ListFollowRequests:input_type -> protos.Tipster.ListFollowRequestsRequest
+	11,  // 11: protos.Tipster.SocialService.ApproveFollowRequest:input_type -> protos.Tipster.ApproveFollowRequestRequest
+	12,  // 12: protos.Tipster.SocialService.RejectFollowRequest:input_type -> protos.Tipster.RejectFollowRequestRequest
+	13,  // 13: protos.Tipster.SocialService.BlockUser:input_type -> protos.Tipster.BlockUserRequest
+	14,  // 14: protos.Tipster.SocialService.UnblockUser:input_type -> protos.Tipster.UnblockUserRequest
+	15,  // 15: protos.Tipster.SocialService.MuteUser:input_type -> protos.Tipster.MuteUserRequest
+	16,  // 16: protos.Tipster.SocialService.UnmuteUser:input_type -> protos.Tipster.UnmuteUserRequest
+	17,  // 17: protos.Tipster.SocialService.Login:input_type -> protos.Tipster.LoginRequest
+	18,  // 18: protos.Tipster.SocialService.RefreshToken:input_type -> protos.Tipster.RefreshTokenRequest
+	19,  // 19: protos.Tipster.SocialService.LoginWithIdentity:input_type -> protos.Tipster.LoginWithIdentityRequest
+	20,  // 20: protos.Tipster.SocialService.LinkIdentity:input_type -> protos.Tipster.LinkIdentityRequest
+	21,  // 21: protos.Tipster.SocialService.SetUserRole:input_type -> protos.Tipster.SetUserRoleRequest
+	22,  // 22: protos.Tipster.SocialService.SuspendUser:input_type -> protos.Tipster.SuspendUserRequest
+	23,  // 23: protos.Tipster.SocialService.ReactivateUser:input_type -> protos.Tipster.ReactivateUserRequest
+	24,  // 24: protos.Tipster.SocialService.CloseUser:input_type -> protos.Tipster.CloseUserRequest
+	25,  // 25: protos.Tipster.SocialService.SelfExclude:input_type -> protos.Tipster.SelfExcludeRequest
+	26,  // 26: protos.Tipster.SocialService.ListSelfExclusions:input_type -> protos.Tipster.ListSelfExclusionsRequest
+	27,  // 27: protos.Tipster.SocialService.CreateTip:input_type -> protos.Tipster.CreateTipRequest
+	28,  // 28: protos.Tipster.SocialService.GetTip:input_type -> protos.Tipster.GetTipRequest
+	29,  // 29: protos.Tipster.SocialService.UpdateTip:input_type -> protos.Tipster.UpdateTipRequest
+	30,  // 30: protos.Tipster.SocialService.ListTipRevisions:input_type -> protos.Tipster.ListTipRevisionsRequest
+	31,  // 31: protos.Tipster.SocialService.DeleteTip:input_type -> protos.Tipster.DeleteTipRequest
+	32,  // 32: protos.Tipster.SocialService.ListTips:input_type -> protos.Tipster.ListTipsRequest
+	33,  // 33: protos.Tipster.SocialService.ShareTip:input_type -> protos.Tipster.ShareTipRequest
+	34,  // 34: protos.Tipster.SocialService.ListTipShares:input_type -> protos.Tipster.ListTipSharesRequest
+	35,  // 35: protos.Tipster.SocialService.SettleTip:input_type -> protos.Tipster.SettleTipRequest
+	36,  // 36: protos.Tipster.SocialService.ListUnsettledTips:input_type -> protos.Tipster.ListUnsettledTipsRequest
+	37,  // 37: protos.Tipster.SocialService.GetTipsterStats:input_type -> protos.Tipster.GetTipsterStatsRequest
+	38,  // 38: protos.Tipster.SocialService.ListLeaderboard:input_type -> protos.Tipster.ListLeaderboardRequest
+	39,  // 39: protos.Tipster.SocialService.VerifyTipsterLedger:input_type -> protos.Tipster.VerifyTipsterLedgerRequest
+	40,  // 40: protos.Tipster.SocialService.LikeTip:input_type -> protos.Tipster.LikeTipRequest
+	41,  // 41: protos.Tipster.SocialService.UnlikeTip:input_type -> protos.Tipster.UnlikeTipRequest
+	42,  // 42: protos.Tipster.SocialService.CommentOnTip:input_type -> protos.Tipster.CommentOnTipRequest
+	43,  // 43: protos.Tipster.SocialService.UpdateComment:input_type -> protos.Tipster.UpdateCommentRequest
+	44,  // 44: protos.Tipster.SocialService.DeleteComment:input_type -> protos.Tipster.DeleteCommentRequest
+	45,  // 45: protos.Tipster.SocialService.ListTipComments:input_type -> protos.Tipster.ListTipCommentsRequest
+	46,  // 46: protos.Tipster.SocialService.LikeComment:input_type -> protos.Tipster.LikeCommentRequest
+	47,  // 47: protos.Tipster.SocialService.UnlikeComment:input_type -> protos.Tipster.UnlikeCommentRequest
+	48,  // 48: protos.Tipster.SocialService.ReplyComment:input_type -> protos.Tipster.ReplyCommentRequest
+	49,  // 49: protos.Tipster.SocialService.ListCommentReplies:input_type -> protos.Tipster.ListCommentRepliesRequest
+	50,  // 50: protos.Tipster.SocialService.ListComments:input_type -> protos.Tipster.ListCommentsRequest
+	51,  // 51: protos.Tipster.SocialService.ListFollowingFeed:input_type -> protos.Tipster.ListFollowingFeedRequest
+	52,  // 52: protos.Tipster.SocialService.CreateUser:output_type -> protos.Tipster.CreateUserResponse
+	53,  // 53: protos.Tipster.SocialService.GetUser:output_type -> protos.Tipster.GetUserResponse
+	54,  // 54: protos.Tipster.SocialService.UpdateUser:output_type -> protos.Tipster.UpdateUserResponse
+	55,  // 55: protos.Tipster.SocialService.DeleteUser:output_type -> protos.Tipster.DeleteUserResponse
+	56,  // 56: protos.Tipster.SocialService.RestoreUser:output_type -> protos.Tipster.RestoreUserResponse
+	57,  // 57: protos.Tipster.SocialService.ListUsers:output_type -> protos.Tipster.ListUserResponse
+	58,  // 58: protos.Tipster.SocialService.FollowTipster:output_type -> protos.Tipster.FollowTipsterResponse
+	59,  // 59: protos.Tipster.SocialService.UnfollowTipster:output_type -> protos.Tipster.UnfollowTipsterResponse
+	60,  // 60: protos.Tipster.SocialService.ListFollowers:output_type -> protos.Tipster.ListFollowersResponse
+	61,  // 61: protos.Tipster.SocialService.ListFollowing:output_type -> protos.Tipster.ListFollowingResponse
+	62,  // 62: protos.Tipster.SocialService.ListFollowRequests:output_type -> protos.Tipster.ListFollowRequestsResponse
+	63,  // 63: protos.Tipster.SocialService.ApproveFollowRequest:output_type -> protos.Tipster.ApproveFollowRequestResponse
+	64,  // 64: protos.Tipster.SocialService.RejectFollowRequest:output_type -> protos.Tipster.RejectFollowRequestResponse
+	65,  // 65: protos.Tipster.SocialService.BlockUser:output_type -> protos.Tipster.BlockUserResponse
+	66,  // 66: protos.Tipster.SocialService.UnblockUser:output_type -> protos.Tipster.UnblockUserResponse
+	67,  // 67: protos.Tipster.SocialService.MuteUser:output_type -> protos.Tipster.MuteUserResponse
+	68,  // 68: protos.Tipster.SocialService.UnmuteUser:output_type -> protos.Tipster.UnmuteUserResponse
+	69,  // 69: protos.Tipster.SocialService.Login:output_type -> protos.Tipster.LoginResponse
+	70,  // 70: protos.Tipster.SocialService.RefreshToken:output_type -> protos.Tipster.RefreshTokenResponse
+	71,  // 71: protos.Tipster.SocialService.LoginWithIdentity:output_type -> protos.Tipster.LoginWithIdentityResponse
+	72,  // 72: protos.Tipster.SocialService.LinkIdentity:output_type -> protos.Tipster.LinkIdentityResponse
+	73,  // 73: protos.Tipster.SocialService.SetUserRole:output_type -> protos.Tipster.SetUserRoleResponse
+	74,  // 74: protos.Tipster.SocialService.SuspendUser:output_type -> protos.Tipster.SuspendUserResponse
+	75,  // 75: protos.Tipster.SocialService.ReactivateUser:output_type -> protos.Tipster.ReactivateUserResponse
+	76,  // 76: protos.Tipster.SocialService.CloseUser:output_type -> protos.Tipster.CloseUserResponse
+	77,  // 77: protos.Tipster.SocialService.SelfExclude:output_type -> protos.Tipster.SelfExcludeResponse
+	78,  // 78: protos.Tipster.SocialService.ListSelfExclusions:output_type -> protos.Tipster.ListSelfExclusionsResponse
+	79,  // 79: protos.Tipster.SocialService.CreateTip:output_type -> protos.Tipster.CreateTipResponse
+	80,  // 80: protos.Tipster.SocialService.GetTip:output_type -> protos.Tipster.GetTipResponse
+	81,  // 81: protos.Tipster.SocialService.UpdateTip:output_type -> protos.Tipster.UpdateTipResponse
+	82,  // 82: protos.Tipster.SocialService.ListTipRevisions:output_type -> protos.Tipster.ListTipRevisionsResponse
+	83,  // 83: protos.Tipster.SocialService.DeleteTip:output_type -> protos.Tipster.DeleteTipResponse
+	84,  // 84: protos.Tipster.SocialService.ListTips:output_type -> protos.Tipster.ListTipsResponse
+	85,  // 85: protos.Tipster.SocialService.ShareTip:output_type -> protos.Tipster.ShareTipResponse
+	86,  // 86: protos.Tipster.SocialService.ListTipShares:output_type -> protos.Tipster.ListTipSharesResponse
+	87,  // 87: protos.Tipster.SocialService.SettleTip:output_type -> protos.Tipster.SettleTipResponse
+	88,  // 88: protos.Tipster.SocialService.ListUnsettledTips:output_type -> protos.Tipster.ListUnsettledTipsResponse
+	89,  // 89: protos.Tipster.SocialService.GetTipsterStats:output_type -> protos.Tipster.GetTipsterStatsResponse
+	90,  // 90: protos.Tipster.SocialService.ListLeaderboard:output_type -> protos.Tipster.ListLeaderboardResponse
+	91,  // 91: protos.Tipster.SocialService.VerifyTipsterLedger:output_type -> protos.Tipster.VerifyTipsterLedgerResponse
+	92,  // 92: protos.Tipster.SocialService.LikeTip:output_type -> protos.Tipster.LikeTipResponse
+	93,  // 93: protos.Tipster.SocialService.UnlikeTip:output_type -> protos.Tipster.UnlikeTipResponse
+	94,  // 94: protos.Tipster.SocialService.CommentOnTip:output_type -> protos.Tipster.CommentOnTipResponse
+	95,  // 95: protos.Tipster.SocialService.UpdateComment:output_type -> protos.Tipster.UpdateCommentResponse
+	96,  // 96: protos.Tipster.SocialService.DeleteComment:output_type -> protos.Tipster.DeleteCommentResponse
+	97,  // 97: protos.Tipster.SocialService.ListTipComments:output_type -> protos.Tipster.ListTipCommentsResponse
+	98,  // 98: protos.Tipster.SocialService.LikeComment:output_type -> protos.Tipster.LikeCommentResponse
+	99,  // 99: protos.Tipster.SocialService.UnlikeComment:output_type -> protos.Tipster.UnlikeCommentResponse
+	100, // 100: protos.Tipster.SocialService.ReplyComment:output_type -> protos.Tipster.ReplyCommentResponse
+	101, // 101: protos.Tipster.SocialService.ListCommentReplies:output_type -> protos.Tipster.ListCommentRepliesResponse
+	102, // 102: protos.Tipster.SocialService.ListComments:output_type -> protos.Tipster.ListCommentsResponse
+	103, // 103: protos.Tipster.SocialService.ListFollowingFeed:output_type -> protos.Tipster.ListFollowingFeedResponse
+	52,  // [52:104] is the sub-list for method output_type
+	0,   // [0:52] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_src_protos_Tipster_SocialService_proto_init() }
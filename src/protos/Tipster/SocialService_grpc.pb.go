@@ -19,31 +19,58 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SocialService_CreateUser_FullMethodName         = "/protos.Tipster.SocialService/CreateUser"
-	SocialService_GetUser_FullMethodName            = "/protos.Tipster.SocialService/GetUser"
-	SocialService_UpdateUser_FullMethodName         = "/protos.Tipster.SocialService/UpdateUser"
-	SocialService_DeleteUser_FullMethodName         = "/protos.Tipster.SocialService/DeleteUser"
-	SocialService_ListUsers_FullMethodName          = "/protos.Tipster.SocialService/ListUsers"
-	SocialService_FollowTipster_FullMethodName      = "/protos.Tipster.SocialService/FollowTipster"
-	SocialService_UnfollowTipster_FullMethodName    = "/protos.Tipster.SocialService/UnfollowTipster"
-	SocialService_CreateTip_FullMethodName          = "/protos.Tipster.SocialService/CreateTip"
-	SocialService_GetTip_FullMethodName             = "/protos.Tipster.SocialService/GetTip"
-	SocialService_UpdateTip_FullMethodName          = "/protos.Tipster.SocialService/UpdateTip"
-	SocialService_DeleteTip_FullMethodName          = "/protos.Tipster.SocialService/DeleteTip"
-	SocialService_ListTips_FullMethodName           = "/protos.Tipster.SocialService/ListTips"
-	SocialService_ShareTip_FullMethodName           = "/protos.Tipster.SocialService/ShareTip"
-	SocialService_LikeTip_FullMethodName            = "/protos.Tipster.SocialService/LikeTip"
-	SocialService_UnlikeTip_FullMethodName          = "/protos.Tipster.SocialService/UnlikeTip"
-	SocialService_CommentOnTip_FullMethodName       = "/protos.Tipster.SocialService/CommentOnTip"
-	SocialService_UpdateComment_FullMethodName      = "/protos.Tipster.SocialService/UpdateComment"
-	SocialService_DeleteComment_FullMethodName      = "/protos.Tipster.SocialService/DeleteComment"
-	SocialService_ListTipComments_FullMethodName    = "/protos.Tipster.SocialService/ListTipComments"
-	SocialService_LikeComment_FullMethodName        = "/protos.Tipster.SocialService/LikeComment"
-	SocialService_UnlikeComment_FullMethodName      = "/protos.Tipster.SocialService/UnlikeComment"
-	SocialService_ReplyComment_FullMethodName       = "/protos.Tipster.SocialService/ReplyComment"
-	SocialService_ListCommentReplies_FullMethodName = "/protos.Tipster.SocialService/ListCommentReplies"
-	SocialService_ListComments_FullMethodName       = "/protos.Tipster.SocialService/ListComments"
-	SocialService_ListFollowingFeed_FullMethodName  = "/protos.Tipster.SocialService/ListFollowingFeed"
+	SocialService_CreateUser_FullMethodName           = "/protos.Tipster.SocialService/CreateUser"
+	SocialService_GetUser_FullMethodName              = "/protos.Tipster.SocialService/GetUser"
+	SocialService_UpdateUser_FullMethodName           = "/protos.Tipster.SocialService/UpdateUser"
+	SocialService_DeleteUser_FullMethodName           = "/protos.Tipster.SocialService/DeleteUser"
+	SocialService_RestoreUser_FullMethodName          = "/protos.Tipster.SocialService/RestoreUser"
+	SocialService_ListUsers_FullMethodName            = "/protos.Tipster.SocialService/ListUsers"
+	SocialService_FollowTipster_FullMethodName        = "/protos.Tipster.SocialService/FollowTipster"
+	SocialService_UnfollowTipster_FullMethodName      = "/protos.Tipster.SocialService/UnfollowTipster"
+	SocialService_ListFollowers_FullMethodName        = "/protos.Tipster.SocialService/ListFollowers"
+	SocialService_ListFollowing_FullMethodName        = "/protos.Tipster.SocialService/ListFollowing"
+	SocialService_ListFollowRequests_FullMethodName   = "/protos.Tipster.SocialService/ListFollowRequests"
+	SocialService_ApproveFollowRequest_FullMethodName = "/protos.Tipster.SocialService/ApproveFollowRequest"
+	SocialService_RejectFollowRequest_FullMethodName  = "/protos.Tipster.SocialService/RejectFollowRequest"
+	SocialService_BlockUser_FullMethodName            = "/protos.Tipster.SocialService/BlockUser"
+	SocialService_UnblockUser_FullMethodName          = "/protos.Tipster.SocialService/UnblockUser"
+	SocialService_MuteUser_FullMethodName             = "/protos.Tipster.SocialService/MuteUser"
+	SocialService_UnmuteUser_FullMethodName           = "/protos.Tipster.SocialService/UnmuteUser"
+	SocialService_Login_FullMethodName                = "/protos.Tipster.SocialService/Login"
+	SocialService_RefreshToken_FullMethodName         = "/protos.Tipster.SocialService/RefreshToken"
+	SocialService_LoginWithIdentity_FullMethodName    = "/protos.Tipster.SocialService/LoginWithIdentity"
+	SocialService_LinkIdentity_FullMethodName         = "/protos.Tipster.SocialService/LinkIdentity"
+	SocialService_SetUserRole_FullMethodName          = "/protos.Tipster.SocialService/SetUserRole"
+	SocialService_SuspendUser_FullMethodName          = "/protos.Tipster.SocialService/SuspendUser"
+	SocialService_ReactivateUser_FullMethodName       = "/protos.Tipster.SocialService/ReactivateUser"
+	SocialService_CloseUser_FullMethodName            = "/protos.Tipster.SocialService/CloseUser"
+	SocialService_SelfExclude_FullMethodName          = "/protos.Tipster.SocialService/SelfExclude"
+	SocialService_ListSelfExclusions_FullMethodName   = "/protos.Tipster.SocialService/ListSelfExclusions"
+	SocialService_CreateTip_FullMethodName            = "/protos.Tipster.SocialService/CreateTip"
+	SocialService_GetTip_FullMethodName               = "/protos.Tipster.SocialService/GetTip"
+	SocialService_UpdateTip_FullMethodName            = "/protos.Tipster.SocialService/UpdateTip"
+	SocialService_ListTipRevisions_FullMethodName     = "/protos.Tipster.SocialService/ListTipRevisions"
+	SocialService_DeleteTip_FullMethodName            = "/protos.Tipster.SocialService/DeleteTip"
+	SocialService_ListTips_FullMethodName             = "/protos.Tipster.SocialService/ListTips"
+	SocialService_ShareTip_FullMethodName             = "/protos.Tipster.SocialService/ShareTip"
+	SocialService_ListTipShares_FullMethodName        = "/protos.Tipster.SocialService/ListTipShares"
+	SocialService_SettleTip_FullMethodName            = "/protos.Tipster.SocialService/SettleTip"
+	SocialService_ListUnsettledTips_FullMethodName    = "/protos.Tipster.SocialService/ListUnsettledTips"
+	SocialService_GetTipsterStats_FullMethodName      = "/protos.Tipster.SocialService/GetTipsterStats"
+	SocialService_ListLeaderboard_FullMethodName      = "/protos.Tipster.SocialService/ListLeaderboard"
+	SocialService_VerifyTipsterLedger_FullMethodName  = "/protos.Tipster.SocialService/VerifyTipsterLedger"
+	SocialService_LikeTip_FullMethodName              = "/protos.Tipster.SocialService/LikeTip"
+	SocialService_UnlikeTip_FullMethodName            = "/protos.Tipster.SocialService/UnlikeTip"
+	SocialService_CommentOnTip_FullMethodName         = "/protos.Tipster.SocialService/CommentOnTip"
+	SocialService_UpdateComment_FullMethodName        = "/protos.Tipster.SocialService/UpdateComment"
+	SocialService_DeleteComment_FullMethodName        = "/protos.Tipster.SocialService/DeleteComment"
+	SocialService_ListTipComments_FullMethodName      = "/protos.Tipster.SocialService/ListTipComments"
+	SocialService_LikeComment_FullMethodName          = "/protos.Tipster.SocialService/LikeComment"
+	SocialService_UnlikeComment_FullMethodName        = "/protos.Tipster.SocialService/UnlikeComment"
+	SocialService_ReplyComment_FullMethodName         = "/protos.Tipster.SocialService/ReplyComment"
+	SocialService_ListCommentReplies_FullMethodName   = "/protos.Tipster.SocialService/ListCommentReplies"
+	SocialService_ListComments_FullMethodName         = "/protos.Tipster.SocialService/ListComments"
+	SocialService_ListFollowingFeed_FullMethodName    = "/protos.Tipster.SocialService/ListFollowingFeed"
 )
 
 // SocialServiceClient is the client API for SocialService service.
@@ -54,15 +81,42 @@ type SocialServiceClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
 	ListUsers(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error)
 	FollowTipster(ctx context.Context, in *FollowTipsterRequest, opts ...grpc.CallOption) (*FollowTipsterResponse, error)
 	UnfollowTipster(ctx context.Context, in *UnFollowTipsterRequest, opts ...grpc.CallOption) (*UnfollowTipsterResponse, error)
+	ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error)
+	ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error)
+	ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error)
+	ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error)
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*MuteUserResponse, error)
+	UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*UnmuteUserResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	LoginWithIdentity(ctx context.Context, in *LoginWithIdentityRequest, opts ...grpc.CallOption) (*LoginWithIdentityResponse, error)
+	LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error)
+	SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error)
+	ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error)
+	CloseUser(ctx context.Context, in *CloseUserRequest, opts ...grpc.CallOption) (*CloseUserResponse, error)
+	SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*SelfExcludeResponse, error)
+	ListSelfExclusions(ctx context.Context, in *ListSelfExclusionsRequest, opts ...grpc.CallOption) (*ListSelfExclusionsResponse, error)
 	CreateTip(ctx context.Context, in *CreateTipRequest, opts ...grpc.CallOption) (*CreateTipResponse, error)
 	GetTip(ctx context.Context, in *GetTipRequest, opts ...grpc.CallOption) (*GetTipResponse, error)
 	UpdateTip(ctx context.Context, in *UpdateTipRequest, opts ...grpc.CallOption) (*UpdateTipResponse, error)
+	ListTipRevisions(ctx context.Context, in *ListTipRevisionsRequest, opts ...grpc.CallOption) (*ListTipRevisionsResponse, error)
 	DeleteTip(ctx context.Context, in *DeleteTipRequest, opts ...grpc.CallOption) (*DeleteTipResponse, error)
 	ListTips(ctx context.Context, in *ListTipsRequest, opts ...grpc.CallOption) (*ListTipsResponse, error)
 	ShareTip(ctx context.Context, in *ShareTipRequest, opts ...grpc.CallOption) (*ShareTipResponse, error)
+	ListTipShares(ctx context.Context, in *ListTipSharesRequest, opts ...grpc.CallOption) (*ListTipSharesResponse, error)
+	SettleTip(ctx context.Context, in *SettleTipRequest, opts ...grpc.CallOption) (*SettleTipResponse, error)
+	ListUnsettledTips(ctx context.Context, in *ListUnsettledTipsRequest, opts ...grpc.CallOption) (*ListUnsettledTipsResponse, error)
+	GetTipsterStats(ctx context.Context, in *GetTipsterStatsRequest, opts ...grpc.CallOption) (*GetTipsterStatsResponse, error)
+	ListLeaderboard(ctx context.Context, in *ListLeaderboardRequest, opts ...grpc.CallOption) (*ListLeaderboardResponse, error)
+	VerifyTipsterLedger(ctx context.Context, in *VerifyTipsterLedgerRequest, opts ...grpc.CallOption) (*VerifyTipsterLedgerResponse, error)
 	LikeTip(ctx context.Context, in *LikeTipRequest, opts ...grpc.CallOption) (*LikeTipResponse, error)
 	UnlikeTip(ctx context.Context, in *UnlikeTipRequest, opts ...grpc.CallOption) (*UnlikeTipResponse, error)
 	CommentOnTip(ctx context.Context, in *CommentOnTipRequest, opts ...grpc.CallOption) (*CommentOnTipResponse, error)
@@ -125,6 +179,16 @@ func (c *socialServiceClient) DeleteUser(ctx context.Context, in *DeleteUserRequ
 	return out, nil
 }
 
+func (c *socialServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_RestoreUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) ListUsers(ctx context.Context, in *ListUserRequest, opts ...grpc.CallOption) (*ListUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListUserResponse)
@@ -155,6 +219,196 @@ func (c *socialServiceClient) UnfollowTipster(ctx context.Context, in *UnFollowT
 	return out, nil
 }
 
+func (c *socialServiceClient) ListFollowers(ctx context.Context, in *ListFollowersRequest, opts ...grpc.CallOption) (*ListFollowersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowersResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListFollowers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListFollowing(ctx context.Context, in *ListFollowingRequest, opts ...grpc.CallOption) (*ListFollowingResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowingResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListFollowing_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListFollowRequests(ctx context.Context, in *ListFollowRequestsRequest, opts ...grpc.CallOption) (*ListFollowRequestsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFollowRequestsResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListFollowRequests_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ApproveFollowRequest(ctx context.Context, in *ApproveFollowRequestRequest, opts ...grpc.CallOption) (*ApproveFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveFollowRequestResponse)
+	err := c.cc.Invoke(ctx, SocialService_ApproveFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) RejectFollowRequest(ctx context.Context, in *RejectFollowRequestRequest, opts ...grpc.CallOption) (*RejectFollowRequestResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectFollowRequestResponse)
+	err := c.cc.Invoke(ctx, SocialService_RejectFollowRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) MuteUser(ctx context.Context, in *MuteUserRequest, opts ...grpc.CallOption) (*MuteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MuteUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_MuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) UnmuteUser(ctx context.Context, in *UnmuteUserRequest, opts ...grpc.CallOption) (*UnmuteUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnmuteUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_UnmuteUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, SocialService_Login_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, SocialService_RefreshToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) LoginWithIdentity(ctx context.Context, in *LoginWithIdentityRequest, opts ...grpc.CallOption) (*LoginWithIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LoginWithIdentityResponse)
+	err := c.cc.Invoke(ctx, SocialService_LoginWithIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...grpc.CallOption) (*LinkIdentityResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(LinkIdentityResponse)
+	err := c.cc.Invoke(ctx, SocialService_LinkIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserRoleResponse)
+	err := c.cc.Invoke(ctx, SocialService_SetUserRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) SuspendUser(ctx context.Context, in *SuspendUserRequest, opts ...grpc.CallOption) (*SuspendUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_SuspendUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ReactivateUser(ctx context.Context, in *ReactivateUserRequest, opts ...grpc.CallOption) (*ReactivateUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReactivateUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_ReactivateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) CloseUser(ctx context.Context, in *CloseUserRequest, opts ...grpc.CallOption) (*CloseUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CloseUserResponse)
+	err := c.cc.Invoke(ctx, SocialService_CloseUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) SelfExclude(ctx context.Context, in *SelfExcludeRequest, opts ...grpc.CallOption) (*SelfExcludeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SelfExcludeResponse)
+	err := c.cc.Invoke(ctx, SocialService_SelfExclude_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListSelfExclusions(ctx context.Context, in *ListSelfExclusionsRequest, opts ...grpc.CallOption) (*ListSelfExclusionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListSelfExclusionsResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListSelfExclusions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) CreateTip(ctx context.Context, in *CreateTipRequest, opts ...grpc.CallOption) (*CreateTipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateTipResponse)
@@ -185,6 +439,16 @@ func (c *socialServiceClient) UpdateTip(ctx context.Context, in *UpdateTipReques
 	return out, nil
 }
 
+func (c *socialServiceClient) ListTipRevisions(ctx context.Context, in *ListTipRevisionsRequest, opts ...grpc.CallOption) (*ListTipRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTipRevisionsResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListTipRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) DeleteTip(ctx context.Context, in *DeleteTipRequest, opts ...grpc.CallOption) (*DeleteTipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteTipResponse)
@@ -215,6 +479,66 @@ func (c *socialServiceClient) ShareTip(ctx context.Context, in *ShareTipRequest,
 	return out, nil
 }
 
+func (c *socialServiceClient) ListTipShares(ctx context.Context, in *ListTipSharesRequest, opts ...grpc.CallOption) (*ListTipSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTipSharesResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListTipShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) SettleTip(ctx context.Context, in *SettleTipRequest, opts ...grpc.CallOption) (*SettleTipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SettleTipResponse)
+	err := c.cc.Invoke(ctx, SocialService_SettleTip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListUnsettledTips(ctx context.Context, in *ListUnsettledTipsRequest, opts ...grpc.CallOption) (*ListUnsettledTipsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUnsettledTipsResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListUnsettledTips_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) GetTipsterStats(ctx context.Context, in *GetTipsterStatsRequest, opts ...grpc.CallOption) (*GetTipsterStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTipsterStatsResponse)
+	err := c.cc.Invoke(ctx, SocialService_GetTipsterStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) ListLeaderboard(ctx context.Context, in *ListLeaderboardRequest, opts ...grpc.CallOption) (*ListLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLeaderboardResponse)
+	err := c.cc.Invoke(ctx, SocialService_ListLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *socialServiceClient) VerifyTipsterLedger(ctx context.Context, in *VerifyTipsterLedgerRequest, opts ...grpc.CallOption) (*VerifyTipsterLedgerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyTipsterLedgerResponse)
+	err := c.cc.Invoke(ctx, SocialService_VerifyTipsterLedger_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *socialServiceClient) LikeTip(ctx context.Context, in *LikeTipRequest, opts ...grpc.CallOption) (*LikeTipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LikeTipResponse)
@@ -343,15 +667,42 @@ type SocialServiceServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	ListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error)
 	FollowTipster(context.Context, *FollowTipsterRequest) (*FollowTipsterResponse, error)
 	UnfollowTipster(context.Context, *UnFollowTipsterRequest) (*UnfollowTipsterResponse, error)
+	ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error)
+	ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error)
+	ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error)
+	ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error)
+	RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error)
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	MuteUser(context.Context, *MuteUserRequest) (*MuteUserResponse, error)
+	UnmuteUser(context.Context, *UnmuteUserRequest) (*UnmuteUserResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	LoginWithIdentity(context.Context, *LoginWithIdentityRequest) (*LoginWithIdentityResponse, error)
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error)
+	SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error)
+	ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error)
+	CloseUser(context.Context, *CloseUserRequest) (*CloseUserResponse, error)
+	SelfExclude(context.Context, *SelfExcludeRequest) (*SelfExcludeResponse, error)
+	ListSelfExclusions(context.Context, *ListSelfExclusionsRequest) (*ListSelfExclusionsResponse, error)
 	CreateTip(context.Context, *CreateTipRequest) (*CreateTipResponse, error)
 	GetTip(context.Context, *GetTipRequest) (*GetTipResponse, error)
 	UpdateTip(context.Context, *UpdateTipRequest) (*UpdateTipResponse, error)
+	ListTipRevisions(context.Context, *ListTipRevisionsRequest) (*ListTipRevisionsResponse, error)
 	DeleteTip(context.Context, *DeleteTipRequest) (*DeleteTipResponse, error)
 	ListTips(context.Context, *ListTipsRequest) (*ListTipsResponse, error)
 	ShareTip(context.Context, *ShareTipRequest) (*ShareTipResponse, error)
+	ListTipShares(context.Context, *ListTipSharesRequest) (*ListTipSharesResponse, error)
+	SettleTip(context.Context, *SettleTipRequest) (*SettleTipResponse, error)
+	ListUnsettledTips(context.Context, *ListUnsettledTipsRequest) (*ListUnsettledTipsResponse, error)
+	GetTipsterStats(context.Context, *GetTipsterStatsRequest) (*GetTipsterStatsResponse, error)
+	ListLeaderboard(context.Context, *ListLeaderboardRequest) (*ListLeaderboardResponse, error)
+	VerifyTipsterLedger(context.Context, *VerifyTipsterLedgerRequest) (*VerifyTipsterLedgerResponse, error)
 	LikeTip(context.Context, *LikeTipRequest) (*LikeTipResponse, error)
 	UnlikeTip(context.Context, *UnlikeTipRequest) (*UnlikeTipResponse, error)
 	CommentOnTip(context.Context, *CommentOnTipRequest) (*CommentOnTipResponse, error)
@@ -386,6 +737,9 @@ func (UnimplementedSocialServiceServer) UpdateUser(context.Context, *UpdateUserR
 func (UnimplementedSocialServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
+func (UnimplementedSocialServiceServer) RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreUser not implemented")
+}
 func (UnimplementedSocialServiceServer) ListUsers(context.Context, *ListUserRequest) (*ListUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
 }
@@ -395,6 +749,63 @@ func (UnimplementedSocialServiceServer) FollowTipster(context.Context, *FollowTi
 func (UnimplementedSocialServiceServer) UnfollowTipster(context.Context, *UnFollowTipsterRequest) (*UnfollowTipsterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnfollowTipster not implemented")
 }
+func (UnimplementedSocialServiceServer) ListFollowers(context.Context, *ListFollowersRequest) (*ListFollowersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowers not implemented")
+}
+func (UnimplementedSocialServiceServer) ListFollowing(context.Context, *ListFollowingRequest) (*ListFollowingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowing not implemented")
+}
+func (UnimplementedSocialServiceServer) ListFollowRequests(context.Context, *ListFollowRequestsRequest) (*ListFollowRequestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFollowRequests not implemented")
+}
+func (UnimplementedSocialServiceServer) ApproveFollowRequest(context.Context, *ApproveFollowRequestRequest) (*ApproveFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveFollowRequest not implemented")
+}
+func (UnimplementedSocialServiceServer) RejectFollowRequest(context.Context, *RejectFollowRequestRequest) (*RejectFollowRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectFollowRequest not implemented")
+}
+func (UnimplementedSocialServiceServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedSocialServiceServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedSocialServiceServer) MuteUser(context.Context, *MuteUserRequest) (*MuteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MuteUser not implemented")
+}
+func (UnimplementedSocialServiceServer) UnmuteUser(context.Context, *UnmuteUserRequest) (*UnmuteUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnmuteUser not implemented")
+}
+func (UnimplementedSocialServiceServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
+}
+func (UnimplementedSocialServiceServer) RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshToken not implemented")
+}
+func (UnimplementedSocialServiceServer) LoginWithIdentity(context.Context, *LoginWithIdentityRequest) (*LoginWithIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoginWithIdentity not implemented")
+}
+func (UnimplementedSocialServiceServer) LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkIdentity not implemented")
+}
+func (UnimplementedSocialServiceServer) SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetUserRole not implemented")
+}
+func (UnimplementedSocialServiceServer) SuspendUser(context.Context, *SuspendUserRequest) (*SuspendUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendUser not implemented")
+}
+func (UnimplementedSocialServiceServer) ReactivateUser(context.Context, *ReactivateUserRequest) (*ReactivateUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReactivateUser not implemented")
+}
+func (UnimplementedSocialServiceServer) CloseUser(context.Context, *CloseUserRequest) (*CloseUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseUser not implemented")
+}
+func (UnimplementedSocialServiceServer) SelfExclude(context.Context, *SelfExcludeRequest) (*SelfExcludeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SelfExclude not implemented")
+}
+func (UnimplementedSocialServiceServer) ListSelfExclusions(context.Context, *ListSelfExclusionsRequest) (*ListSelfExclusionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSelfExclusions not implemented")
+}
 func (UnimplementedSocialServiceServer) CreateTip(context.Context, *CreateTipRequest) (*CreateTipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTip not implemented")
 }
@@ -404,6 +815,9 @@ func (UnimplementedSocialServiceServer) GetTip(context.Context, *GetTipRequest)
 func (UnimplementedSocialServiceServer) UpdateTip(context.Context, *UpdateTipRequest) (*UpdateTipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateTip not implemented")
 }
+func (UnimplementedSocialServiceServer) ListTipRevisions(context.Context, *ListTipRevisionsRequest) (*ListTipRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTipRevisions not implemented")
+}
 func (UnimplementedSocialServiceServer) DeleteTip(context.Context, *DeleteTipRequest) (*DeleteTipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTip not implemented")
 }
@@ -413,6 +827,24 @@ func (UnimplementedSocialServiceServer) ListTips(context.Context, *ListTipsReque
 func (UnimplementedSocialServiceServer) ShareTip(context.Context, *ShareTipRequest) (*ShareTipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareTip not implemented")
 }
+func (UnimplementedSocialServiceServer) ListTipShares(context.Context, *ListTipSharesRequest) (*ListTipSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTipShares not implemented")
+}
+func (UnimplementedSocialServiceServer) SettleTip(context.Context, *SettleTipRequest) (*SettleTipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleTip not implemented")
+}
+func (UnimplementedSocialServiceServer) ListUnsettledTips(context.Context, *ListUnsettledTipsRequest) (*ListUnsettledTipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUnsettledTips not implemented")
+}
+func (UnimplementedSocialServiceServer) GetTipsterStats(context.Context, *GetTipsterStatsRequest) (*GetTipsterStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTipsterStats not implemented")
+}
+func (UnimplementedSocialServiceServer) ListLeaderboard(context.Context, *ListLeaderboardRequest) (*ListLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLeaderboard not implemented")
+}
+func (UnimplementedSocialServiceServer) VerifyTipsterLedger(context.Context, *VerifyTipsterLedgerRequest) (*VerifyTipsterLedgerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTipsterLedger not implemented")
+}
 func (UnimplementedSocialServiceServer) LikeTip(context.Context, *LikeTipRequest) (*LikeTipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LikeTip not implemented")
 }
@@ -542,6 +974,24 @@ func _SocialService_DeleteUser_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_RestoreUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SocialService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListUserRequest)
 	if err := dec(in); err != nil {
@@ -596,98 +1046,458 @@ func _SocialService_UnfollowTipster_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SocialService_CreateTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTipRequest)
+func _SocialService_ListFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SocialServiceServer).CreateTip(ctx, in)
+		return srv.(SocialServiceServer).ListFollowers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SocialService_CreateTip_FullMethodName,
+		FullMethod: SocialService_ListFollowers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SocialServiceServer).CreateTip(ctx, req.(*CreateTipRequest))
+		return srv.(SocialServiceServer).ListFollowers(ctx, req.(*ListFollowersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SocialService_GetTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTipRequest)
+func _SocialService_ListFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SocialServiceServer).GetTip(ctx, in)
+		return srv.(SocialServiceServer).ListFollowing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SocialService_GetTip_FullMethodName,
+		FullMethod: SocialService_ListFollowing_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SocialServiceServer).GetTip(ctx, req.(*GetTipRequest))
+		return srv.(SocialServiceServer).ListFollowing(ctx, req.(*ListFollowingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SocialService_UpdateTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTipRequest)
+func _SocialService_ListFollowRequests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFollowRequestsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SocialServiceServer).UpdateTip(ctx, in)
+		return srv.(SocialServiceServer).ListFollowRequests(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SocialService_UpdateTip_FullMethodName,
+		FullMethod: SocialService_ListFollowRequests_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SocialServiceServer).UpdateTip(ctx, req.(*UpdateTipRequest))
+		return srv.(SocialServiceServer).ListFollowRequests(ctx, req.(*ListFollowRequestsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SocialService_DeleteTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeleteTipRequest)
+func _SocialService_ApproveFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveFollowRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SocialServiceServer).DeleteTip(ctx, in)
+		return srv.(SocialServiceServer).ApproveFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SocialService_DeleteTip_FullMethodName,
+		FullMethod: SocialService_ApproveFollowRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SocialServiceServer).DeleteTip(ctx, req.(*DeleteTipRequest))
+		return srv.(SocialServiceServer).ApproveFollowRequest(ctx, req.(*ApproveFollowRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SocialService_ListTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListTipsRequest)
+func _SocialService_RejectFollowRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectFollowRequestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SocialServiceServer).ListTips(ctx, in)
+		return srv.(SocialServiceServer).RejectFollowRequest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: SocialService_ListTips_FullMethodName,
+		FullMethod: SocialService_RejectFollowRequest_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SocialServiceServer).ListTips(ctx, req.(*ListTipsRequest))
+		return srv.(SocialServiceServer).RejectFollowRequest(ctx, req.(*RejectFollowRequestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SocialService_ShareTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShareTipRequest)
+func _SocialService_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_MuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).MuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_MuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).MuteUser(ctx, req.(*MuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_UnmuteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnmuteUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).UnmuteUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_UnmuteUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).UnmuteUser(ctx, req.(*UnmuteUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_RefreshToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_LoginWithIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginWithIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).LoginWithIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_LoginWithIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).LoginWithIdentity(ctx, req.(*LoginWithIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_LinkIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).LinkIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_LinkIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).LinkIdentity(ctx, req.(*LinkIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).SetUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_SetUserRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_SuspendUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).SuspendUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_SuspendUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).SuspendUser(ctx, req.(*SuspendUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ReactivateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReactivateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ReactivateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ReactivateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ReactivateUser(ctx, req.(*ReactivateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_CloseUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloseUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).CloseUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_CloseUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).CloseUser(ctx, req.(*CloseUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_SelfExclude_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelfExcludeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).SelfExclude(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_SelfExclude_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).SelfExclude(ctx, req.(*SelfExcludeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListSelfExclusions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSelfExclusionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListSelfExclusions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListSelfExclusions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListSelfExclusions(ctx, req.(*ListSelfExclusionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_CreateTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).CreateTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_CreateTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).CreateTip(ctx, req.(*CreateTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_GetTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).GetTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_GetTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).GetTip(ctx, req.(*GetTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_UpdateTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).UpdateTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_UpdateTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).UpdateTip(ctx, req.(*UpdateTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListTipRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTipRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListTipRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListTipRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListTipRevisions(ctx, req.(*ListTipRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_DeleteTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).DeleteTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_DeleteTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).DeleteTip(ctx, req.(*DeleteTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListTips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListTips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListTips(ctx, req.(*ListTipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ShareTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShareTipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -704,6 +1514,114 @@ func _SocialService_ShareTip_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SocialService_ListTipShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTipSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListTipShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListTipShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListTipShares(ctx, req.(*ListTipSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_SettleTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SettleTipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).SettleTip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_SettleTip_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).SettleTip(ctx, req.(*SettleTipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListUnsettledTips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUnsettledTipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListUnsettledTips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListUnsettledTips_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListUnsettledTips(ctx, req.(*ListUnsettledTipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_GetTipsterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTipsterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).GetTipsterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_GetTipsterStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).GetTipsterStats(ctx, req.(*GetTipsterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_ListLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).ListLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_ListLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).ListLeaderboard(ctx, req.(*ListLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SocialService_VerifyTipsterLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTipsterLedgerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SocialServiceServer).VerifyTipsterLedger(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SocialService_VerifyTipsterLedger_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SocialServiceServer).VerifyTipsterLedger(ctx, req.(*VerifyTipsterLedgerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SocialService_LikeTip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LikeTipRequest)
 	if err := dec(in); err != nil {
@@ -943,6 +1861,10 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _SocialService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _SocialService_RestoreUser_Handler,
+		},
 		{
 			MethodName: "ListUsers",
 			Handler:    _SocialService_ListUsers_Handler,
@@ -955,6 +1877,82 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnfollowTipster",
 			Handler:    _SocialService_UnfollowTipster_Handler,
 		},
+		{
+			MethodName: "ListFollowers",
+			Handler:    _SocialService_ListFollowers_Handler,
+		},
+		{
+			MethodName: "ListFollowing",
+			Handler:    _SocialService_ListFollowing_Handler,
+		},
+		{
+			MethodName: "ListFollowRequests",
+			Handler:    _SocialService_ListFollowRequests_Handler,
+		},
+		{
+			MethodName: "ApproveFollowRequest",
+			Handler:    _SocialService_ApproveFollowRequest_Handler,
+		},
+		{
+			MethodName: "RejectFollowRequest",
+			Handler:    _SocialService_RejectFollowRequest_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _SocialService_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _SocialService_UnblockUser_Handler,
+		},
+		{
+			MethodName: "MuteUser",
+			Handler:    _SocialService_MuteUser_Handler,
+		},
+		{
+			MethodName: "UnmuteUser",
+			Handler:    _SocialService_UnmuteUser_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _SocialService_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _SocialService_RefreshToken_Handler,
+		},
+		{
+			MethodName: "LoginWithIdentity",
+			Handler:    _SocialService_LoginWithIdentity_Handler,
+		},
+		{
+			MethodName: "LinkIdentity",
+			Handler:    _SocialService_LinkIdentity_Handler,
+		},
+		{
+			MethodName: "SetUserRole",
+			Handler:    _SocialService_SetUserRole_Handler,
+		},
+		{
+			MethodName: "SuspendUser",
+			Handler:    _SocialService_SuspendUser_Handler,
+		},
+		{
+			MethodName: "ReactivateUser",
+			Handler:    _SocialService_ReactivateUser_Handler,
+		},
+		{
+			MethodName: "CloseUser",
+			Handler:    _SocialService_CloseUser_Handler,
+		},
+		{
+			MethodName: "SelfExclude",
+			Handler:    _SocialService_SelfExclude_Handler,
+		},
+		{
+			MethodName: "ListSelfExclusions",
+			Handler:    _SocialService_ListSelfExclusions_Handler,
+		},
 		{
 			MethodName: "CreateTip",
 			Handler:    _SocialService_CreateTip_Handler,
@@ -967,6 +1965,10 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateTip",
 			Handler:    _SocialService_UpdateTip_Handler,
 		},
+		{
+			MethodName: "ListTipRevisions",
+			Handler:    _SocialService_ListTipRevisions_Handler,
+		},
 		{
 			MethodName: "DeleteTip",
 			Handler:    _SocialService_DeleteTip_Handler,
@@ -979,6 +1981,30 @@ var SocialService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ShareTip",
 			Handler:    _SocialService_ShareTip_Handler,
 		},
+		{
+			MethodName: "ListTipShares",
+			Handler:    _SocialService_ListTipShares_Handler,
+		},
+		{
+			MethodName: "SettleTip",
+			Handler:    _SocialService_SettleTip_Handler,
+		},
+		{
+			MethodName: "ListUnsettledTips",
+			Handler:    _SocialService_ListUnsettledTips_Handler,
+		},
+		{
+			MethodName: "GetTipsterStats",
+			Handler:    _SocialService_GetTipsterStats_Handler,
+		},
+		{
+			MethodName: "ListLeaderboard",
+			Handler:    _SocialService_ListLeaderboard_Handler,
+		},
+		{
+			MethodName: "VerifyTipsterLedger",
+			Handler:    _SocialService_VerifyTipsterLedger_Handler,
+		},
 		{
 			MethodName: "LikeTip",
 			Handler:    _SocialService_LikeTip_Handler,